@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/internal/storage"
+	storagecoderef "go.flipt.io/flipt/internal/storage/coderef"
+	coderefsql "go.flipt.io/flipt/internal/storage/coderef/sql"
+	"go.flipt.io/flipt/internal/storage/sql"
+)
+
+// newFlagCodeRefsCommand returns the `flipt flag code-refs` commands, which
+// ingest and query "code references" (repo, path, line) reported by a CI
+// scanner for a flag key, so users can see where a flag is used before
+// deleting it.
+func newFlagCodeRefsCommand(rf *resourceFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-refs",
+		Short: "Manage code references for flags, as reported by a CI scanner",
+	}
+
+	cmd.PersistentFlags().StringVar(&providedConfigFile, "config", "", "path to config file")
+
+	cmd.AddCommand(
+		newFlagCodeRefsIngestCommand(rf),
+		newFlagCodeRefsListCommand(rf),
+	)
+
+	return cmd
+}
+
+// openCodeRefStore opens a direct connection to the configured database and
+// returns its code reference store, for use by this CLI without going
+// through a running Flipt server.
+func openCodeRefStore() (*coderefsql.Store, func(), error) {
+	_, cfg, err := buildConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, driver, err := sql.Open(*cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening db: %w", err)
+	}
+
+	builder := sql.BuilderFor(db, driver, cfg.Database.PreparedStatementsEnabled)
+
+	return coderefsql.NewStore(driver, builder), func() { _ = db.Close() }, nil
+}
+
+// scannedReference is the shape a CI scanner is expected to emit, one per
+// code location that references a flag key.
+type scannedReference struct {
+	FlagKey    string `json:"flag_key"`
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+	Line       int    `json:"line"`
+	Ref        string `json:"ref,omitempty"`
+}
+
+func newFlagCodeRefsIngestCommand(rf *resourceFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ingest <file>",
+		Short: "Ingest code references reported by a CI scanner as a JSON file",
+		Long: "Reads a JSON array of {flag_key, repository, path, line, ref} objects, as " +
+			"produced by a CI scanner, and records them as code references for their " +
+			"respective flags.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("reading scan results %q: %w", args[0], err)
+			}
+
+			var scanned []scannedReference
+			if err := json.Unmarshal(data, &scanned); err != nil {
+				return fmt.Errorf("unmarshaling scan results: %w", err)
+			}
+
+			reqs := make([]*storagecoderef.AddReferenceRequest, 0, len(scanned))
+
+			for _, s := range scanned {
+				reqs = append(reqs, &storagecoderef.AddReferenceRequest{
+					NamespaceKey: rf.namespace,
+					FlagKey:      s.FlagKey,
+					Repository:   s.Repository,
+					Path:         s.Path,
+					Line:         s.Line,
+					Ref:          s.Ref,
+				})
+			}
+
+			store, cleanup, err := openCodeRefStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			refs, err := store.AddReferences(cmd.Context(), reqs)
+			if err != nil {
+				return fmt.Errorf("adding code references: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "ingested %d code reference(s)\n", len(refs))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newFlagCodeRefsListCommand(rf *resourceFlags) *cobra.Command {
+	var repository string
+
+	cmd := &cobra.Command{
+		Use:   "list <flag-key>",
+		Short: "List code references recorded for a flag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, cleanup, err := openCodeRefStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			opts := []storage.ListOption[storagecoderef.ListReferencesPredicate]{
+				storagecoderef.ListWithNamespaceKey(rf.namespace),
+				storagecoderef.ListWithFlagKey(args[0]),
+			}
+
+			if repository != "" {
+				opts = append(opts, storagecoderef.ListWithRepository(repository))
+			}
+
+			set, err := store.ListReferences(cmd.Context(), storage.NewListRequest(opts...))
+			if err != nil {
+				return fmt.Errorf("listing code references: %w", err)
+			}
+
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(tw, "REPOSITORY\tPATH\tLINE\tREF")
+
+			for _, ref := range set.Results {
+				fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", ref.Repository, ref.Path, ref.Line, ref.Ref)
+			}
+
+			return tw.Flush()
+		},
+	}
+
+	cmd.Flags().StringVar(&repository, "repository", "", "filter by repository")
+
+	return cmd
+}
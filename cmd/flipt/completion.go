@@ -7,10 +7,26 @@ import (
 // completionCmd represents the completion command
 func newCompletionCommand() *cobra.Command {
 	return &cobra.Command{
-		Use:    "completion [SHELL]",
-		Short:  "Generate completion scripts",
-		Args:   cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
-		Hidden: true,
+		Use:   "completion [SHELL]",
+		Short: "Generate completion scripts",
+		Long: `Generate a shell completion script for Flipt.
+
+To load completions:
+
+Bash:
+  $ source <(flipt completion bash)
+
+Zsh:
+  $ flipt completion zsh > "${fpath[1]}/_flipt"
+
+Fish:
+  $ flipt completion fish > ~/.config/fish/completions/flipt.fish
+
+PowerShell:
+  PS> flipt completion powershell | Out-String | Invoke-Expression
+`,
+		ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			switch args[0] {
 			case "bash":
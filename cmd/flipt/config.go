@@ -13,9 +13,25 @@ import (
 )
 
 type initCommand struct {
-	force bool
+	force   bool
+	storage string
+	auth    string
+	cache   string
 }
 
+const (
+	initStorageDatabase = "database"
+	initStorageLocal    = "local"
+	initStorageGit      = "git"
+
+	initAuthNone  = "none"
+	initAuthToken = "token"
+
+	initCacheNone   = "none"
+	initCacheMemory = "memory"
+	initCacheRedis  = "redis"
+)
+
 func (c *initCommand) run(cmd *cobra.Command, args []string) error {
 	defaultFile := providedConfigFile
 
@@ -25,8 +41,13 @@ func (c *initCommand) run(cmd *cobra.Command, args []string) error {
 
 	file := defaultFile
 
+	// when none of the storage/auth/cache flags have been explicitly set,
+	// prompt interactively for them alongside the file path; otherwise
+	// fall through using the flag values (or their defaults) as-is.
+	interactive := c.storage == "" && c.auth == "" && c.cache == ""
+
 	ack := c.force
-	if !ack {
+	if interactive {
 		q := []*survey.Question{
 			{
 				Name: "file",
@@ -36,12 +57,45 @@ func (c *initCommand) run(cmd *cobra.Command, args []string) error {
 				},
 				Validate: survey.Required,
 			},
+			{
+				Name: "storage",
+				Prompt: &survey.Select{
+					Message: "Storage backend:",
+					Options: []string{initStorageDatabase, initStorageLocal, initStorageGit},
+					Default: initStorageDatabase,
+				},
+			},
+			{
+				Name: "auth",
+				Prompt: &survey.Select{
+					Message: "Authentication method:",
+					Options: []string{initAuthNone, initAuthToken},
+					Default: initAuthNone,
+				},
+			},
+			{
+				Name: "cache",
+				Prompt: &survey.Select{
+					Message: "Cache backend:",
+					Options: []string{initCacheNone, initCacheMemory, initCacheRedis},
+					Default: initCacheNone,
+				},
+			},
 		}
 
-		if err := survey.Ask(q, &file); err != nil {
+		answers := struct {
+			File    string
+			Storage string
+			Auth    string
+			Cache   string
+		}{}
+
+		if err := survey.Ask(q, &answers); err != nil {
 			return err
 		}
 
+		file, c.storage, c.auth, c.cache = answers.File, answers.Storage, answers.Auth, answers.Cache
+
 		// check if file exists
 		if _, err := os.Stat(file); err == nil {
 			// file exists
@@ -65,8 +119,11 @@ func (c *initCommand) run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	cfg := config.Default()
-	cfg.Version = config.Version // write version for backward compatibility
+	cfg, err := c.buildConfig()
+	if err != nil {
+		return err
+	}
+
 	out, err := yaml.Marshal(cfg)
 	if err != nil {
 		return err
@@ -84,6 +141,55 @@ func (c *initCommand) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// buildConfig starts from Flipt's default configuration and layers on the
+// storage, auth and cache selections made via flags or the interactive
+// prompts above.
+func (c *initCommand) buildConfig() (*config.Config, error) {
+	cfg := config.Default()
+	cfg.Version = config.Version // write version for backward compatibility
+
+	switch c.storage {
+	case "", initStorageDatabase:
+		// database storage is already Flipt's default
+	case initStorageLocal:
+		cfg.Storage.Type = config.LocalStorageType
+		cfg.Storage.Local = &config.Local{Path: "/var/opt/flipt"}
+	case initStorageGit:
+		cfg.Storage.Type = config.GitStorageType
+		cfg.Storage.Git = &config.Git{
+			Repository: "https://github.com/my-org/my-flags.git",
+			Ref:        "main",
+		}
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", c.storage)
+	}
+
+	switch c.auth {
+	case "", initAuthNone:
+		// no authentication methods enabled by default
+	case initAuthToken:
+		cfg.Authentication.Required = true
+		cfg.Authentication.Methods.Token.Enabled = true
+	default:
+		return nil, fmt.Errorf("unknown authentication method %q", c.auth)
+	}
+
+	switch c.cache {
+	case "", initCacheNone:
+		// caching disabled by default
+	case initCacheMemory:
+		cfg.Cache.Enabled = true
+		cfg.Cache.Backend = config.CacheMemory
+	case initCacheRedis:
+		cfg.Cache.Enabled = true
+		cfg.Cache.Backend = config.CacheRedis
+	default:
+		return nil, fmt.Errorf("unknown cache backend %q", c.cache)
+	}
+
+	return cfg, nil
+}
+
 type editCommand struct{}
 
 func (c *editCommand) run(cmd *cobra.Command, args []string) error {
@@ -126,6 +232,36 @@ func (c *editCommand) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+type configValidateCommand struct{}
+
+// run loads the configuration file (plus any environment variable
+// overrides) at the resolved path, running it through the same
+// defaulting/validation pipeline as normal startup, and reports the
+// outcome without starting Flipt. This is intended for use in CI to
+// catch invalid configuration before it's rolled out.
+func (c *configValidateCommand) run(cmd *cobra.Command, _ []string) error {
+	path, found := determineConfig(providedConfigFile)
+
+	res, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "configuration is invalid: %v\n", err)
+		return err
+	}
+
+	if found {
+		fmt.Fprintf(cmd.OutOrStdout(), "Validating configuration file: %s\n", path)
+	} else {
+		fmt.Fprintln(cmd.OutOrStdout(), "No configuration file found, validating defaults")
+	}
+
+	for _, warning := range res.Warnings {
+		fmt.Fprintf(cmd.OutOrStdout(), "warning: %s\n", warning)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), "Configuration is valid.")
+	return nil
+}
+
 func newConfigCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "config",
@@ -134,8 +270,9 @@ func newConfigCommand() *cobra.Command {
 	}
 
 	var (
-		initCmd = &initCommand{}
-		editCmd = &editCommand{}
+		initCmd     = &initCommand{}
+		editCmd     = &editCommand{}
+		validateCmd = &configValidateCommand{}
 	)
 
 	var init = &cobra.Command{
@@ -145,6 +282,9 @@ func newConfigCommand() *cobra.Command {
 	}
 
 	init.Flags().BoolVarP(&initCmd.force, "force", "y", false, "Overwrite existing configuration file")
+	init.Flags().StringVar(&initCmd.storage, "storage", "", "Storage backend (database, local, git); prompted for interactively if not set")
+	init.Flags().StringVar(&initCmd.auth, "auth", "", "Authentication method (none, token); prompted for interactively if not set")
+	init.Flags().StringVar(&initCmd.cache, "cache", "", "Cache backend (none, memory, redis); prompted for interactively if not set")
 
 	var edit = &cobra.Command{
 		Use:   "edit",
@@ -152,9 +292,16 @@ func newConfigCommand() *cobra.Command {
 		RunE:  editCmd.run,
 	}
 
+	var validate = &cobra.Command{
+		Use:   "validate",
+		Short: "Validate Flipt configuration",
+		RunE:  validateCmd.run,
+	}
+
 	cmd.PersistentFlags().StringVar(&providedConfigFile, "config", "", "path to config file")
 	cmd.AddCommand(init)
 	cmd.AddCommand(edit)
+	cmd.AddCommand(validate)
 
 	return cmd
 }
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/internal/storage"
+	"go.flipt.io/flipt/rpc/flipt"
+	"gopkg.in/yaml.v2"
+)
+
+// newSegmentConstraintCommand returns the `flipt segment constraint`
+// subcommands for batch constraint operations, which require direct store
+// access since they aren't exposed over gRPC.
+func newSegmentConstraintCommand(rf *resourceFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constraint",
+		Short: "Manage a segment's constraints in batch",
+	}
+
+	cmd.PersistentFlags().StringVar(&providedConfigFile, "config", "", "path to config file")
+
+	cmd.AddCommand(
+		newConstraintCreateBatchCommand(rf),
+		newConstraintUpdateBatchCommand(rf),
+		newConstraintDeleteBatchCommand(rf),
+		newConstraintOrderCommand(rf),
+	)
+
+	return cmd
+}
+
+// batchConstraint is the YAML shape a constraint batch file is expected to
+// contain, one entry per constraint to create, update, or delete.
+type batchConstraint struct {
+	ID          string `yaml:"id,omitempty"`
+	Type        string `yaml:"type"`
+	Property    string `yaml:"property"`
+	Operator    string `yaml:"operator"`
+	Value       string `yaml:"value,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+func readBatchConstraints(path string) ([]batchConstraint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading constraints file %q: %w", path, err)
+	}
+
+	var constraints []batchConstraint
+	if err := yaml.Unmarshal(data, &constraints); err != nil {
+		return nil, fmt.Errorf("unmarshaling constraints file: %w", err)
+	}
+
+	return constraints, nil
+}
+
+func parseComparisonType(s string) (flipt.ComparisonType, error) {
+	switch s {
+	case "STRING_COMPARISON_TYPE", "string":
+		return flipt.ComparisonType_STRING_COMPARISON_TYPE, nil
+	case "NUMBER_COMPARISON_TYPE", "number":
+		return flipt.ComparisonType_NUMBER_COMPARISON_TYPE, nil
+	case "BOOLEAN_COMPARISON_TYPE", "boolean":
+		return flipt.ComparisonType_BOOLEAN_COMPARISON_TYPE, nil
+	case "DATETIME_COMPARISON_TYPE", "datetime":
+		return flipt.ComparisonType_DATETIME_COMPARISON_TYPE, nil
+	default:
+		return 0, fmt.Errorf("unsupported constraint type %q", s)
+	}
+}
+
+func newConstraintCreateBatchCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-batch <segment-key> <file>",
+		Short: "Create a batch of constraints for a segment in a single transaction",
+		Long: "Reads a YAML list of {type, property, operator, value, description} entries and " +
+			"creates them all as constraints of the segment in a single transaction, avoiding a " +
+			"sequential round trip per constraint and visible intermediate states.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			segmentKey := args[0]
+
+			batch, err := readBatchConstraints(args[1])
+			if err != nil {
+				return err
+			}
+
+			reqs := make([]*flipt.CreateConstraintRequest, 0, len(batch))
+			for _, b := range batch {
+				t, err := parseComparisonType(b.Type)
+				if err != nil {
+					return err
+				}
+
+				reqs = append(reqs, &flipt.CreateConstraintRequest{
+					SegmentKey:   segmentKey,
+					NamespaceKey: rf.namespace,
+					Type:         t,
+					Property:     b.Property,
+					Operator:     b.Operator,
+					Value:        b.Value,
+					Description:  b.Description,
+				})
+			}
+
+			store, cleanup, err := openFlagStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			constraints, err := store.CreateConstraints(cmd.Context(), reqs)
+			if err != nil {
+				return fmt.Errorf("creating constraints: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "created %d constraint(s)\n", len(constraints))
+
+			return nil
+		},
+	}
+}
+
+func newConstraintUpdateBatchCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-batch <segment-key> <file>",
+		Short: "Update a batch of constraints for a segment in a single transaction",
+		Long: "Reads a YAML list of {id, type, property, operator, value, description} entries and " +
+			"updates them all as constraints of the segment in a single transaction.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			segmentKey := args[0]
+
+			batch, err := readBatchConstraints(args[1])
+			if err != nil {
+				return err
+			}
+
+			reqs := make([]*flipt.UpdateConstraintRequest, 0, len(batch))
+			for _, b := range batch {
+				if b.ID == "" {
+					return fmt.Errorf("constraint entry for property %q is missing an id", b.Property)
+				}
+
+				t, err := parseComparisonType(b.Type)
+				if err != nil {
+					return err
+				}
+
+				reqs = append(reqs, &flipt.UpdateConstraintRequest{
+					Id:           b.ID,
+					SegmentKey:   segmentKey,
+					NamespaceKey: rf.namespace,
+					Type:         t,
+					Property:     b.Property,
+					Operator:     b.Operator,
+					Value:        b.Value,
+					Description:  b.Description,
+				})
+			}
+
+			store, cleanup, err := openFlagStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			constraints, err := store.UpdateConstraints(cmd.Context(), reqs)
+			if err != nil {
+				return fmt.Errorf("updating constraints: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "updated %d constraint(s)\n", len(constraints))
+
+			return nil
+		},
+	}
+}
+
+func newConstraintDeleteBatchCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-batch <segment-key> <constraint-id>...",
+		Short: "Delete a batch of constraints from a segment in a single transaction",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			segmentKey, ids := args[0], args[1:]
+
+			reqs := make([]*flipt.DeleteConstraintRequest, 0, len(ids))
+			for _, id := range ids {
+				reqs = append(reqs, &flipt.DeleteConstraintRequest{
+					Id:           id,
+					SegmentKey:   segmentKey,
+					NamespaceKey: rf.namespace,
+				})
+			}
+
+			store, cleanup, err := openFlagStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			if err := store.DeleteConstraints(cmd.Context(), reqs); err != nil {
+				return fmt.Errorf("deleting constraints: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted %d constraint(s)\n", len(reqs))
+
+			return nil
+		},
+	}
+}
+
+func newConstraintOrderCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "order <segment-key> <constraint-id>...",
+		Short: "Explicitly reorder a segment's constraints",
+		Long:  "Reorders every constraint on the segment to match the given constraint ID order.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			segmentKey, ids := args[0], args[1:]
+
+			store, cleanup, err := openFlagStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			if err := store.OrderConstraints(cmd.Context(), &storage.OrderConstraintsRequest{
+				NamespaceKey:  rf.namespace,
+				SegmentKey:    segmentKey,
+				ConstraintIds: ids,
+			}); err != nil {
+				return fmt.Errorf("ordering constraints: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "reordered %d constraint(s)\n", len(ids))
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/fatih/color"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/storage/sql"
+)
+
+// maxClockSkew is how far apart the local clock and the database's clock
+// can be before doctor flags it, e.g. as a sign of a misconfigured NTP
+// daemon that would otherwise surface as confusing token/session expiry
+// behaviour.
+const maxClockSkew = 5 * time.Second
+
+type doctorStatus string
+
+const (
+	doctorOK   doctorStatus = "ok"
+	doctorWarn doctorStatus = "warn"
+	doctorFail doctorStatus = "fail"
+	doctorSkip doctorStatus = "skip"
+)
+
+type doctorResult struct {
+	Check   string       `json:"check"`
+	Status  doctorStatus `json:"status"`
+	Message string       `json:"message"`
+}
+
+func newDoctorCommand() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check for common configuration and connectivity problems",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger, cfg, err := buildConfig()
+			if err != nil {
+				return err
+			}
+
+			defer func() {
+				_ = logger.Sync()
+			}()
+
+			results := runDoctorChecks(cmd.Context(), cfg)
+
+			var failed bool
+			if output == outputJSON {
+				failed, err = printDoctorResultsJSON(cmd.OutOrStdout(), results)
+				if err != nil {
+					return err
+				}
+			} else {
+				failed = printDoctorResults(cmd.OutOrStdout(), results)
+			}
+
+			if failed {
+				os.Exit(1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&providedConfigFile, "config", "", "path to config file")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "output format: text, json")
+	return cmd
+}
+
+// runDoctorChecks runs every doctor check against cfg, in the order
+// they're reported, and returns their results. Checks do not depend on
+// one another, so a failure in one (e.g. storage connectivity) doesn't
+// prevent the others (e.g. cache reachability) from running.
+func runDoctorChecks(ctx context.Context, cfg *config.Config) []doctorResult {
+	return []doctorResult{
+		checkConfiguration(),
+		checkStorageConnectivity(ctx, cfg),
+		checkPendingMigrations(cfg),
+		checkClockSkew(ctx, cfg),
+		checkCacheReachability(ctx, cfg),
+		checkAuthenticationConfiguration(cfg),
+	}
+}
+
+// checkConfiguration re-surfaces the warnings collected the last time
+// the configuration file was loaded (see buildConfig), since a doctor
+// run always starts by loading configuration successfully.
+func checkConfiguration() doctorResult {
+	if len(loadedWarnings) == 0 {
+		return doctorResult{Check: "configuration", Status: doctorOK, Message: fmt.Sprintf("valid (%s)", configSource())}
+	}
+
+	msg := fmt.Sprintf("valid with %d warning(s) (%s):", len(loadedWarnings), configSource())
+	for _, w := range loadedWarnings {
+		msg += "\n    - " + w
+	}
+
+	return doctorResult{Check: "configuration", Status: doctorWarn, Message: msg}
+}
+
+func configSource() string {
+	if loadedConfigFound {
+		return loadedConfigPath
+	}
+
+	return "using defaults, no configuration file found"
+}
+
+func checkStorageConnectivity(ctx context.Context, cfg *config.Config) doctorResult {
+	const check = "storage connectivity"
+
+	switch cfg.Storage.Type {
+	case config.DatabaseStorageType, "":
+		db, _, err := sql.Open(*cfg)
+		if err != nil {
+			return doctorResult{Check: check, Status: doctorFail, Message: fmt.Sprintf("opening database: %v", err)}
+		}
+
+		defer db.Close()
+
+		pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		if err := db.PingContext(pingCtx); err != nil {
+			return doctorResult{Check: check, Status: doctorFail, Message: fmt.Sprintf("pinging database: %v", err)}
+		}
+
+		return doctorResult{Check: check, Status: doctorOK, Message: "connected to database"}
+	case config.LocalStorageType:
+		fi, err := os.Stat(cfg.Storage.Local.Path)
+		if err != nil {
+			return doctorResult{Check: check, Status: doctorFail, Message: fmt.Sprintf("reading local storage path %q: %v", cfg.Storage.Local.Path, err)}
+		}
+
+		if !fi.IsDir() {
+			return doctorResult{Check: check, Status: doctorFail, Message: fmt.Sprintf("local storage path %q is not a directory", cfg.Storage.Local.Path)}
+		}
+
+		return doctorResult{Check: check, Status: doctorOK, Message: fmt.Sprintf("local storage path %q is readable", cfg.Storage.Local.Path)}
+	case config.GitStorageType:
+		return doctorResult{Check: check, Status: doctorSkip, Message: fmt.Sprintf("not checked, git storage requires cloning %q", cfg.Storage.Git.Repository)}
+	case config.ObjectStorageType:
+		return doctorResult{Check: check, Status: doctorSkip, Message: "not checked, object storage connectivity depends on cloud provider credentials"}
+	default:
+		return doctorResult{Check: check, Status: doctorSkip, Message: fmt.Sprintf("unknown storage type %q", cfg.Storage.Type)}
+	}
+}
+
+func checkPendingMigrations(cfg *config.Config) doctorResult {
+	const check = "pending migrations"
+
+	if cfg.Storage.Type != config.DatabaseStorageType && cfg.Storage.Type != "" {
+		return doctorResult{Check: check, Status: doctorSkip, Message: "only applicable to database storage"}
+	}
+
+	migrator, err := sql.NewMigrator(*cfg, defaultLogger)
+	if err != nil {
+		return doctorResult{Check: check, Status: doctorFail, Message: fmt.Sprintf("opening migrator: %v", err)}
+	}
+
+	defer migrator.Close()
+
+	pending, current, expected, err := migrator.PendingMigrations()
+	if err != nil {
+		return doctorResult{Check: check, Status: doctorFail, Message: fmt.Sprintf("checking migrations: %v", err)}
+	}
+
+	if pending {
+		return doctorResult{Check: check, Status: doctorWarn, Message: fmt.Sprintf("migrations pending (at version %d, expected %d); run `flipt migrate`", current, expected)}
+	}
+
+	return doctorResult{Check: check, Status: doctorOK, Message: fmt.Sprintf("up to date (version %d)", current)}
+}
+
+// checkClockSkew compares the local clock against the database's clock,
+// since a drifting system clock can otherwise surface as confusing token
+// or session expiry behaviour.
+func checkClockSkew(ctx context.Context, cfg *config.Config) doctorResult {
+	const check = "clock skew"
+
+	if cfg.Storage.Type != config.DatabaseStorageType && cfg.Storage.Type != "" {
+		return doctorResult{Check: check, Status: doctorSkip, Message: "only checked against database storage"}
+	}
+
+	db, _, err := sql.Open(*cfg)
+	if err != nil {
+		return doctorResult{Check: check, Status: doctorSkip, Message: fmt.Sprintf("could not open database: %v", err)}
+	}
+
+	defer db.Close()
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	before := time.Now()
+
+	// scanned as a string rather than time.Time, since sqlite's driver
+	// returns CURRENT_TIMESTAMP as plain text rather than a typed value.
+	var raw string
+	if err := db.QueryRowContext(queryCtx, "SELECT CURRENT_TIMESTAMP").Scan(&raw); err != nil {
+		return doctorResult{Check: check, Status: doctorSkip, Message: fmt.Sprintf("could not read database time: %v", err)}
+	}
+
+	dbTime, err := parseDBTime(raw)
+	if err != nil {
+		return doctorResult{Check: check, Status: doctorSkip, Message: fmt.Sprintf("could not parse database time %q: %v", raw, err)}
+	}
+
+	// account for the round trip to the database when comparing clocks.
+	localTime := before.Add(time.Since(before) / 2)
+
+	skew := localTime.UTC().Sub(dbTime)
+	if skew < 0 {
+		skew = -skew
+	}
+
+	if skew > maxClockSkew {
+		return doctorResult{Check: check, Status: doctorWarn, Message: fmt.Sprintf("local clock differs from database clock by %s", skew.Round(time.Millisecond))}
+	}
+
+	return doctorResult{Check: check, Status: doctorOK, Message: fmt.Sprintf("within %s of database clock", skew.Round(time.Millisecond))}
+}
+
+// parseDBTime parses the value CURRENT_TIMESTAMP returns, which varies in
+// layout across the supported drivers (sqlite returns plain UTC text,
+// postgres/mysql return a value already parsed into time.Time by the
+// driver and reformatted here as RFC 3339 by database/sql).
+func parseDBTime(raw string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339, "2006-01-02 15:04:05.999999999-07:00", "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized time format")
+}
+
+func checkCacheReachability(ctx context.Context, cfg *config.Config) doctorResult {
+	const check = "cache reachability"
+
+	if !cfg.Cache.Enabled {
+		return doctorResult{Check: check, Status: doctorSkip, Message: "caching disabled"}
+	}
+
+	switch cfg.Cache.Backend {
+	case config.CacheMemory:
+		return doctorResult{Check: check, Status: doctorOK, Message: "in-memory cache requires no connectivity check"}
+	case config.CacheRedis:
+		var tlsConfig *tls.Config
+		if cfg.Cache.Redis.RequireTLS {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		rdb := goredis.NewClient(&goredis.Options{
+			Addr:        net.JoinHostPort(cfg.Cache.Redis.Host, strconv.Itoa(cfg.Cache.Redis.Port)),
+			TLSConfig:   tlsConfig,
+			Password:    cfg.Cache.Redis.Password,
+			DB:          cfg.Cache.Redis.DB,
+			DialTimeout: 5 * time.Second,
+		})
+
+		defer rdb.Close()
+
+		pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		if err := rdb.Ping(pingCtx).Err(); err != nil {
+			return doctorResult{Check: check, Status: doctorFail, Message: fmt.Sprintf("pinging redis at %s:%d: %v", cfg.Cache.Redis.Host, cfg.Cache.Redis.Port, err)}
+		}
+
+		return doctorResult{Check: check, Status: doctorOK, Message: fmt.Sprintf("connected to redis at %s:%d", cfg.Cache.Redis.Host, cfg.Cache.Redis.Port)}
+	default:
+		return doctorResult{Check: check, Status: doctorSkip, Message: fmt.Sprintf("unknown cache backend %q", cfg.Cache.Backend)}
+	}
+}
+
+func checkAuthenticationConfiguration(cfg *config.Config) doctorResult {
+	const check = "authentication configuration"
+
+	enabled := cfg.Authentication.Methods.EnabledMethods()
+
+	if !cfg.Authentication.Required {
+		if len(enabled) == 0 {
+			return doctorResult{Check: check, Status: doctorOK, Message: "authentication not required, no methods enabled"}
+		}
+
+		return doctorResult{Check: check, Status: doctorWarn, Message: "authentication methods enabled but not required; clients can skip authenticating entirely"}
+	}
+
+	if len(enabled) == 0 {
+		return doctorResult{Check: check, Status: doctorFail, Message: "authentication required but no methods are enabled; no client will be able to authenticate"}
+	}
+
+	names := make([]string, 0, len(enabled))
+	for _, m := range enabled {
+		names = append(names, m.Name())
+	}
+
+	return doctorResult{Check: check, Status: doctorOK, Message: fmt.Sprintf("required, enabled methods: %v", names)}
+}
+
+// printDoctorResults prints a line per check, returning true if any check
+// failed outright (as opposed to merely warning or being skipped).
+func printDoctorResults(w io.Writer, results []doctorResult) bool {
+	var failed bool
+
+	for _, r := range results {
+		var symbol string
+
+		switch r.Status {
+		case doctorOK:
+			symbol = color.GreenString("✓")
+		case doctorWarn:
+			symbol = color.YellowString("!")
+		case doctorFail:
+			symbol = color.RedString("✗")
+			failed = true
+		case doctorSkip:
+			symbol = color.New(color.Faint).Sprint("-")
+		}
+
+		fmt.Fprintf(w, "%s %s: %s\n", symbol, r.Check, r.Message)
+	}
+
+	return failed
+}
+
+// printDoctorResultsJSON prints results as a JSON array, for scripting
+// against doctor's output instead of parsing the human-readable symbols.
+func printDoctorResultsJSON(w io.Writer, results []doctorResult) (bool, error) {
+	var failed bool
+
+	for _, r := range results {
+		if r.Status == doctorFail {
+			failed = true
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return failed, enc.Encode(results)
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/internal/storage"
+	fliptsql "go.flipt.io/flipt/internal/storage/sql"
+	"go.flipt.io/flipt/internal/storage/sql/mysql"
+	"go.flipt.io/flipt/internal/storage/sql/postgres"
+	"go.flipt.io/flipt/internal/storage/sql/sqlite"
+	"go.flipt.io/flipt/rpc/flipt"
+	"gopkg.in/yaml.v2"
+)
+
+// newFlagDraftCommand returns the `flipt flag draft` commands, for staging a
+// flag's rules as a draft file and publishing them as a single atomic change,
+// so that evaluation never observes a partially updated rule set.
+func newFlagDraftCommand(rf *resourceFlags) *cobra.Command {
+	var draftsDir string
+
+	cmd := &cobra.Command{
+		Use:   "draft",
+		Short: "Manage draft flag rule states",
+	}
+
+	cmd.PersistentFlags().StringVar(&draftsDir, "drafts-dir", "flipt-drafts", "directory drafts are stored under, per namespace")
+	cmd.PersistentFlags().StringVar(&providedConfigFile, "config", "", "path to config file")
+
+	cmd.AddCommand(
+		newFlagDraftSaveCommand(rf, &draftsDir),
+		newFlagDraftPublishCommand(rf, &draftsDir),
+	)
+
+	return cmd
+}
+
+func draftPath(draftsDir, namespace, flagKey string) string {
+	return filepath.Join(draftsDir, namespace, flagKey+".yml")
+}
+
+// openFlagStore opens a direct connection to the configured database and
+// returns its storage.Store, for use by this CLI without going through a
+// running Flipt server, mirroring fliptServer's construction of a store per
+// dialect.
+func openFlagStore() (storage.Store, func(), error) {
+	logger, cfg, err := buildConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, driver, err := fliptsql.Open(*cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening db: %w", err)
+	}
+
+	builder := fliptsql.BuilderFor(db, driver, cfg.Database.PreparedStatementsEnabled)
+
+	var store storage.Store
+
+	switch driver {
+	case fliptsql.SQLite:
+		store = sqlite.NewStore(db, builder, logger)
+	case fliptsql.Postgres, fliptsql.CockroachDB:
+		store = postgres.NewStore(db, builder, logger)
+	case fliptsql.MySQL:
+		store = mysql.NewStore(db, builder, logger)
+	}
+
+	return store, func() { _ = db.Close() }, nil
+}
+
+func newFlagDraftSaveCommand(rf *resourceFlags, draftsDir *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save <flag-key>",
+		Short: "Save a flag's currently published rules as a draft file for editing",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			flag, err := client.GetFlag(cmd.Context(), &flipt.GetFlagRequest{Key: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("getting flag: %w", err)
+			}
+
+			rules, err := client.ListRules(cmd.Context(), &flipt.ListRuleRequest{FlagKey: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("listing rules: %w", err)
+			}
+
+			var draft []*ext.Rule
+
+			for _, r := range rules.Rules {
+				rule := &ext.Rule{Rank: uint(r.Rank)}
+
+				switch {
+				case len(r.SegmentKeys) > 0:
+					rule.Segment = &ext.SegmentEmbed{IsSegment: &ext.Segments{
+						Keys:            r.SegmentKeys,
+						SegmentOperator: r.SegmentOperator.String(),
+					}}
+				case r.SegmentKey != "":
+					rule.Segment = &ext.SegmentEmbed{IsSegment: ext.SegmentKey(r.SegmentKey)}
+				}
+
+				for _, d := range r.Distributions {
+					rule.Distributions = append(rule.Distributions, &ext.Distribution{
+						VariantKey: variantKeyByID(flag.Variants, d.VariantId),
+						Rollout:    d.Rollout,
+					})
+				}
+
+				draft = append(draft, rule)
+			}
+
+			path := draftPath(*draftsDir, rf.namespace, args[0])
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return fmt.Errorf("creating drafts directory: %w", err)
+			}
+
+			data, err := yaml.Marshal(draft)
+			if err != nil {
+				return fmt.Errorf("marshaling draft: %w", err)
+			}
+
+			if err := os.WriteFile(path, data, 0644); err != nil {
+				return fmt.Errorf("writing draft to %q: %w", path, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "saved draft for flag %q to %q\n", args[0], path)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newFlagDraftPublishCommand(rf *resourceFlags, draftsDir *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish <flag-key>",
+		Short: "Atomically replace a flag's published rules with its draft",
+		Long: "Reads the flag's draft file and publishes it by atomically replacing every " +
+			"rule (and distribution) currently attached to the flag, so that evaluation " +
+			"never sees the rule set half-updated.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := draftPath(*draftsDir, rf.namespace, args[0])
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("reading draft %q: %w", path, err)
+			}
+
+			var draft []*ext.Rule
+			if err := yaml.Unmarshal(data, &draft); err != nil {
+				return fmt.Errorf("unmarshaling draft: %w", err)
+			}
+
+			drafts := make([]storage.DraftRule, 0, len(draft))
+
+			for _, r := range draft {
+				dr := storage.DraftRule{}
+
+				if r.Segment != nil {
+					switch s := r.Segment.IsSegment.(type) {
+					case ext.SegmentKey:
+						dr.SegmentKey = string(s)
+					case *ext.Segments:
+						dr.SegmentKeys = s.Keys
+						dr.SegmentOperator = flipt.SegmentOperator(flipt.SegmentOperator_value[s.SegmentOperator])
+					}
+				}
+
+				for _, d := range r.Distributions {
+					dr.Distributions = append(dr.Distributions, storage.DraftDistribution{
+						VariantKey: d.VariantKey,
+						Rollout:    d.Rollout,
+					})
+				}
+
+				drafts = append(drafts, dr)
+			}
+
+			store, cleanup, err := openFlagStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			published, err := store.ReplaceRules(cmd.Context(), rf.namespace, args[0], drafts)
+			if err != nil {
+				return fmt.Errorf("publishing draft: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "published %d rule(s) for flag %q\n", len(published), args[0])
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -5,20 +5,26 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/internal/ext/flagd"
 	"go.flipt.io/flipt/rpc/flipt"
 	"go.uber.org/zap"
 )
 
 type exportCommand struct {
 	filename      string
+	dir           string
 	address       string
 	token         string
 	namespaces    string // comma delimited list of namespaces
 	allNamespaces bool
+	flagKeys      string // comma delimited list of flag keys
+	format        string // output format: "flipt" (default) or "flagd"
 }
 
 func newExportCommand() *cobra.Command {
@@ -37,6 +43,13 @@ func newExportCommand() *cobra.Command {
 		"export to filename (default STDOUT)",
 	)
 
+	cmd.Flags().StringVar(
+		&export.dir,
+		"dir",
+		"",
+		"export to a directory of per-namespace declarative files, compatible with the git/local storage backends (mutually exclusive with --output)",
+	)
+
 	cmd.Flags().StringVarP(
 		&export.address,
 		"address", "a",
@@ -72,9 +85,24 @@ func newExportCommand() *cobra.Command {
 		"export all namespaces. (mutually exclusive with --namespaces)",
 	)
 
+	cmd.Flags().StringVar(
+		&export.flagKeys,
+		"flag-key",
+		"",
+		"comma-delimited list of flag keys to export, excluding all other flags. (default exports all flags)",
+	)
+
+	cmd.Flags().StringVar(
+		&export.format,
+		"format",
+		"flipt",
+		"output format: \"flipt\" (the native declarative format) or \"flagd\" (flagd's JSON flag configuration format, mutually exclusive with --dir)",
+	)
+
 	cmd.Flags().StringVar(&providedConfigFile, "config", "", "path to config file")
 
 	cmd.MarkFlagsMutuallyExclusive("all-namespaces", "namespaces", "namespace")
+	cmd.MarkFlagsMutuallyExclusive("output", "dir")
 
 	// We can ignore the error here since "namespace" will be a flag that exists.
 	_ = cmd.Flags().MarkDeprecated("namespace", "please use namespaces instead")
@@ -83,6 +111,24 @@ func newExportCommand() *cobra.Command {
 }
 
 func (c *exportCommand) run(cmd *cobra.Command, _ []string) error {
+	if c.format != "flipt" && c.format != "flagd" {
+		return fmt.Errorf("unsupported format %q: expected \"flipt\" or \"flagd\"", c.format)
+	}
+
+	if c.dir != "" {
+		if c.format != "flipt" {
+			return fmt.Errorf("--format %q is not supported with --dir", c.format)
+		}
+
+		lister, cleanup, err := c.lister(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		return c.exportDir(cmd.Context(), lister)
+	}
+
 	var (
 		// default to stdout
 		out    io.Writer = os.Stdout
@@ -100,40 +146,112 @@ func (c *exportCommand) run(cmd *cobra.Command, _ []string) error {
 
 		defer fi.Close()
 
-		fmt.Fprintf(fi, "# exported by Flipt (%s) on %s\n\n", version, time.Now().UTC().Format(time.RFC3339))
+		// JSON does not support comments, so only annotate YAML output.
+		if c.format == "flipt" && filepath.Ext(c.filename) != ".json" {
+			fmt.Fprintf(fi, "# exported by Flipt (%s) on %s\n\n", version, time.Now().UTC().Format(time.RFC3339))
+		}
 
 		out = fi
 	}
 
-	// Use client when remote address is configured.
+	lister, cleanup, err := c.lister(cmd.Context())
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if c.format == "flagd" {
+		return flagd.NewExporter(lister, c.namespaces, c.allNamespaces).Export(cmd.Context(), out)
+	}
+
+	return c.export(cmd.Context(), out, lister, c.exportOpts(c.filename)...)
+}
+
+// lister resolves the ext.Lister to export from: a remote Flipt instance
+// when --address is set, otherwise a direct database connection built from
+// the local Flipt configuration file.
+func (c *exportCommand) lister(ctx context.Context) (ext.Lister, func(), error) {
 	if c.address != "" {
 		client, err := fliptClient(c.address, c.token)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
-		return c.export(cmd.Context(), out, client)
+		return client, func() {}, nil
 	}
 
-	// Otherwise, go direct to the DB using Flipt configuration file.
 	logger, cfg, err := buildConfig()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	defer func() {
+	server, cleanup, err := fliptServer(logger, cfg)
+	if err != nil {
 		_ = logger.Sync()
-	}()
+		return nil, nil, err
+	}
 
-	server, cleanup, err := fliptServer(logger, cfg)
+	return server, func() {
+		cleanup()
+		_ = logger.Sync()
+	}, nil
+}
+
+// exportOpts derives the exporter options to use for a single destination
+// file, e.g. selecting JSON encoding for a ".json" output file.
+func (c *exportCommand) exportOpts(filename string) []ext.ExportOpt {
+	opts := []ext.ExportOpt{ext.WithFlagKeys(c.flagKeySlice())}
+
+	if filepath.Ext(filename) == ".json" {
+		opts = append(opts, ext.WithEncoding(ext.EncodingJSON))
+	}
+
+	return opts
+}
+
+// flagKeySlice splits the comma-delimited --flag-key flag into a slice of
+// individual flag keys.
+func (c *exportCommand) flagKeySlice() []string {
+	if c.flagKeys == "" {
+		return nil
+	}
+
+	return strings.Split(c.flagKeys, ",")
+}
+
+func (c *exportCommand) export(ctx context.Context, dst io.Writer, lister ext.Lister, opts ...ext.ExportOpt) error {
+	return ext.NewExporter(lister, c.namespaces, c.allNamespaces, opts...).Export(ctx, dst)
+}
+
+// exportDir writes the exported state out as one declarative state file per
+// namespace under c.dir, in the layout expected by the git/local storage
+// backends (e.g. "<dir>/<namespace>/features.yml").
+func (c *exportCommand) exportDir(ctx context.Context, lister ext.Lister) error {
+	exporter := ext.NewExporter(lister, c.namespaces, c.allNamespaces, ext.WithFlagKeys(c.flagKeySlice()))
+
+	namespaces, err := exporter.Namespaces(ctx)
 	if err != nil {
 		return err
 	}
 
-	defer cleanup()
+	for _, namespace := range namespaces {
+		nsDir := filepath.Join(c.dir, namespace)
+		if err := os.MkdirAll(nsDir, 0o755); err != nil {
+			return fmt.Errorf("creating namespace directory: %w", err)
+		}
 
-	return c.export(cmd.Context(), out, server)
-}
+		filename := filepath.Join(nsDir, "features.yml")
+
+		fi, err := os.Create(filename)
+		if err != nil {
+			return fmt.Errorf("creating %q: %w", filename, err)
+		}
+
+		err = ext.NewExporter(lister, namespace, false, ext.WithFlagKeys(c.flagKeySlice())).Export(ctx, fi)
+		fi.Close()
+		if err != nil {
+			return fmt.Errorf("exporting namespace %q: %w", namespace, err)
+		}
+	}
 
-func (c *exportCommand) export(ctx context.Context, dst io.Writer, lister ext.Lister) error {
-	return ext.NewExporter(lister, c.namespaces, c.allNamespaces).Export(ctx, dst)
+	return nil
 }
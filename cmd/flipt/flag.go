@@ -0,0 +1,265 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/rpc/flipt"
+	"google.golang.org/protobuf/proto"
+)
+
+// newFlagCommand returns the `flipt flag` resource management commands,
+// for scripting flag changes against a remote Flipt instance without
+// hand-crafting requests against the API.
+func newFlagCommand() *cobra.Command {
+	rf := &resourceFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "flag",
+		Short: "Manage flags",
+	}
+
+	rf.register(cmd)
+
+	cmd.AddCommand(
+		newFlagListCommand(rf),
+		newFlagGetCommand(rf),
+		newFlagCreateCommand(rf),
+		newFlagUpdateCommand(rf),
+		newFlagDeleteCommand(rf),
+		newFlagRuleCommand(rf),
+		newFlagVariantCommand(rf),
+		newFlagKillSwitchCommand(rf),
+		newFlagTemplateCommand(rf),
+		newFlagDraftCommand(rf),
+		newFlagCodeRefsCommand(rf),
+	)
+
+	return cmd
+}
+
+func newFlagListCommand(rf *resourceFlags) *cobra.Command {
+	var withCounts bool
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List flags",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			list, err := client.ListFlags(cmd.Context(), &flipt.ListFlagRequest{NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("listing flags: %w", err)
+			}
+
+			var ruleCounts map[string]uint64
+
+			if withCounts {
+				store, closer, err := openFlagStore()
+				if err != nil {
+					return fmt.Errorf("opening store for counts: %w", err)
+				}
+				defer closer()
+
+				ruleCounts, err = store.CountRulesByFlag(cmd.Context(), rf.namespace)
+				if err != nil {
+					return fmt.Errorf("counting rules: %w", err)
+				}
+			}
+
+			msgs := make([]proto.Message, 0, len(list.Flags))
+			rows := make([][]string, 0, len(list.Flags))
+			header := []string{"KEY", "NAME", "TYPE", "ENABLED"}
+
+			for _, f := range list.Flags {
+				msgs = append(msgs, f)
+				row := []string{f.Key, f.Name, f.Type.String(), fmt.Sprintf("%t", f.Enabled)}
+				if withCounts {
+					row = append(row, fmt.Sprintf("%d", ruleCounts[f.Key]))
+				}
+				rows = append(rows, row)
+			}
+
+			if withCounts {
+				header = append(header, "RULES")
+			}
+
+			return rf.printResourceList(cmd.OutOrStdout(), msgs, header, rows)
+		},
+	}
+
+	cmd.Flags().BoolVar(&withCounts, "counts", false, "include rule counts per flag, computed in a single additional query")
+	cmd.Flags().StringVar(&providedConfigFile, "config", "", "path to config file, required when --counts is set")
+
+	return cmd
+}
+
+func newFlagGetCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <key>",
+		Short: "Get a flag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			f, err := client.GetFlag(cmd.Context(), &flipt.GetFlagRequest{Key: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("getting flag: %w", err)
+			}
+
+			return printFlag(cmd, rf, f)
+		},
+	}
+}
+
+func newFlagCreateCommand(rf *resourceFlags) *cobra.Command {
+	var (
+		name        string
+		description string
+		enabled     bool
+		flagType    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <key>",
+		Short: "Create a flag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			typ, err := parseFlagType(flagType)
+			if err != nil {
+				return err
+			}
+
+			f, err := client.CreateFlag(cmd.Context(), &flipt.CreateFlagRequest{
+				Key:          args[0],
+				Name:         name,
+				Description:  description,
+				Enabled:      enabled,
+				Type:         typ,
+				NamespaceKey: rf.namespace,
+			})
+			if err != nil {
+				return fmt.Errorf("creating flag: %w", err)
+			}
+
+			return printFlag(cmd, rf, f)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "flag name (defaults to key)")
+	cmd.Flags().StringVar(&description, "description", "", "flag description")
+	cmd.Flags().BoolVar(&enabled, "enabled", false, "enable the flag")
+	cmd.Flags().StringVar(&flagType, "type", "variant", "flag type: variant, boolean")
+
+	return cmd
+}
+
+func newFlagUpdateCommand(rf *resourceFlags) *cobra.Command {
+	var (
+		name        string
+		description string
+		enabled     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <key>",
+		Short: "Update a flag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetFlag(cmd.Context(), &flipt.GetFlagRequest{Key: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("getting flag: %w", err)
+			}
+
+			req := &flipt.UpdateFlagRequest{
+				Key:          args[0],
+				Name:         existing.Name,
+				Description:  existing.Description,
+				Enabled:      existing.Enabled,
+				NamespaceKey: rf.namespace,
+			}
+
+			if cmd.Flags().Changed("name") {
+				req.Name = name
+			}
+
+			if cmd.Flags().Changed("description") {
+				req.Description = description
+			}
+
+			if cmd.Flags().Changed("enabled") {
+				req.Enabled = enabled
+			}
+
+			f, err := client.UpdateFlag(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("updating flag: %w", err)
+			}
+
+			return printFlag(cmd, rf, f)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "flag name")
+	cmd.Flags().StringVar(&description, "description", "", "flag description")
+	cmd.Flags().BoolVar(&enabled, "enabled", false, "enable the flag")
+
+	return cmd
+}
+
+func newFlagDeleteCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <key>",
+		Short: "Delete a flag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteFlag(cmd.Context(), &flipt.DeleteFlagRequest{Key: args[0], NamespaceKey: rf.namespace}); err != nil {
+				return fmt.Errorf("deleting flag: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted flag %q\n", args[0])
+			return nil
+		},
+	}
+}
+
+func printFlag(cmd *cobra.Command, rf *resourceFlags, f *flipt.Flag) error {
+	fields := []string{"KEY", "NAME", "DESCRIPTION", "TYPE", "ENABLED", "NAMESPACE"}
+	values := []string{f.Key, f.Name, f.Description, f.Type.String(), fmt.Sprintf("%t", f.Enabled), f.NamespaceKey}
+
+	return rf.printResource(cmd.OutOrStdout(), f, fields, values)
+}
+
+func parseFlagType(s string) (flipt.FlagType, error) {
+	switch strings.ToLower(s) {
+	case "variant", "":
+		return flipt.FlagType_VARIANT_FLAG_TYPE, nil
+	case "boolean":
+		return flipt.FlagType_BOOLEAN_FLAG_TYPE, nil
+	default:
+		return 0, fmt.Errorf("unsupported flag type %q, must be one of: variant, boolean", s)
+	}
+}
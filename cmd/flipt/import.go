@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -9,13 +11,19 @@ import (
 
 	"github.com/spf13/cobra"
 	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/internal/ext/launchdarkly"
+	"go.flipt.io/flipt/internal/ext/unleash"
 	"go.flipt.io/flipt/internal/storage/sql"
 	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
 )
 
 type importCommand struct {
 	dropBeforeImport bool
 	importStdin      bool
+	dryRun           bool
+	from             string
+	environment      string
 	address          string
 	token            string
 }
@@ -43,6 +51,27 @@ func newImportCommand() *cobra.Command {
 		"import from STDIN",
 	)
 
+	cmd.Flags().BoolVar(
+		&importCmd.dryRun,
+		"dry-run",
+		false,
+		"show a diff of the changes the import would make without writing anything",
+	)
+
+	cmd.Flags().StringVar(
+		&importCmd.from,
+		"from",
+		"",
+		"source format to convert from before importing (supported: launchdarkly, unleash)",
+	)
+
+	cmd.Flags().StringVar(
+		&importCmd.environment,
+		"environment",
+		"production",
+		"source environment to import from, when --from is set",
+	)
+
 	cmd.Flags().StringVarP(
 		&importCmd.address,
 		"address", "a",
@@ -91,12 +120,26 @@ func (c *importCommand) run(cmd *cobra.Command, args []string) error {
 		in = fi
 	}
 
+	if c.from != "" {
+		converted, err := c.convert(in)
+		if err != nil {
+			return err
+		}
+
+		in = converted
+	}
+
 	// Use client when remote address is configured.
 	if c.address != "" {
 		client, err := fliptClient(c.address, c.token)
 		if err != nil {
 			return err
 		}
+
+		if c.dryRun {
+			return c.printDiff(cmd.Context(), client, in)
+		}
+
 		return ext.NewImporter(client).Import(cmd.Context(), in)
 	}
 
@@ -110,7 +153,7 @@ func (c *importCommand) run(cmd *cobra.Command, args []string) error {
 	}()
 
 	// drop tables if specified
-	if c.dropBeforeImport {
+	if c.dropBeforeImport && !c.dryRun {
 		logger.Debug("dropping tables")
 
 		migrator, err := sql.NewMigrator(*cfg, logger)
@@ -132,7 +175,7 @@ func (c *importCommand) run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := migrator.Up(forceMigrate); err != nil {
+	if err := migrator.Up(forceMigrate || cfg.Database.AutoMigrate); err != nil {
 		return err
 	}
 
@@ -148,7 +191,71 @@ func (c *importCommand) run(cmd *cobra.Command, args []string) error {
 
 	defer cleanup()
 
+	if c.dryRun {
+		return c.printDiff(cmd.Context(), server, in)
+	}
+
 	return ext.NewImporter(
 		server,
 	).Import(cmd.Context(), in)
 }
+
+// convert reads in as the format named by c.from and converts it into a
+// Flipt import document, printing a report of what was converted and
+// what had to be skipped along the way.
+func (c *importCommand) convert(in io.Reader) (io.Reader, error) {
+	var (
+		doc *ext.Document
+		err error
+	)
+
+	switch c.from {
+	case "launchdarkly":
+		var report *launchdarkly.Report
+
+		doc, report, err = launchdarkly.Convert(in, c.environment)
+		if err == nil {
+			report.Fprint(os.Stdout)
+		}
+	case "unleash":
+		var report *unleash.Report
+
+		doc, report, err = unleash.Convert(in, c.environment)
+		if err == nil {
+			report.Fprint(os.Stdout)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported --from format %q", c.from)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("converting %s export: %w", c.from, err)
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling converted document: %w", err)
+	}
+
+	return bytes.NewReader(out), nil
+}
+
+// printDiff prints the changes importing in would make, without applying
+// them, so the import can be reviewed before it's run for real.
+func (c *importCommand) printDiff(ctx context.Context, lister ext.Lister, in io.Reader) error {
+	diffs, err := ext.NewDiffer(lister).Diff(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	if len(diffs) == 0 {
+		fmt.Println("no changes")
+		return nil
+	}
+
+	for _, d := range diffs {
+		fmt.Println(d.String())
+	}
+
+	return nil
+}
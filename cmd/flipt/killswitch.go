@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+// killSwitchState records the flags that a `kill-switch disable-all` run
+// disabled, so that a subsequent `kill-switch restore` can put them back
+// exactly how it found them.
+type killSwitchState struct {
+	NamespaceKey string   `json:"namespaceKey"`
+	FlagKeys     []string `json:"flagKeys"`
+}
+
+func newFlagKillSwitchCommand(rf *resourceFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kill-switch",
+		Short: "Emergency disable/restore of all flags in a namespace",
+	}
+
+	cmd.AddCommand(
+		newFlagKillSwitchDisableAllCommand(rf),
+		newFlagKillSwitchRestoreCommand(rf),
+	)
+
+	return cmd
+}
+
+func newFlagKillSwitchDisableAllCommand(rf *resourceFlags) *cobra.Command {
+	var stateFile string
+
+	cmd := &cobra.Command{
+		Use:   "disable-all",
+		Short: "Disable every flag in a namespace, recording prior state for restore",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			state := killSwitchState{NamespaceKey: rf.namespace}
+
+			var pageToken string
+			for {
+				list, err := client.ListFlags(cmd.Context(), &flipt.ListFlagRequest{
+					NamespaceKey: rf.namespace,
+					PageToken:    pageToken,
+				})
+				if err != nil {
+					return fmt.Errorf("listing flags: %w", err)
+				}
+
+				for _, f := range list.Flags {
+					if !f.Enabled {
+						continue
+					}
+
+					if _, err := client.UpdateFlag(cmd.Context(), &flipt.UpdateFlagRequest{
+						Key:          f.Key,
+						Name:         f.Name,
+						Description:  f.Description,
+						Enabled:      false,
+						NamespaceKey: rf.namespace,
+					}); err != nil {
+						return fmt.Errorf("disabling flag %q: %w", f.Key, err)
+					}
+
+					state.FlagKeys = append(state.FlagKeys, f.Key)
+				}
+
+				pageToken = list.NextPageToken
+				if pageToken == "" {
+					break
+				}
+			}
+
+			data, err := json.MarshalIndent(state, "", "  ")
+			if err != nil {
+				return fmt.Errorf("marshaling kill-switch state: %w", err)
+			}
+
+			if err := os.WriteFile(stateFile, data, 0600); err != nil {
+				return fmt.Errorf("writing kill-switch state to %q: %w", stateFile, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "disabled %d flag(s) in namespace %q, prior state saved to %q\n", len(state.FlagKeys), rf.namespace, stateFile)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&stateFile, "state-file", "flipt-kill-switch.json", "file to record the disabled flags' prior state to, for restore")
+
+	return cmd
+}
+
+func newFlagKillSwitchRestoreCommand(rf *resourceFlags) *cobra.Command {
+	var stateFile string
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Re-enable the flags disabled by a prior kill-switch disable-all",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			data, err := os.ReadFile(stateFile)
+			if err != nil {
+				return fmt.Errorf("reading kill-switch state from %q: %w", stateFile, err)
+			}
+
+			var state killSwitchState
+			if err := json.Unmarshal(data, &state); err != nil {
+				return fmt.Errorf("unmarshaling kill-switch state: %w", err)
+			}
+
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			for _, key := range state.FlagKeys {
+				existing, err := client.GetFlag(cmd.Context(), &flipt.GetFlagRequest{Key: key, NamespaceKey: state.NamespaceKey})
+				if err != nil {
+					return fmt.Errorf("getting flag %q: %w", key, err)
+				}
+
+				if _, err := client.UpdateFlag(cmd.Context(), &flipt.UpdateFlagRequest{
+					Key:          key,
+					Name:         existing.Name,
+					Description:  existing.Description,
+					Enabled:      true,
+					NamespaceKey: state.NamespaceKey,
+				}); err != nil {
+					return fmt.Errorf("restoring flag %q: %w", key, err)
+				}
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "restored %d flag(s) in namespace %q\n", len(state.FlagKeys), state.NamespaceKey)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&stateFile, "state-file", "flipt-kill-switch.json", "file previously written by kill-switch disable-all")
+
+	return cmd
+}
@@ -21,7 +21,6 @@ import (
 	"go.flipt.io/flipt/internal/config"
 	"go.flipt.io/flipt/internal/info"
 	"go.flipt.io/flipt/internal/release"
-	"go.flipt.io/flipt/internal/telemetry"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/sync/errgroup"
@@ -64,6 +63,13 @@ var (
 	defaultLogger    = zap.Must(loggerConfig(defaultEncoding).Build())
 	userConfigDir, _ = os.UserConfigDir()
 	userConfigFile   = filepath.Join(userConfigDir, "flipt", "config.yml")
+
+	// set by buildConfig, so that run can watch the same configuration
+	// file for changes and adjust the same logger's level live.
+	loadedConfigPath  string
+	loadedConfigFound bool
+	loadedLogLevel    zap.AtomicLevel
+	loadedWarnings    []string
 )
 
 func loggerConfig(encoding zapcore.EncoderConfig) zap.Config {
@@ -140,6 +146,11 @@ func exec() error {
 	rootCmd.AddCommand(newConfigCommand())
 	rootCmd.AddCommand(newCompletionCommand())
 	rootCmd.AddCommand(newDocCommand())
+	rootCmd.AddCommand(newDoctorCommand())
+	rootCmd.AddCommand(newSeedCommand())
+	rootCmd.AddCommand(newFlagCommand())
+	rootCmd.AddCommand(newProposalCommand())
+	rootCmd.AddCommand(newSegmentCommand())
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -227,6 +238,11 @@ func buildConfig() (*zap.Logger, *config.Config, error) {
 		logger.Debug("configuration source", zap.String("path", path))
 	}
 
+	loadedConfigPath = path
+	loadedConfigFound = found
+	loadedLogLevel = loggerConfig.Level
+	loadedWarnings = res.Warnings
+
 	return logger, cfg, nil
 }
 
@@ -236,7 +252,7 @@ func run(ctx context.Context, logger *zap.Logger, cfg *config.Config) error {
 	if isConsole {
 		color.Cyan("%s\n", banner)
 	} else {
-		logger.Info("flipt starting", zap.String("version", version), zap.String("commit", commit), zap.String("date", date), zap.String("go_version", goVersion))
+		logger.Info("flipt starting", zap.String("version", version), zap.String("commit", commit), zap.String("date", date), zap.String("go_version", goVersion), zap.String("environment", cfg.Meta.Environment))
 	}
 
 	var (
@@ -308,26 +324,9 @@ func run(ctx context.Context, logger *zap.Logger, cfg *config.Config) error {
 		Arch:             goArch,
 	}
 
-	if cfg.Meta.TelemetryEnabled {
-		logger := logger.With(zap.String("component", "telemetry"))
-
-		g.Go(func() error {
-			reporter, err := telemetry.NewReporter(*cfg, logger, analyticsKey, info)
-			if err != nil {
-				logger.Debug("initializing telemetry reporter", zap.Error(err))
-				return nil
-			}
-
-			defer func() {
-				_ = reporter.Shutdown()
-			}()
+	startTelemetry(ctx, g, cfg, logger, info, analyticsKey)
 
-			reporter.Run(ctx)
-			return nil
-		})
-	}
-
-	grpcServer, err := cmd.NewGRPCServer(ctx, logger, cfg, info, forceMigrate)
+	grpcServer, err := cmd.NewGRPCServer(ctx, logger, cfg, info, forceMigrate, loadedWarnings)
 	if err != nil {
 		return err
 	}
@@ -341,7 +340,7 @@ func run(ctx context.Context, logger *zap.Logger, cfg *config.Config) error {
 		return err
 	}
 
-	httpServer, err := cmd.NewHTTPServer(ctx, logger, cfg, conn, info)
+	httpServer, err := cmd.NewHTTPServer(ctx, logger, cfg, conn, info, grpcServer.Refresher(), grpcServer.SSEHub())
 	if err != nil {
 		return err
 	}
@@ -349,13 +348,41 @@ func run(ctx context.Context, logger *zap.Logger, cfg *config.Config) error {
 	// starts REST http(s) server
 	g.Go(httpServer.Run)
 
+	// watch the configuration file (and SIGHUP) for changes, applying the
+	// small set of settings considered safe to reload live and reporting
+	// anything else that would require a restart to take effect.
+	if loadedConfigFound {
+		watcher := config.NewWatcher(logger, loadedConfigPath, cfg)
+		watcher.OnReload = func(cfg *config.Config, hot, restart []string) {
+			for _, key := range hot {
+				if key != "log.level" {
+					continue
+				}
+
+				level, err := zap.ParseAtomicLevel(cfg.Log.Level)
+				if err != nil {
+					logger.Warn("parsing reloaded log level", zap.String("level", cfg.Log.Level), zap.Error(err))
+					continue
+				}
+
+				loadedLogLevel.SetLevel(level.Level())
+			}
+
+			if len(restart) > 0 {
+				logger.Warn("configuration changed, restart flipt to apply", zap.Strings("keys", restart))
+			}
+		}
+
+		g.Go(func() error { return watcher.Run(ctx) })
+	}
+
 	// block until root context is cancelled
 	// and shutdown has been signalled
 	<-ctx.Done()
 
 	logger.Info("shutting down...")
 
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownGracePeriod)
 	defer shutdownCancel()
 
 	_ = httpServer.Shutdown(shutdownCtx)
@@ -36,6 +36,48 @@ func newMigrateCommand() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&providedConfigFile, "config", "", "path to config file")
+	cmd.AddCommand(newMigrateStatusCommand())
+	return cmd
+}
+
+func newMigrateStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Report the status of database migrations without applying them",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger, cfg, err := buildConfig()
+			if err != nil {
+				return err
+			}
+
+			defer func() {
+				_ = logger.Sync()
+			}()
+
+			migrator, err := sql.NewMigrator(*cfg, logger)
+			if err != nil {
+				return fmt.Errorf("initializing migrator %w", err)
+			}
+
+			defer migrator.Close()
+
+			pending, current, expected, err := migrator.PendingMigrations()
+			if err != nil {
+				return fmt.Errorf("checking migrations %w", err)
+			}
+
+			if pending {
+				fmt.Fprintf(cmd.OutOrStdout(), "migrations pending: current version %d, expected %d\n", current, expected)
+				fmt.Fprintln(cmd.OutOrStdout(), "run `flipt migrate` to apply them")
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "up to date: current version %d\n", current)
+			return nil
+		},
+	}
+
 	cmd.Flags().StringVar(&providedConfigFile, "config", "", "path to config file")
 	return cmd
 }
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/internal/storage"
+	storageproposal "go.flipt.io/flipt/internal/storage/proposal"
+	proposalsql "go.flipt.io/flipt/internal/storage/proposal/sql"
+	"go.flipt.io/flipt/internal/storage/sql"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+// newProposalCommand returns the `flipt proposal` commands, implementing a
+// change proposal / approval workflow: mutations are created as pending
+// proposals and must be approved by another user before being applied,
+// for use in controlled production namespaces.
+func newProposalCommand() *cobra.Command {
+	var namespace string
+
+	cmd := &cobra.Command{
+		Use:   "proposal",
+		Short: "Manage change proposals requiring approval before being applied",
+	}
+
+	cmd.PersistentFlags().StringVarP(&namespace, "namespace", "n", "default", "namespace of the proposal")
+	cmd.PersistentFlags().StringVar(&providedConfigFile, "config", "", "path to config file")
+
+	cmd.AddCommand(
+		newProposalProposeUpdateFlagCommand(&namespace),
+		newProposalListCommand(&namespace),
+		newProposalApproveCommand(),
+		newProposalRejectCommand(),
+	)
+
+	return cmd
+}
+
+// openProposalStore opens a direct connection to the configured database and
+// returns its proposal store, for use by this CLI without going through a
+// running Flipt server.
+func openProposalStore() (*proposalsql.Store, func(), error) {
+	_, cfg, err := buildConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, driver, err := sql.Open(*cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening db: %w", err)
+	}
+
+	builder := sql.BuilderFor(db, driver, cfg.Database.PreparedStatementsEnabled)
+
+	return proposalsql.NewStore(driver, builder), func() { _ = db.Close() }, nil
+}
+
+func newProposalProposeUpdateFlagCommand(namespace *string) *cobra.Command {
+	var (
+		name        string
+		description string
+		enabled     bool
+		proposedBy  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "propose-update-flag <key>",
+		Short: "Propose a flag update, pending approval",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, cleanup, err := openProposalStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			p, err := store.CreateProposal(cmd.Context(), &storageproposal.CreateProposalRequest{
+				NamespaceKey: *namespace,
+				FlagKey:      args[0],
+				Action:       storageproposal.ActionUpdateFlag,
+				Payload: storageproposal.UpdateFlagPayload{
+					Name:        name,
+					Description: description,
+					Enabled:     enabled,
+				},
+				ProposedBy: proposedBy,
+			})
+			if err != nil {
+				return fmt.Errorf("creating proposal: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "created proposal %q, pending approval\n", p.ID)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "proposed flag name")
+	cmd.Flags().StringVar(&description, "description", "", "proposed flag description")
+	cmd.Flags().BoolVar(&enabled, "enabled", false, "proposed flag enabled state")
+	cmd.Flags().StringVar(&proposedBy, "proposed-by", "", "identity of the person proposing this change")
+
+	return cmd
+}
+
+func newProposalListCommand(namespace *string) *cobra.Command {
+	var status string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List change proposals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			store, cleanup, err := openProposalStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			opts := []storage.ListOption[storageproposal.ListProposalsPredicate]{
+				storageproposal.ListWithNamespaceKey(*namespace),
+			}
+
+			if status != "" {
+				opts = append(opts, storageproposal.ListWithStatus(storageproposal.Status(status)))
+			}
+
+			set, err := store.ListProposals(cmd.Context(), storage.NewListRequest(opts...))
+			if err != nil {
+				return fmt.Errorf("listing proposals: %w", err)
+			}
+
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(tw, "ID\tFLAG\tACTION\tSTATUS\tPROPOSED BY")
+
+			for _, p := range set.Results {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", p.ID, p.FlagKey, p.Action, p.Status, p.ProposedBy)
+			}
+
+			return tw.Flush()
+		},
+	}
+
+	cmd.Flags().StringVar(&status, "status", "", "filter by status (pending, approved, rejected)")
+
+	return cmd
+}
+
+func newProposalApproveCommand() *cobra.Command {
+	return newProposalReviewCommand("approve", storageproposal.StatusApproved)
+}
+
+func newProposalRejectCommand() *cobra.Command {
+	return newProposalReviewCommand("reject", storageproposal.StatusRejected)
+}
+
+// newProposalReviewCommand builds the `approve`/`reject` commands, which only
+// differ in the resulting Status and whether the underlying change is
+// actually applied.
+func newProposalReviewCommand(use string, status storageproposal.Status) *cobra.Command {
+	var reviewedBy string
+
+	short := "Reject a pending proposal"
+	if status == storageproposal.StatusApproved {
+		short = "Approve a pending proposal and apply its change"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " <id>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, cleanup, err := openProposalStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			p, err := store.ReviewProposal(cmd.Context(), &storageproposal.ReviewProposalRequest{
+				ID:         args[0],
+				Status:     status,
+				ReviewedBy: reviewedBy,
+			})
+			if err != nil {
+				return fmt.Errorf("reviewing proposal: %w", err)
+			}
+
+			if status == storageproposal.StatusApproved {
+				if err := applyProposal(cmd, p); err != nil {
+					return fmt.Errorf("applying approved proposal: %w", err)
+				}
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "proposal %q %s\n", p.ID, p.Status)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&reviewedBy, "reviewed-by", "", "identity of the person reviewing this proposal")
+
+	return cmd
+}
+
+// applyProposal dispatches an approved proposal to the storage operation its
+// Action describes.
+func applyProposal(cmd *cobra.Command, p *storageproposal.Proposal) error {
+	logger, cfg, err := buildConfig()
+	if err != nil {
+		return err
+	}
+
+	server, cleanup, err := fliptServer(logger, cfg)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	switch p.Action {
+	case storageproposal.ActionUpdateFlag:
+		payload, ok := p.Payload.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected payload type %T for action %q", p.Payload, p.Action)
+		}
+
+		existing, err := server.GetFlag(cmd.Context(), &flipt.GetFlagRequest{Key: p.FlagKey, NamespaceKey: p.NamespaceKey})
+		if err != nil {
+			return fmt.Errorf("getting flag: %w", err)
+		}
+
+		req := &flipt.UpdateFlagRequest{
+			Key:          p.FlagKey,
+			NamespaceKey: p.NamespaceKey,
+			Name:         existing.Name,
+			Description:  existing.Description,
+			Enabled:      existing.Enabled,
+		}
+
+		if v, ok := payload["name"].(string); ok && v != "" {
+			req.Name = v
+		}
+
+		if v, ok := payload["description"].(string); ok && v != "" {
+			req.Description = v
+		}
+
+		if v, ok := payload["enabled"].(bool); ok {
+			req.Enabled = v
+		}
+
+		_, err = server.UpdateFlag(cmd.Context(), req)
+		return err
+	default:
+		return fmt.Errorf("unsupported proposal action: %q", p.Action)
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	sdk "go.flipt.io/flipt/sdk/go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+)
+
+// resourceFlags holds the connection and formatting flags shared by the
+// resource management commands (flag, segment, ...), so flag/key/enabled
+// style flags stay consistent across resource types.
+type resourceFlags struct {
+	address   string
+	token     string
+	namespace string
+	output    string
+}
+
+func (r *resourceFlags) register(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&r.address, "address", "a", "http://localhost:8080", "address of Flipt instance to manage")
+	cmd.PersistentFlags().StringVarP(&r.token, "token", "t", "", "client token used to authenticate access to Flipt instance")
+	cmd.PersistentFlags().StringVarP(&r.namespace, "namespace", "n", "default", "namespace of the resource")
+	cmd.PersistentFlags().StringVarP(&r.output, "output", "o", outputTable, "output format: table, json")
+}
+
+func (r *resourceFlags) client() (*sdk.Flipt, error) {
+	return fliptClient(r.address, r.token)
+}
+
+// printResource prints a single proto message in the configured format.
+// Table output for a single resource is rendered as a two-column
+// field/value table, since a one-row table of a dozen columns is
+// unreadable on a terminal.
+func (r *resourceFlags) printResource(w io.Writer, msg proto.Message, fields, values []string) error {
+	if r.output == outputJSON {
+		return printJSON(w, msg)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for i, field := range fields {
+		fmt.Fprintf(tw, "%s\t%s\n", field, values[i])
+	}
+
+	return tw.Flush()
+}
+
+// printResourceList prints a list of resources in the configured format.
+func (r *resourceFlags) printResourceList(w io.Writer, msgs []proto.Message, header []string, rows [][]string) error {
+	if r.output == outputJSON {
+		return printJSONList(w, msgs)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, tabHeader(header))
+	for _, row := range rows {
+		fmt.Fprintln(tw, tabHeader(row))
+	}
+
+	return tw.Flush()
+}
+
+func tabHeader(cols []string) string {
+	out := ""
+	for i, c := range cols {
+		if i > 0 {
+			out += "\t"
+		}
+		out += c
+	}
+
+	return out
+}
+
+func printJSON(w io.Writer, msg proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshaling response: %w", err)
+	}
+
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+func printJSONList(w io.Writer, msgs []proto.Message) error {
+	fmt.Fprintln(w, "[")
+
+	for i, msg := range msgs {
+		data, err := protojson.MarshalOptions{Indent: "  "}.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("marshaling response: %w", err)
+		}
+
+		suffix := ","
+		if i == len(msgs)-1 {
+			suffix = ""
+		}
+
+		fmt.Fprintf(w, "  %s%s\n", data, suffix)
+	}
+
+	fmt.Fprintln(w, "]")
+	return nil
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/internal/storage"
+	"go.flipt.io/flipt/rpc/flipt"
+	"google.golang.org/protobuf/proto"
+)
+
+// newFlagRuleCommand returns the `flipt flag rule` subcommands for
+// managing a flag's rules and their distributions, which otherwise have
+// no standalone `flipt <resource>` of their own since they only make
+// sense scoped to a flag.
+func newFlagRuleCommand(rf *resourceFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rule",
+		Short: "Manage a flag's rules",
+	}
+
+	cmd.AddCommand(
+		newRuleListCommand(rf),
+		newRuleCreateCommand(rf),
+		newRuleDeleteCommand(rf),
+		newDistributionCommand(rf),
+	)
+
+	return cmd
+}
+
+func newRuleListCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <flag-key>",
+		Short: "List a flag's rules",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			list, err := client.ListRules(cmd.Context(), &flipt.ListRuleRequest{FlagKey: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("listing rules: %w", err)
+			}
+
+			msgs := make([]proto.Message, 0, len(list.Rules))
+			rows := make([][]string, 0, len(list.Rules))
+
+			for _, r := range list.Rules {
+				msgs = append(msgs, r)
+				rows = append(rows, []string{r.Id, fmt.Sprintf("%d", r.Rank), ruleSegments(r), r.SegmentOperator.String()})
+			}
+
+			return rf.printResourceList(cmd.OutOrStdout(), msgs, []string{"ID", "RANK", "SEGMENTS", "OPERATOR"}, rows)
+		},
+	}
+}
+
+func newRuleCreateCommand(rf *resourceFlags) *cobra.Command {
+	var (
+		segments []string
+		operator string
+		rank     int32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <flag-key>",
+		Short: "Create a rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			op, err := parseSegmentOperator(operator)
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("rank") {
+				existing, err := client.ListRules(cmd.Context(), &flipt.ListRuleRequest{FlagKey: args[0], NamespaceKey: rf.namespace})
+				if err != nil {
+					return fmt.Errorf("listing existing rules: %w", err)
+				}
+
+				rank = int32(len(existing.Rules)) + 1
+			}
+
+			r, err := client.CreateRule(cmd.Context(), &flipt.CreateRuleRequest{
+				FlagKey:         args[0],
+				SegmentKeys:     segments,
+				SegmentOperator: op,
+				Rank:            rank,
+				NamespaceKey:    rf.namespace,
+			})
+			if err != nil {
+				return fmt.Errorf("creating rule: %w", err)
+			}
+
+			fields := []string{"ID", "FLAG", "RANK", "SEGMENTS", "OPERATOR"}
+			values := []string{r.Id, r.FlagKey, fmt.Sprintf("%d", r.Rank), ruleSegments(r), r.SegmentOperator.String()}
+
+			return rf.printResource(cmd.OutOrStdout(), r, fields, values)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&segments, "segment", nil, "segment key to match against (repeatable)")
+	cmd.Flags().StringVar(&operator, "segment-operator", "or", "how multiple --segment values combine: or, and")
+	cmd.Flags().Int32Var(&rank, "rank", 0, "rule evaluation order, lowest first (defaults to the next available rank)")
+	_ = cmd.MarkFlagRequired("segment")
+
+	return cmd
+}
+
+func newRuleDeleteCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <flag-key> <rule-id>",
+		Short: "Delete a rule",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteRule(cmd.Context(), &flipt.DeleteRuleRequest{FlagKey: args[0], Id: args[1], NamespaceKey: rf.namespace}); err != nil {
+				return fmt.Errorf("deleting rule: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted rule %q\n", args[1])
+			return nil
+		},
+	}
+}
+
+func newDistributionCommand(rf *resourceFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution",
+		Short: "Manage a rule's variant distributions",
+	}
+
+	cmd.AddCommand(newDistributionCreateCommand(rf), newDistributionDeleteCommand(rf), newDistributionNormalizeCommand(rf))
+
+	cmd.PersistentFlags().StringVar(&providedConfigFile, "config", "", "path to config file")
+
+	return cmd
+}
+
+func newDistributionCreateCommand(rf *resourceFlags) *cobra.Command {
+	var (
+		variant string
+		rollout float32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <flag-key> <rule-id>",
+		Short: "Create a variant distribution for a rule",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			flagKey, ruleID := args[0], args[1]
+
+			f, err := client.GetFlag(cmd.Context(), &flipt.GetFlagRequest{Key: flagKey, NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("getting flag: %w", err)
+			}
+
+			variantID, err := variantIDByKey(f, variant)
+			if err != nil {
+				return err
+			}
+
+			d, err := client.CreateDistribution(cmd.Context(), &flipt.CreateDistributionRequest{
+				FlagKey:      flagKey,
+				RuleId:       ruleID,
+				VariantId:    variantID,
+				Rollout:      rollout,
+				NamespaceKey: rf.namespace,
+			})
+			if err != nil {
+				return fmt.Errorf("creating distribution: %w", err)
+			}
+
+			fields := []string{"ID", "RULE", "VARIANT", "ROLLOUT"}
+			values := []string{d.Id, d.RuleId, variant, fmt.Sprintf("%.2f%%", d.Rollout)}
+
+			return rf.printResource(cmd.OutOrStdout(), d, fields, values)
+		},
+	}
+
+	cmd.Flags().StringVar(&variant, "variant", "", "variant key to distribute to")
+	cmd.Flags().Float32Var(&rollout, "rollout", 0, "percentage of matching traffic to distribute to this variant")
+	_ = cmd.MarkFlagRequired("variant")
+
+	return cmd
+}
+
+func newDistributionDeleteCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <flag-key> <rule-id> <distribution-id>",
+		Short: "Delete a variant distribution",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			req := &flipt.DeleteDistributionRequest{
+				FlagKey:      args[0],
+				RuleId:       args[1],
+				Id:           args[2],
+				NamespaceKey: rf.namespace,
+			}
+
+			if err := client.DeleteDistribution(cmd.Context(), req); err != nil {
+				return fmt.Errorf("deleting distribution: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted distribution %q\n", args[2])
+			return nil
+		},
+	}
+}
+
+// newDistributionNormalizeCommand rebalances a rule's distributions to an
+// explicit set of weights in a single transaction, requiring direct store
+// access since it isn't exposed over gRPC.
+func newDistributionNormalizeCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "normalize <flag-key> <rule-id> <variant>=<rollout>...",
+		Short: "Rebalance a rule's distributions to the given variant weights",
+		Long: "Replaces every distribution on the rule with the given variant=rollout " +
+			"weights atomically, rejecting the request if the weights sum to over 100, " +
+			"rather than issuing a create/update/delete call per variant that could " +
+			"transiently sum over 100%.",
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flagKey, ruleID, pairs := args[0], args[1], args[2:]
+
+			store, cleanup, err := openFlagStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			f, err := store.GetFlag(cmd.Context(), rf.namespace, flagKey)
+			if err != nil {
+				return fmt.Errorf("getting flag: %w", err)
+			}
+
+			weights := make([]storage.DistributionWeight, 0, len(pairs))
+			for _, pair := range pairs {
+				variant, rolloutStr, ok := strings.Cut(pair, "=")
+				if !ok {
+					return fmt.Errorf("invalid weight %q, expected <variant>=<rollout>", pair)
+				}
+
+				rollout, err := strconv.ParseFloat(rolloutStr, 32)
+				if err != nil {
+					return fmt.Errorf("invalid rollout in %q: %w", pair, err)
+				}
+
+				variantID, err := variantIDByKey(f, variant)
+				if err != nil {
+					return err
+				}
+
+				weights = append(weights, storage.DistributionWeight{VariantId: variantID, Rollout: float32(rollout)})
+			}
+
+			distributions, err := store.NormalizeDistributions(cmd.Context(), &storage.NormalizeDistributionsRequest{
+				NamespaceKey: rf.namespace,
+				RuleId:       ruleID,
+				Weights:      weights,
+			})
+			if err != nil {
+				return fmt.Errorf("normalizing distributions: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "normalized %d distribution(s)\n", len(distributions))
+
+			return nil
+		},
+	}
+}
+
+// ruleSegments renders a rule's matched segments, falling back to the
+// deprecated singular SegmentKey field since the store only populates
+// SegmentKeys when a rule matches more than one segment.
+func ruleSegments(r *flipt.Rule) string {
+	if len(r.SegmentKeys) > 0 {
+		return strings.Join(r.SegmentKeys, ",")
+	}
+
+	return r.SegmentKey
+}
+
+func variantIDByKey(f *flipt.Flag, key string) (string, error) {
+	for _, v := range f.Variants {
+		if v.Key == key {
+			return v.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("no variant %q found on flag %q", key, f.Key)
+}
+
+func parseSegmentOperator(s string) (flipt.SegmentOperator, error) {
+	switch strings.ToLower(s) {
+	case "or", "":
+		return flipt.SegmentOperator_OR_SEGMENT_OPERATOR, nil
+	case "and":
+		return flipt.SegmentOperator_AND_SEGMENT_OPERATOR, nil
+	default:
+		return 0, fmt.Errorf("unsupported segment operator %q, must be one of: or, and", s)
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/internal/ext/seed"
+	"gopkg.in/yaml.v2"
+)
+
+type seedCommand struct {
+	namespaces    int
+	flags         int
+	segments      int
+	rules         int
+	seed          int64
+	deterministic bool
+}
+
+func newSeedCommand() *cobra.Command {
+	s := &seedCommand{}
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Generate test data directly into Flipt's storage, for load testing and demos",
+		RunE:  s.run,
+	}
+
+	cmd.Flags().IntVar(&s.namespaces, "namespaces", 1, "number of namespaces to generate")
+	cmd.Flags().IntVar(&s.flags, "flags", 10, "number of flags to generate per namespace")
+	cmd.Flags().IntVar(&s.segments, "segments", 5, "number of segments to generate per namespace")
+	cmd.Flags().IntVar(&s.rules, "rules", 2, "number of rules/rollouts to generate per flag")
+	cmd.Flags().Int64Var(&s.seed, "seed", 0, "seed for deterministic generation (used only with --deterministic)")
+	cmd.Flags().BoolVar(&s.deterministic, "deterministic", false, "generate the same data across runs, using --seed")
+
+	cmd.Flags().StringVar(&providedConfigFile, "config", "", "path to config file")
+	return cmd
+}
+
+func (s *seedCommand) run(cmd *cobra.Command, _ []string) error {
+	logger, cfg, err := buildConfig()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	server, cleanup, err := fliptServer(logger, cfg)
+	if err != nil {
+		return err
+	}
+
+	defer cleanup()
+
+	opts := seed.Options{
+		Namespaces: s.namespaces,
+		Flags:      s.flags,
+		Segments:   s.segments,
+		Rules:      s.rules,
+	}
+
+	if s.deterministic {
+		opts.Seed = &s.seed
+	}
+
+	docs := seed.Generate(opts)
+
+	importer := ext.NewImporter(server)
+
+	for _, doc := range docs {
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return fmt.Errorf("marshaling generated document: %w", err)
+		}
+
+		if err := importer.Import(cmd.Context(), bytes.NewReader(out)); err != nil {
+			return fmt.Errorf("seeding namespace %q: %w", doc.Namespace, err)
+		}
+	}
+
+	fmt.Printf(
+		"seeded %d namespace(s), %d flag(s) and %d segment(s) per namespace\n",
+		len(docs), s.flags, s.segments,
+	)
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/rpc/flipt"
+	"google.golang.org/protobuf/proto"
+)
+
+// newSegmentCommand returns the `flipt segment` resource management
+// commands, for scripting segment changes against a remote Flipt
+// instance without hand-crafting requests against the API.
+func newSegmentCommand() *cobra.Command {
+	rf := &resourceFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "segment",
+		Short: "Manage segments",
+	}
+
+	rf.register(cmd)
+
+	cmd.AddCommand(
+		newSegmentListCommand(rf),
+		newSegmentGetCommand(rf),
+		newSegmentCreateCommand(rf),
+		newSegmentUpdateCommand(rf),
+		newSegmentDeleteCommand(rf),
+		newSegmentConstraintCommand(rf),
+	)
+
+	return cmd
+}
+
+func newSegmentListCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List segments",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			list, err := client.ListSegments(cmd.Context(), &flipt.ListSegmentRequest{NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("listing segments: %w", err)
+			}
+
+			msgs := make([]proto.Message, 0, len(list.Segments))
+			rows := make([][]string, 0, len(list.Segments))
+
+			for _, s := range list.Segments {
+				msgs = append(msgs, s)
+				rows = append(rows, []string{s.Key, s.Name, s.MatchType.String(), fmt.Sprintf("%d", len(s.Constraints))})
+			}
+
+			return rf.printResourceList(cmd.OutOrStdout(), msgs, []string{"KEY", "NAME", "MATCH", "CONSTRAINTS"}, rows)
+		},
+	}
+}
+
+func newSegmentGetCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <key>",
+		Short: "Get a segment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			s, err := client.GetSegment(cmd.Context(), &flipt.GetSegmentRequest{Key: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("getting segment: %w", err)
+			}
+
+			return printSegment(cmd, rf, s)
+		},
+	}
+}
+
+func newSegmentCreateCommand(rf *resourceFlags) *cobra.Command {
+	var (
+		name        string
+		description string
+		matchType   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <key>",
+		Short: "Create a segment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			mt, err := parseMatchType(matchType)
+			if err != nil {
+				return err
+			}
+
+			s, err := client.CreateSegment(cmd.Context(), &flipt.CreateSegmentRequest{
+				Key:          args[0],
+				Name:         name,
+				Description:  description,
+				MatchType:    mt,
+				NamespaceKey: rf.namespace,
+			})
+			if err != nil {
+				return fmt.Errorf("creating segment: %w", err)
+			}
+
+			return printSegment(cmd, rf, s)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "segment name (defaults to key)")
+	cmd.Flags().StringVar(&description, "description", "", "segment description")
+	cmd.Flags().StringVar(&matchType, "match-type", "all", "constraint match type: all, any")
+
+	return cmd
+}
+
+func newSegmentUpdateCommand(rf *resourceFlags) *cobra.Command {
+	var (
+		name        string
+		description string
+		matchType   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <key>",
+		Short: "Update a segment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetSegment(cmd.Context(), &flipt.GetSegmentRequest{Key: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("getting segment: %w", err)
+			}
+
+			req := &flipt.UpdateSegmentRequest{
+				Key:          args[0],
+				Name:         existing.Name,
+				Description:  existing.Description,
+				MatchType:    existing.MatchType,
+				NamespaceKey: rf.namespace,
+			}
+
+			if cmd.Flags().Changed("name") {
+				req.Name = name
+			}
+
+			if cmd.Flags().Changed("description") {
+				req.Description = description
+			}
+
+			if cmd.Flags().Changed("match-type") {
+				mt, err := parseMatchType(matchType)
+				if err != nil {
+					return err
+				}
+
+				req.MatchType = mt
+			}
+
+			s, err := client.UpdateSegment(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("updating segment: %w", err)
+			}
+
+			return printSegment(cmd, rf, s)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "segment name")
+	cmd.Flags().StringVar(&description, "description", "", "segment description")
+	cmd.Flags().StringVar(&matchType, "match-type", "all", "constraint match type: all, any")
+
+	return cmd
+}
+
+func newSegmentDeleteCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <key>",
+		Short: "Delete a segment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteSegment(cmd.Context(), &flipt.DeleteSegmentRequest{Key: args[0], NamespaceKey: rf.namespace}); err != nil {
+				return fmt.Errorf("deleting segment: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted segment %q\n", args[0])
+			return nil
+		},
+	}
+}
+
+func printSegment(cmd *cobra.Command, rf *resourceFlags, s *flipt.Segment) error {
+	fields := []string{"KEY", "NAME", "DESCRIPTION", "MATCH", "CONSTRAINTS", "NAMESPACE"}
+	values := []string{s.Key, s.Name, s.Description, s.MatchType.String(), fmt.Sprintf("%d", len(s.Constraints)), s.NamespaceKey}
+
+	return rf.printResource(cmd.OutOrStdout(), s, fields, values)
+}
+
+func parseMatchType(s string) (flipt.MatchType, error) {
+	switch strings.ToLower(s) {
+	case "all", "":
+		return flipt.MatchType_ALL_MATCH_TYPE, nil
+	case "any":
+		return flipt.MatchType_ANY_MATCH_TYPE, nil
+	default:
+		return 0, fmt.Errorf("unsupported match type %q, must be one of: all, any", s)
+	}
+}
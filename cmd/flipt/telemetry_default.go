@@ -0,0 +1,38 @@
+//go:build !notelemetry
+// +build !notelemetry
+
+package main
+
+import (
+	"context"
+
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/info"
+	"go.flipt.io/flipt/internal/telemetry"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// startTelemetry runs the telemetry reporter in the background, if enabled.
+func startTelemetry(ctx context.Context, g *errgroup.Group, cfg *config.Config, logger *zap.Logger, info info.Flipt, analyticsKey string) {
+	if !cfg.Meta.TelemetryEnabled {
+		return
+	}
+
+	logger = logger.With(zap.String("component", "telemetry"))
+
+	g.Go(func() error {
+		reporter, err := telemetry.NewReporter(*cfg, logger, analyticsKey, info)
+		if err != nil {
+			logger.Debug("initializing telemetry reporter", zap.Error(err))
+			return nil
+		}
+
+		defer func() {
+			_ = reporter.Shutdown()
+		}()
+
+		reporter.Run(ctx)
+		return nil
+	})
+}
@@ -0,0 +1,20 @@
+//go:build notelemetry
+// +build notelemetry
+
+package main
+
+import (
+	"context"
+
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/info"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// startTelemetry is a no-op when Flipt is built with the "notelemetry" tag.
+// This compiles the telemetry package, and its outbound analytics
+// dependency, out of the binary entirely, for air-gapped deployments whose
+// security scans flag any code capable of making outbound network calls.
+func startTelemetry(ctx context.Context, g *errgroup.Group, cfg *config.Config, logger *zap.Logger, info info.Flipt, analyticsKey string) {
+}
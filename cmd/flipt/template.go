@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/rpc/flipt"
+	"gopkg.in/yaml.v2"
+)
+
+// newFlagTemplateCommand returns the `flipt flag template` commands, for
+// standardizing flag structure (variants, default rules, metadata) across
+// a namespace by saving a flag as a reusable template and creating new
+// flags from it.
+func newFlagTemplateCommand(rf *resourceFlags) *cobra.Command {
+	var templatesDir string
+
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manage flag templates",
+	}
+
+	cmd.PersistentFlags().StringVar(&templatesDir, "templates-dir", "flipt-templates", "directory templates are stored under, per namespace")
+
+	cmd.AddCommand(
+		newFlagTemplateSaveCommand(rf, &templatesDir),
+		newFlagTemplateListCommand(rf, &templatesDir),
+		newFlagTemplateCreateCommand(rf, &templatesDir),
+	)
+
+	return cmd
+}
+
+func templatePath(templatesDir, namespace, name string) string {
+	return filepath.Join(templatesDir, namespace, name+".yml")
+}
+
+func newFlagTemplateSaveCommand(rf *resourceFlags, templatesDir *string) *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "save <flag-key>",
+		Short: "Save an existing flag's variants/rules/metadata as a reusable template",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			flag, err := client.GetFlag(cmd.Context(), &flipt.GetFlagRequest{Key: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("getting flag: %w", err)
+			}
+
+			rules, err := client.ListRules(cmd.Context(), &flipt.ListRuleRequest{FlagKey: args[0], NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("listing rules: %w", err)
+			}
+
+			tmpl := &ext.Flag{
+				Name:        flag.Name,
+				Type:        flag.Type.String(),
+				Description: flag.Description,
+				Enabled:     flag.Enabled,
+			}
+
+			for _, v := range flag.Variants {
+				tmpl.Variants = append(tmpl.Variants, &ext.Variant{
+					Key:         v.Key,
+					Name:        v.Name,
+					Description: v.Description,
+					Attachment:  v.Attachment,
+				})
+			}
+
+			for _, r := range rules.Rules {
+				rule := &ext.Rule{Rank: uint(r.Rank)}
+
+				for _, d := range r.Distributions {
+					rule.Distributions = append(rule.Distributions, &ext.Distribution{
+						VariantKey: variantKeyByID(flag.Variants, d.VariantId),
+						Rollout:    d.Rollout,
+					})
+				}
+
+				tmpl.Rules = append(tmpl.Rules, rule)
+			}
+
+			if name == "" {
+				name = args[0]
+			}
+
+			path := templatePath(*templatesDir, rf.namespace, name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return fmt.Errorf("creating templates directory: %w", err)
+			}
+
+			data, err := yaml.Marshal(tmpl)
+			if err != nil {
+				return fmt.Errorf("marshaling template: %w", err)
+			}
+
+			if err := os.WriteFile(path, data, 0644); err != nil {
+				return fmt.Errorf("writing template to %q: %w", path, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "saved template %q to %q\n", name, path)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "name to save the template under (defaults to the flag key)")
+
+	return cmd
+}
+
+func newFlagTemplateListCommand(rf *resourceFlags, templatesDir *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the templates saved for a namespace",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dir := filepath.Join(*templatesDir, rf.namespace)
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				if os.IsNotExist(err) {
+					fmt.Fprintf(cmd.OutOrStdout(), "no templates found for namespace %q\n", rf.namespace)
+					return nil
+				}
+
+				return fmt.Errorf("reading templates directory: %w", err)
+			}
+
+			for _, e := range entries {
+				fmt.Fprintln(cmd.OutOrStdout(), strings.TrimSuffix(e.Name(), ".yml"))
+			}
+
+			return nil
+		},
+	}
+}
+
+func newFlagTemplateCreateCommand(rf *resourceFlags, templatesDir *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <template-name> <new-flag-key>",
+		Short: "Create a new flag from a saved template",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, key := args[0], args[1]
+
+			path := templatePath(*templatesDir, rf.namespace, name)
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("reading template %q: %w", name, err)
+			}
+
+			var tmpl ext.Flag
+			if err := yaml.Unmarshal(data, &tmpl); err != nil {
+				return fmt.Errorf("unmarshaling template: %w", err)
+			}
+
+			tmpl.Key = key
+			if tmpl.Name == "" {
+				tmpl.Name = key
+			}
+
+			doc := ext.Document{
+				Version:   "1.2",
+				Namespace: rf.namespace,
+				Flags:     []*ext.Flag{&tmpl},
+			}
+
+			out, err := yaml.Marshal(doc)
+			if err != nil {
+				return fmt.Errorf("marshaling flag document: %w", err)
+			}
+
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			if err := ext.NewImporter(client).Import(cmd.Context(), bytes.NewReader(out)); err != nil {
+				return fmt.Errorf("creating flag from template: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "created flag %q from template %q\n", key, name)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// variantKeyByID resolves a distribution's variant ID back to its key, since
+// templates reference variants by key rather than the server-assigned ID.
+func variantKeyByID(variants []*flipt.Variant, id string) string {
+	for _, v := range variants {
+		if v.Id == id {
+			return v.Key
+		}
+	}
+
+	return ""
+}
@@ -32,6 +32,13 @@ func newValidateCommand() *cobra.Command {
 
 	cmd.Flags().IntVar(&v.issueExitCode, "issue-exit-code", 1, "Exit code to use when issues are found")
 
+	cmd.Flags().StringVarP(
+		&v.format,
+		"output", "o",
+		"text",
+		"output format: json, text",
+	)
+
 	cmd.Flags().StringVarP(
 		&v.format,
 		"format", "F",
@@ -39,6 +46,9 @@ func newValidateCommand() *cobra.Command {
 		"output format: json, text",
 	)
 
+	// We can ignore the error here since "format" will be a flag that exists.
+	_ = cmd.Flags().MarkDeprecated("format", "please use --output instead")
+
 	return cmd
 }
 
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.flipt.io/flipt/rpc/flipt"
+	"gopkg.in/yaml.v2"
+)
+
+// newFlagVariantCommand returns the `flipt flag variant` subcommands for
+// managing a flag's variants, which only make sense scoped to a flag.
+func newFlagVariantCommand(rf *resourceFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "variant",
+		Short: "Manage a flag's variants",
+	}
+
+	cmd.PersistentFlags().StringVar(&providedConfigFile, "config", "", "path to config file")
+
+	cmd.AddCommand(
+		newVariantCreateCommand(rf),
+		newVariantUpdateCommand(rf),
+		newVariantDeleteCommand(rf),
+		newVariantCreateBatchCommand(rf),
+		newVariantUpdateBatchCommand(rf),
+	)
+
+	return cmd
+}
+
+func newVariantCreateCommand(rf *resourceFlags) *cobra.Command {
+	var (
+		name        string
+		description string
+		attachment  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <flag-key> <key>",
+		Short: "Create a variant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			v, err := client.CreateVariant(cmd.Context(), &flipt.CreateVariantRequest{
+				FlagKey:      args[0],
+				Key:          args[1],
+				Name:         name,
+				Description:  description,
+				Attachment:   attachment,
+				NamespaceKey: rf.namespace,
+			})
+			if err != nil {
+				return fmt.Errorf("creating variant: %w", err)
+			}
+
+			return printVariant(cmd, rf, v)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "variant name (defaults to key)")
+	cmd.Flags().StringVar(&description, "description", "", "variant description")
+	cmd.Flags().StringVar(&attachment, "attachment", "", "variant attachment, as a JSON string")
+
+	return cmd
+}
+
+func newVariantUpdateCommand(rf *resourceFlags) *cobra.Command {
+	var (
+		name        string
+		description string
+		attachment  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <flag-key> <variant-id>",
+		Short: "Update a variant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			flagKey, variantID := args[0], args[1]
+
+			f, err := client.GetFlag(cmd.Context(), &flipt.GetFlagRequest{Key: flagKey, NamespaceKey: rf.namespace})
+			if err != nil {
+				return fmt.Errorf("getting flag: %w", err)
+			}
+
+			existing, err := variantByID(f, variantID)
+			if err != nil {
+				return err
+			}
+
+			req := &flipt.UpdateVariantRequest{
+				Id:           variantID,
+				FlagKey:      flagKey,
+				Key:          existing.Key,
+				Name:         existing.Name,
+				Description:  existing.Description,
+				Attachment:   existing.Attachment,
+				NamespaceKey: rf.namespace,
+			}
+
+			if cmd.Flags().Changed("name") {
+				req.Name = name
+			}
+
+			if cmd.Flags().Changed("description") {
+				req.Description = description
+			}
+
+			if cmd.Flags().Changed("attachment") {
+				req.Attachment = attachment
+			}
+
+			v, err := client.UpdateVariant(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("updating variant: %w", err)
+			}
+
+			return printVariant(cmd, rf, v)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "variant name")
+	cmd.Flags().StringVar(&description, "description", "", "variant description")
+	cmd.Flags().StringVar(&attachment, "attachment", "", "variant attachment, as a JSON string")
+
+	return cmd
+}
+
+func newVariantDeleteCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <flag-key> <variant-id>",
+		Short: "Delete a variant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rf.client()
+			if err != nil {
+				return err
+			}
+
+			req := &flipt.DeleteVariantRequest{FlagKey: args[0], Id: args[1], NamespaceKey: rf.namespace}
+			if err := client.DeleteVariant(cmd.Context(), req); err != nil {
+				return fmt.Errorf("deleting variant: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted variant %q\n", args[1])
+			return nil
+		},
+	}
+}
+
+// batchVariant is the YAML shape a variant batch file is expected to
+// contain, one entry per variant to create or update.
+type batchVariant struct {
+	ID          string `yaml:"id,omitempty"`
+	Key         string `yaml:"key"`
+	Name        string `yaml:"name,omitempty"`
+	Description string `yaml:"description,omitempty"`
+	Attachment  string `yaml:"attachment,omitempty"`
+}
+
+func readBatchVariants(path string) ([]batchVariant, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading variants file %q: %w", path, err)
+	}
+
+	var variants []batchVariant
+	if err := yaml.Unmarshal(data, &variants); err != nil {
+		return nil, fmt.Errorf("unmarshaling variants file: %w", err)
+	}
+
+	return variants, nil
+}
+
+func newVariantCreateBatchCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-batch <flag-key> <file>",
+		Short: "Create a batch of variants for a flag in a single transaction",
+		Long: "Reads a YAML list of {key, name, description, attachment} entries and " +
+			"creates them all as variants of the flag in a single transaction, avoiding a " +
+			"sequential round trip per variant.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flagKey := args[0]
+
+			batch, err := readBatchVariants(args[1])
+			if err != nil {
+				return err
+			}
+
+			reqs := make([]*flipt.CreateVariantRequest, 0, len(batch))
+			for _, b := range batch {
+				reqs = append(reqs, &flipt.CreateVariantRequest{
+					FlagKey:      flagKey,
+					NamespaceKey: rf.namespace,
+					Key:          b.Key,
+					Name:         b.Name,
+					Description:  b.Description,
+					Attachment:   b.Attachment,
+				})
+			}
+
+			store, cleanup, err := openFlagStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			variants, err := store.CreateVariants(cmd.Context(), reqs)
+			if err != nil {
+				return fmt.Errorf("creating variants: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "created %d variant(s)\n", len(variants))
+
+			return nil
+		},
+	}
+}
+
+func newVariantUpdateBatchCommand(rf *resourceFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-batch <flag-key> <file>",
+		Short: "Update a batch of variants for a flag in a single transaction",
+		Long: "Reads a YAML list of {id, key, name, description, attachment} entries and " +
+			"updates them all as variants of the flag in a single transaction, avoiding a " +
+			"sequential round trip per variant.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flagKey := args[0]
+
+			batch, err := readBatchVariants(args[1])
+			if err != nil {
+				return err
+			}
+
+			reqs := make([]*flipt.UpdateVariantRequest, 0, len(batch))
+			for _, b := range batch {
+				if b.ID == "" {
+					return fmt.Errorf("variant entry for key %q is missing an id", b.Key)
+				}
+
+				reqs = append(reqs, &flipt.UpdateVariantRequest{
+					Id:           b.ID,
+					FlagKey:      flagKey,
+					NamespaceKey: rf.namespace,
+					Key:          b.Key,
+					Name:         b.Name,
+					Description:  b.Description,
+					Attachment:   b.Attachment,
+				})
+			}
+
+			store, cleanup, err := openFlagStore()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			variants, err := store.UpdateVariants(cmd.Context(), reqs)
+			if err != nil {
+				return fmt.Errorf("updating variants: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "updated %d variant(s)\n", len(variants))
+
+			return nil
+		},
+	}
+}
+
+func printVariant(cmd *cobra.Command, rf *resourceFlags, v *flipt.Variant) error {
+	fields := []string{"ID", "KEY", "NAME", "DESCRIPTION", "FLAG"}
+	values := []string{v.Id, v.Key, v.Name, v.Description, v.FlagKey}
+
+	return rf.printResource(cmd.OutOrStdout(), v, fields, values)
+}
+
+func variantByID(f *flipt.Flag, id string) (*flipt.Variant, error) {
+	for _, v := range f.Variants {
+		if v.Id == id {
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no variant %q found on flag %q", id, f.Key)
+}
@@ -0,0 +1,22 @@
+package awssecrets
+
+import "testing"
+
+func TestHasReference(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want bool
+	}{
+		{"aws-sm:flipt/db-url", true},
+		{"aws-ssm:/flipt/db-url", true},
+		{"vault:secret/data/flipt#git_token", false},
+		{"supersecret", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := HasReference(tt.raw); got != tt.want {
+			t.Errorf("HasReference(%q) = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"time"
 
 	gocache "github.com/patrickmn/go-cache"
 	"go.flipt.io/flipt/internal/cache"
@@ -21,24 +22,30 @@ func NewCache(cfg config.CacheConfig) *Cache {
 }
 
 func (c *Cache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	defer cache.ObserveLatency(ctx, cacheType, cache.OperationGet, time.Now())
+
 	key = cache.Key(key)
 	v, ok := c.c.Get(key)
 	if !ok {
-		cache.Observe(ctx, cacheType, cache.Miss)
+		cache.Observe(ctx, cacheType, cache.OperationGet, cache.Miss)
 		return nil, false, nil
 	}
 
-	cache.Observe(ctx, cacheType, cache.Hit)
+	cache.Observe(ctx, cacheType, cache.OperationGet, cache.Hit)
 	return v.([]byte), true, nil
 }
 
-func (c *Cache) Set(_ context.Context, key string, value []byte) error {
+func (c *Cache) Set(ctx context.Context, key string, value []byte) error {
+	defer cache.ObserveLatency(ctx, cacheType, cache.OperationSet, time.Now())
+
 	key = cache.Key(key)
 	c.c.SetDefault(key, value)
 	return nil
 }
 
-func (c *Cache) Delete(_ context.Context, key string) error {
+func (c *Cache) Delete(ctx context.Context, key string) error {
+	defer cache.ObserveLatency(ctx, cacheType, cache.OperationDelete, time.Now())
+
 	key = cache.Key(key)
 	c.c.Delete(key)
 	return nil
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.flipt.io/flipt/internal/metrics"
@@ -33,12 +34,39 @@ var (
 			prometheus.BuildFQName(namespace, subsystem, "error"),
 			metric.WithDescription("The number of times an error occurred reading or writing to the cache"),
 		)
+	// Latency is a histogram of cache operation durations, in seconds, labeled
+	// by backend and operation so we can tell whether a given backend is
+	// actually helping evaluation latency.
+	Latency = metrics.MustFloat64().
+		Histogram(
+			prometheus.BuildFQName(namespace, subsystem, "latency"),
+			metric.WithDescription("The latency of cache operations in seconds"),
+			metric.WithUnit("s"),
+		)
+)
+
+// Operation names used as the "operation" attribute on cache metrics.
+const (
+	OperationGet    = "get"
+	OperationSet    = "set"
+	OperationDelete = "delete"
 )
 
-// Observe adds one to the provided counter and records the
-// cache type attribute supplied by typ.
-func Observe(ctx context.Context, typ string, counter metric.Int64Counter) {
-	counter.Add(ctx, 1, metric.WithAttributeSet(
-		attribute.NewSet(attribute.Key("cache").String(typ)),
-	))
+// Observe adds one to the provided counter and records the cache backend
+// and operation attributes supplied by typ and op.
+func Observe(ctx context.Context, typ, op string, counter metric.Int64Counter) {
+	counter.Add(ctx, 1, metric.WithAttributeSet(attributeSet(typ, op)))
+}
+
+// ObserveLatency records how long a cache operation against backend typ took,
+// measured from start.
+func ObserveLatency(ctx context.Context, typ, op string, start time.Time) {
+	Latency.Record(ctx, time.Since(start).Seconds(), metric.WithAttributeSet(attributeSet(typ, op)))
+}
+
+func attributeSet(typ, op string) attribute.Set {
+	return attribute.NewSet(
+		attribute.Key("cache").String(typ),
+		attribute.Key("operation").String(op),
+	)
 }
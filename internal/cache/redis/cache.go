@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"errors"
+	"time"
 
 	redis "github.com/go-redis/cache/v9"
 	"go.flipt.io/flipt/internal/cache"
@@ -22,23 +23,27 @@ func NewCache(cfg config.CacheConfig, r *redis.Cache) *Cache {
 }
 
 func (c *Cache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	defer cache.ObserveLatency(ctx, cacheType, cache.OperationGet, time.Now())
+
 	var value []byte
 	key = cache.Key(key)
 	if err := c.c.Get(ctx, key, &value); err != nil {
 		if errors.Is(err, redis.ErrCacheMiss) {
-			cache.Observe(ctx, cacheType, cache.Miss)
+			cache.Observe(ctx, cacheType, cache.OperationGet, cache.Miss)
 			return nil, false, nil
 		}
 
-		cache.Observe(ctx, cacheType, cache.Error)
+		cache.Observe(ctx, cacheType, cache.OperationGet, cache.Error)
 		return nil, false, err
 	}
 
-	cache.Observe(ctx, cacheType, cache.Hit)
+	cache.Observe(ctx, cacheType, cache.OperationGet, cache.Hit)
 	return value, true, nil
 }
 
 func (c *Cache) Set(ctx context.Context, key string, value []byte) error {
+	defer cache.ObserveLatency(ctx, cacheType, cache.OperationSet, time.Now())
+
 	key = cache.Key(key)
 	if err := c.c.Set(&redis.Item{
 		Ctx:   ctx,
@@ -46,7 +51,7 @@ func (c *Cache) Set(ctx context.Context, key string, value []byte) error {
 		Value: value,
 		TTL:   c.cfg.TTL,
 	}); err != nil {
-		cache.Observe(ctx, cacheType, cache.Error)
+		cache.Observe(ctx, cacheType, cache.OperationSet, cache.Error)
 		return err
 	}
 
@@ -54,9 +59,11 @@ func (c *Cache) Set(ctx context.Context, key string, value []byte) error {
 }
 
 func (c *Cache) Delete(ctx context.Context, key string) error {
+	defer cache.ObserveLatency(ctx, cacheType, cache.OperationDelete, time.Now())
+
 	key = cache.Key(key)
 	if err := c.c.Delete(ctx, key); err != nil {
-		cache.Observe(ctx, cacheType, cache.Error)
+		cache.Observe(ctx, cacheType, cache.OperationDelete, cache.Error)
 		return err
 	}
 
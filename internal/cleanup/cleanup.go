@@ -6,14 +6,14 @@ import (
 	"time"
 
 	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/server/audit"
 	authstorage "go.flipt.io/flipt/internal/storage/auth"
 	"go.flipt.io/flipt/internal/storage/oplock"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
-const minCleanupInterval = 5 * time.Minute
-
 // AuthenticationService is configured to run background goroutines which
 // will clear out expired authentication tokens.
 type AuthenticationService struct {
@@ -22,18 +22,21 @@ type AuthenticationService struct {
 	store  authstorage.Store
 	config config.AuthenticationConfig
 
+	enableAuditLogging bool
+
 	errgroup errgroup.Group
 	cancel   func()
 }
 
 // NewAuthenticationService constructs and configures a new instance of authentication service.
-func NewAuthenticationService(logger *zap.Logger, lock oplock.Service, store authstorage.Store, config config.AuthenticationConfig) *AuthenticationService {
+func NewAuthenticationService(logger *zap.Logger, lock oplock.Service, store authstorage.Store, config config.AuthenticationConfig, enableAuditLogging bool) *AuthenticationService {
 	return &AuthenticationService{
-		logger: logger,
-		lock:   lock,
-		store:  store,
-		config: config,
-		cancel: func() {},
+		logger:             logger,
+		lock:               lock,
+		store:              store,
+		config:             config,
+		enableAuditLogging: enableAuditLogging,
+		cancel:             func() {},
 	}
 }
 
@@ -58,40 +61,7 @@ func (s *AuthenticationService) Run(ctx context.Context) {
 		)
 
 		s.errgroup.Go(func() error {
-			// on the first attempt to run the cleanup authentication service
-			// we attempt to obtain the lock immediately. If the lock is already
-			// held the service should return false and return the current acquired
-			// current timestamp
-			acquiredUntil := time.Now().UTC()
-			for {
-				select {
-				case <-ctx.Done():
-					return nil
-				case <-time.After(time.Until(acquiredUntil)):
-				}
-
-				acquired, entry, err := s.lock.TryAcquire(ctx, operation, schedule.Interval)
-				if err != nil {
-					// ensure we dont go into hot loop when the operation lock service
-					// enters an error state by ensuring we sleep for at-least the minimum
-					// interval.
-					now := time.Now().UTC()
-					if acquiredUntil.Before(now) {
-						acquiredUntil = now.Add(minCleanupInterval)
-					}
-
-					logger.Warn("attempting to acquire lock", zap.Error(err))
-					continue
-				}
-
-				// update the next sleep target to current entries acquired until
-				acquiredUntil = entry.AcquiredUntil
-
-				if !acquired {
-					logger.Debug("cleanup process not acquired", zap.Time("next_attempt", entry.AcquiredUntil))
-					continue
-				}
-
+			oplock.RunOnSchedule(ctx, logger, s.lock, operation, schedule.Interval, func(ctx context.Context) {
 				expiredBefore := time.Now().UTC().Add(-schedule.GracePeriod)
 				logger.Info("cleanup process deleting authentications", zap.Time("expired_before", expiredBefore))
 				if err := s.store.DeleteAuthentications(ctx, authstorage.Delete(
@@ -99,12 +69,32 @@ func (s *AuthenticationService) Run(ctx context.Context) {
 					authstorage.WithExpiredBefore(expiredBefore),
 				)); err != nil {
 					logger.Error("attempting to delete expired authentications", zap.Error(err))
+				} else if s.enableAuditLogging {
+					s.auditExpiredAuthenticationsDeleted(ctx, method, expiredBefore)
 				}
-			}
+			})
+
+			return nil
 		})
 	}
 }
 
+// auditExpiredAuthenticationsDeleted emits a single audit event for a cleanup sweep that
+// removed expired authentications for method. Since this runs in a background goroutine
+// with no gRPC request in flight, we start a span of our own to attach the event to.
+func (s *AuthenticationService) auditExpiredAuthenticationsDeleted(ctx context.Context, method fmt.Stringer, expiredBefore time.Time) {
+	ctx, span := otel.Tracer("flipt").Start(ctx, "cleanup.DeleteExpiredAuthentications")
+	defer span.End()
+
+	event := audit.NewEvent(audit.AuthenticationType, audit.Delete, map[string]string{
+		"authentication": "system",
+	}, map[string]string{
+		"method":         method.String(),
+		"expired_before": expiredBefore.Format(time.RFC3339),
+	})
+	event.AddToSpan(ctx)
+}
+
 // Stop signals for the cleanup goroutines to cancel and waits for them to finish.
 func (s *AuthenticationService) Shutdown(ctx context.Context) error {
 	s.cancel()
@@ -51,7 +51,7 @@ func TestCleanup(t *testing.T) {
 	for i := 0; i < 5; i++ {
 		// run five instances of service
 		// it should be a safe operation given they share the same lock service
-		service := NewAuthenticationService(logger, lock, authstore, authConfig)
+		service := NewAuthenticationService(logger, lock, authstore, authConfig, false)
 		service.Run(ctx)
 		defer func() {
 			require.NoError(t, service.Shutdown(context.TODO()))
@@ -2,26 +2,32 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"regexp"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	goredis "github.com/redis/go-redis/v9"
 	"go.flipt.io/flipt/internal/cleanup"
 	"go.flipt.io/flipt/internal/config"
 	"go.flipt.io/flipt/internal/containers"
 	"go.flipt.io/flipt/internal/gateway"
+	"go.flipt.io/flipt/internal/server/audit"
 	"go.flipt.io/flipt/internal/server/auth"
 	"go.flipt.io/flipt/internal/server/auth/method"
 	authgithub "go.flipt.io/flipt/internal/server/auth/method/github"
+	authjwt "go.flipt.io/flipt/internal/server/auth/method/jwt"
 	authkubernetes "go.flipt.io/flipt/internal/server/auth/method/kubernetes"
+	authmtls "go.flipt.io/flipt/internal/server/auth/method/mtls"
 	authoidc "go.flipt.io/flipt/internal/server/auth/method/oidc"
 	authtoken "go.flipt.io/flipt/internal/server/auth/method/token"
 	"go.flipt.io/flipt/internal/server/auth/public"
 	storageauth "go.flipt.io/flipt/internal/storage/auth"
 	storageauthcache "go.flipt.io/flipt/internal/storage/auth/cache"
 	storageauthmemory "go.flipt.io/flipt/internal/storage/auth/memory"
+	storageauthredis "go.flipt.io/flipt/internal/storage/auth/redis"
 	authsql "go.flipt.io/flipt/internal/storage/auth/sql"
 	oplocksql "go.flipt.io/flipt/internal/storage/oplock/sql"
 	rpcauth "go.flipt.io/flipt/rpc/flipt/auth"
@@ -34,9 +40,9 @@ func authenticationGRPC(
 	logger *zap.Logger,
 	cfg *config.Config,
 	forceMigrate bool,
-	tokenDeletedEnabled bool,
+	checker *audit.Checker,
 	authOpts ...containers.Option[auth.InterceptorOptions],
-) (grpcRegisterers, []grpc.UnaryServerInterceptor, func(context.Context) error, error) {
+) (grpcRegisterers, []grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor, func(context.Context) error, error) {
 
 	shutdown := func(ctx context.Context) error {
 		return nil
@@ -50,25 +56,43 @@ func authenticationGRPC(
 		return grpcRegisterers{
 			public.NewServer(logger, cfg.Authentication),
 			auth.NewServer(logger, storageauthmemory.NewStore()),
-		}, nil, shutdown, nil
+		}, nil, nil, shutdown, nil
 	}
 
 	_, builder, driver, dbShutdown, err := getDB(ctx, logger, cfg, forceMigrate)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
+	}
+
+	encryptor, err := newEncryptor(cfg.Encryption)
+	if err != nil {
+		_ = dbShutdown(ctx)
+		return nil, nil, nil, nil, fmt.Errorf("configuring encryption: %w", err)
 	}
 
 	var (
 		authCfg                   = cfg.Authentication
-		store   storageauth.Store = authsql.NewStore(driver, builder, logger)
+		store   storageauth.Store = authsql.NewStore(driver, builder, logger, authsql.WithEncryptor(encryptor))
 		oplock                    = oplocksql.New(logger, driver, builder)
 		public                    = public.NewServer(logger, authCfg)
+		authStoreShutdown         = func(ctx context.Context) error { return nil }
 	)
 
+	if authCfg.Session.Storage.Backend == config.AuthenticationSessionStorageRedis {
+		rdb, redisShutdown, err := getAuthRedis(ctx, authCfg.Session.Storage.Redis)
+		if err != nil {
+			_ = dbShutdown(ctx)
+			return nil, nil, nil, nil, err
+		}
+
+		store = storageauthredis.NewStore(rdb, logger)
+		authStoreShutdown = redisShutdown
+	}
+
 	if cfg.Cache.Enabled {
 		cacher, _, err := getCache(ctx, cfg)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, nil, err
 		}
 		store = storageauthcache.NewStore(store, cacher, logger)
 	}
@@ -76,9 +100,10 @@ func authenticationGRPC(
 	var (
 		register = grpcRegisterers{
 			public,
-			auth.NewServer(logger, store, auth.WithAuditLoggingEnabled(tokenDeletedEnabled)),
+			auth.NewServer(logger, store, auth.WithAuditLoggingEnabled(checker.Check("token:deleted"))),
 		}
-		interceptors []grpc.UnaryServerInterceptor
+		interceptors       []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
 	)
 
 	authOpts = append(authOpts, auth.WithServerSkipsAuthentication(public))
@@ -97,24 +122,32 @@ func authenticationGRPC(
 			opts = append(opts, storageauth.WithExpiration(authCfg.Methods.Token.Method.Bootstrap.Expiration))
 		}
 
+		// if a bootstrap scope is provided, use it to restrict the token's access
+		if scope := authCfg.Methods.Token.Method.Bootstrap.Scope; scope != "" && scope != config.AuthenticationMethodTokenBootstrapScopeAdmin {
+			opts = append(opts, storageauth.WithScope(string(scope)))
+		}
+
 		// attempt to bootstrap authentication store
 		clientToken, err := storageauth.Bootstrap(ctx, store, opts...)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("configuring token authentication: %w", err)
+			return nil, nil, nil, nil, fmt.Errorf("configuring token authentication: %w", err)
 		}
 
 		if clientToken != "" {
 			logger.Info("access token created", zap.String("client_token", clientToken))
 		}
 
-		register.Add(authtoken.NewServer(logger, store))
+		register.Add(authtoken.NewServer(logger, store,
+			authtoken.WithAuditLoggingEnabled(checker.Check("token:created")),
+			authtoken.WithAuthorizationConfig(cfg.Authorization),
+		))
 
 		logger.Debug("authentication method \"token\" server registered")
 	}
 
 	// register auth method oidc service
 	if authCfg.Methods.OIDC.Enabled {
-		oidcServer := authoidc.NewServer(logger, store, authCfg)
+		oidcServer := authoidc.NewServer(logger, store, authCfg, authoidc.WithAuditLoggingEnabled(checker.Check("authentication:created") || checker.Check("authentication:failed")))
 		register.Add(oidcServer)
 		// OIDC server exposes unauthenticated endpoints
 		authOpts = append(authOpts, auth.WithServerSkipsAuthentication(oidcServer))
@@ -123,7 +156,7 @@ func authenticationGRPC(
 	}
 
 	if authCfg.Methods.Github.Enabled {
-		githubServer := authgithub.NewServer(logger, store, authCfg)
+		githubServer := authgithub.NewServer(logger, store, authCfg, authgithub.WithAuditLoggingEnabled(checker.Check("authentication:created") || checker.Check("authentication:failed")))
 		register.Add(githubServer)
 
 		authOpts = append(authOpts, auth.WithServerSkipsAuthentication(githubServer))
@@ -134,7 +167,7 @@ func authenticationGRPC(
 	if authCfg.Methods.Kubernetes.Enabled {
 		kubernetesServer, err := authkubernetes.New(logger, store, authCfg)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("configuring kubernetes authentication: %w", err)
+			return nil, nil, nil, nil, fmt.Errorf("configuring kubernetes authentication: %w", err)
 		}
 		register.Add(kubernetesServer)
 
@@ -144,13 +177,53 @@ func authenticationGRPC(
 		logger.Debug("authentication method \"kubernetes\" server registered")
 	}
 
+	if authCfg.Methods.JWT.Enabled {
+		jwtVerifier, err := authjwt.New(ctx, logger, authCfg.Methods.JWT.Method)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("configuring jwt authentication: %w", err)
+		}
+		authOpts = append(authOpts, auth.WithJWTVerifier(jwtVerifier))
+
+		logger.Debug("authentication method \"jwt\" registered")
+	}
+
+	if authCfg.Methods.MTLS.Enabled {
+		mtlsVerifier := authmtls.New(logger, authCfg.Methods.MTLS.Method)
+		authOpts = append(authOpts, auth.WithMTLSVerifier(mtlsVerifier))
+
+		logger.Debug("authentication method \"mtls\" registered")
+	}
+
 	// only enable enforcement middleware if authentication required
 	if authCfg.Required {
-		interceptors = append(interceptors, auth.UnaryInterceptor(
+		unaryAuth := auth.UnaryInterceptor(
 			logger,
 			store,
 			authOpts...,
-		))
+		)
+		interceptors = append(interceptors, unaryAuth)
+		streamInterceptors = append(streamInterceptors, auth.StreamInterceptor(unaryAuth))
+
+		if authCfg.Methods.Token.Enabled {
+			unaryScope := auth.ScopeInterceptor(logger)
+			interceptors = append(interceptors, unaryScope)
+			streamInterceptors = append(streamInterceptors, auth.StreamInterceptor(unaryScope))
+		}
+
+		if cfg.Authorization.Required {
+			unaryRole := auth.RoleInterceptor(logger, cfg.Authorization)
+			interceptors = append(interceptors, unaryRole)
+			streamInterceptors = append(streamInterceptors, auth.StreamInterceptor(unaryRole))
+		}
+
+		if cfg.Authorization.Policy.Required {
+			engine := auth.NewOPAPolicyEngine(cfg.Authorization.Policy.URL)
+			unaryPolicy := auth.PolicyInterceptor(logger, cfg.Authorization.Policy, engine)
+			interceptors = append(interceptors, unaryPolicy)
+			streamInterceptors = append(streamInterceptors, auth.StreamInterceptor(unaryPolicy))
+
+			logger.Debug("authorization policy enforcement enabled", zap.String("url", cfg.Authorization.Policy.URL))
+		}
 
 		if authCfg.Methods.OIDC.Enabled && len(authCfg.Methods.OIDC.Method.EmailMatches) != 0 {
 			rgxs := make([]*regexp.Regexp, 0, len(authCfg.Methods.OIDC.Method.EmailMatches))
@@ -158,13 +231,15 @@ func authenticationGRPC(
 			for _, em := range authCfg.Methods.OIDC.Method.EmailMatches {
 				rgx, err := regexp.Compile(em)
 				if err != nil {
-					return nil, nil, nil, fmt.Errorf("failed compiling string for pattern: %s: %w", em, err)
+					return nil, nil, nil, nil, fmt.Errorf("failed compiling string for pattern: %s: %w", em, err)
 				}
 
 				rgxs = append(rgxs, rgx)
 			}
 
-			interceptors = append(interceptors, auth.EmailMatchingInterceptor(logger, rgxs))
+			unaryEmail := auth.EmailMatchingInterceptor(logger, rgxs)
+			interceptors = append(interceptors, unaryEmail)
+			streamInterceptors = append(streamInterceptors, auth.StreamInterceptor(unaryEmail))
 		}
 
 		logger.Info("authentication middleware enabled")
@@ -176,6 +251,7 @@ func authenticationGRPC(
 			oplock,
 			store,
 			authCfg,
+			checker.Check("authentication:deleted"),
 		)
 		cleanupAuthService.Run(ctx)
 
@@ -184,14 +260,51 @@ func authenticationGRPC(
 
 			if err := cleanupAuthService.Shutdown(ctx); err != nil {
 				_ = dbShutdown(ctx)
+				_ = authStoreShutdown(ctx)
 				return err
 			}
 
-			return dbShutdown(ctx)
+			if err := dbShutdown(ctx); err != nil {
+				_ = authStoreShutdown(ctx)
+				return err
+			}
+
+			return authStoreShutdown(ctx)
 		}
 	}
 
-	return register, interceptors, shutdown, nil
+	return register, interceptors, streamInterceptors, shutdown, nil
+}
+
+// getAuthRedis constructs and health-checks a redis client used to back the
+// authentication store, returning a shutdown func which closes the connection.
+func getAuthRedis(ctx context.Context, cfg config.RedisCacheConfig) (*goredis.Client, func(context.Context) error, error) {
+	var tlsConfig *tls.Config
+	if cfg.RequireTLS {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	rdb := goredis.NewClient(&goredis.Options{
+		Addr:            fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		TLSConfig:       tlsConfig,
+		Password:        cfg.Password,
+		DB:              cfg.DB,
+		PoolSize:        cfg.PoolSize,
+		MinIdleConns:    cfg.MinIdleConn,
+		ConnMaxIdleTime: cfg.ConnMaxIdleTime,
+		DialTimeout:     cfg.NetTimeout,
+		ReadTimeout:     cfg.NetTimeout * 2,
+		WriteTimeout:    cfg.NetTimeout * 2,
+		PoolTimeout:     cfg.NetTimeout * 2,
+	})
+
+	if status := rdb.Ping(ctx); status.Err() != nil {
+		return nil, nil, fmt.Errorf("connecting to redis: %w", status.Err())
+	}
+
+	return rdb, func(ctx context.Context) error {
+		return rdb.Shutdown(ctx).Err()
+	}, nil
 }
 
 func registerFunc(ctx context.Context, conn *grpc.ClientConn, fn func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error) runtime.ServeMuxOption {
@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/rpc/flipt"
+	rpcaudit "go.flipt.io/flipt/rpc/flipt/audit"
+	"google.golang.org/grpc"
+)
+
+// dashboardActivityDefaultLimit is the number of activity entries returned
+// by the dashboard activity feed endpoint when the caller doesn't specify a
+// limit.
+const dashboardActivityDefaultLimit = 25
+
+// dashboardSummaryRecentFlagLimit bounds how many recently changed flags are
+// returned by the dashboard summary endpoint.
+const dashboardSummaryRecentFlagLimit = 10
+
+// dashboardFlagCounts breaks down the total number of flags known across the
+// namespaces covered by a dashboard summary.
+type dashboardFlagCounts struct {
+	Total    int `json:"total"`
+	Enabled  int `json:"enabled"`
+	Disabled int `json:"disabled"`
+}
+
+// dashboardRecentFlag is an entry in the dashboard summary's recently
+// changed flags list.
+type dashboardRecentFlag struct {
+	NamespaceKey string `json:"namespaceKey"`
+	Key          string `json:"key"`
+	Name         string `json:"name"`
+	UpdatedAt    string `json:"updatedAt,omitempty"`
+}
+
+// dashboardSummary is the response body for the dashboard summary endpoint.
+//
+// Note: Flipt has no analytics subsystem today, so this summary does not
+// include evaluation error rates; it is limited to data already available
+// from the management API (namespaces, flags, and rollouts).
+type dashboardSummary struct {
+	NamespaceCount  int                   `json:"namespaceCount"`
+	Flags           dashboardFlagCounts   `json:"flags"`
+	ActiveRollouts  int                   `json:"activeRollouts"`
+	RecentlyChanged []dashboardRecentFlag `json:"recentlyChanged"`
+}
+
+// newDashboardHandler mounts the dashboard HTTP surface on top of the
+// existing FliptService, aggregating a cross-namespace overview in a single
+// call so the UI can populate a landing dashboard without issuing its own
+// fan-out of requests.
+func newDashboardHandler(cfg *config.Config, conn *grpc.ClientConn) http.Handler {
+	client := flipt.NewFliptClient(conn)
+
+	r := chi.NewRouter()
+	r.Get("/summary", dashboardSummaryHandler(client))
+
+	// the activity feed is derived from persisted audit events, so it's
+	// only available when audit event storage is enabled.
+	if cfg.Audit.Storage.Enabled {
+		r.Get("/activity", dashboardActivityHandler(rpcaudit.NewAuditServiceClient(conn)))
+	}
+
+	return r
+}
+
+func dashboardSummaryHandler(client flipt.FliptClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		summary, err := buildDashboardSummary(forwardedContext(r), client)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(summary)
+	}
+}
+
+// buildDashboardSummary gathers a dashboard summary across every namespace
+// by paging through each namespace's flags and, for boolean flags, their
+// rollouts.
+func buildDashboardSummary(ctx context.Context, client flipt.FliptClient) (dashboardSummary, error) {
+	var summary dashboardSummary
+
+	namespaces, err := dashboardListNamespaces(ctx, client)
+	if err != nil {
+		return summary, err
+	}
+
+	summary.NamespaceCount = len(namespaces)
+
+	var recent []dashboardRecentFlag
+
+	for _, namespace := range namespaces {
+		flags, err := dashboardListFlags(ctx, client, namespace.Key)
+		if err != nil {
+			return summary, err
+		}
+
+		for _, flag := range flags {
+			summary.Flags.Total++
+			if flag.Enabled {
+				summary.Flags.Enabled++
+			} else {
+				summary.Flags.Disabled++
+			}
+
+			rf := dashboardRecentFlag{
+				NamespaceKey: namespace.Key,
+				Key:          flag.Key,
+				Name:         flag.Name,
+			}
+			if flag.UpdatedAt != nil {
+				rf.UpdatedAt = flag.UpdatedAt.AsTime().UTC().Format("2006-01-02T15:04:05Z07:00")
+			}
+
+			recent = append(recent, rf)
+
+			if flag.Type == flipt.FlagType_BOOLEAN_FLAG_TYPE {
+				rollouts, err := client.ListRollouts(ctx, &flipt.ListRolloutRequest{
+					NamespaceKey: namespace.Key,
+					FlagKey:      flag.Key,
+					Limit:        1,
+				})
+				if err != nil {
+					return summary, err
+				}
+
+				if rollouts.TotalCount > 0 {
+					summary.ActiveRollouts++
+				}
+			}
+		}
+	}
+
+	sort.Slice(recent, func(i, j int) bool {
+		return recent[i].UpdatedAt > recent[j].UpdatedAt
+	})
+
+	if len(recent) > dashboardSummaryRecentFlagLimit {
+		recent = recent[:dashboardSummaryRecentFlagLimit]
+	}
+
+	summary.RecentlyChanged = recent
+
+	return summary, nil
+}
+
+// dashboardListNamespaces returns every namespace, following pagination
+// until the namespace list is exhausted.
+func dashboardListNamespaces(ctx context.Context, client flipt.FliptClient) ([]*flipt.Namespace, error) {
+	var (
+		namespaces []*flipt.Namespace
+		pageToken  string
+	)
+
+	for {
+		list, err := client.ListNamespaces(ctx, &flipt.ListNamespaceRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+
+		namespaces = append(namespaces, list.Namespaces...)
+
+		if list.NextPageToken == "" {
+			break
+		}
+
+		pageToken = list.NextPageToken
+	}
+
+	return namespaces, nil
+}
+
+// dashboardListFlags returns every flag in namespace, following pagination
+// until the flag list is exhausted.
+func dashboardListFlags(ctx context.Context, client flipt.FliptClient, namespace string) ([]*flipt.Flag, error) {
+	var (
+		flags     []*flipt.Flag
+		pageToken string
+	)
+
+	for {
+		list, err := client.ListFlags(ctx, &flipt.ListFlagRequest{NamespaceKey: namespace, PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+
+		flags = append(flags, list.Flags...)
+
+		if list.NextPageToken == "" {
+			break
+		}
+
+		pageToken = list.NextPageToken
+	}
+
+	return flags, nil
+}
+
+// dashboardActivityEntry is a single entry in the dashboard activity feed,
+// trimmed down from an audit event to what the UI sidebar needs: what
+// happened, who did it, and when.
+type dashboardActivityEntry struct {
+	ID           string            `json:"id"`
+	Type         string            `json:"type"`
+	Action       string            `json:"action"`
+	Actor        map[string]string `json:"actor,omitempty"`
+	NamespaceKey string            `json:"namespaceKey,omitempty"`
+	Timestamp    string            `json:"timestamp,omitempty"`
+}
+
+// dashboardActivityResponse is the response body for the dashboard activity
+// feed endpoint.
+type dashboardActivityResponse struct {
+	Activity      []dashboardActivityEntry `json:"activity"`
+	NextPageToken string                   `json:"nextPageToken,omitempty"`
+}
+
+// dashboardActivityHandler serves a paginated feed of recent resource
+// changes, derived from persisted audit events, for display in the UI
+// sidebar. It accepts the same "namespace", "limit", and "pageToken" query
+// parameters as the underlying audit event log.
+func dashboardActivityHandler(client rpcaudit.AuditServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, err := dashboardActivityLimit(r.URL.Query().Get("limit"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := client.ListAuditEvents(forwardedContext(r), &rpcaudit.ListAuditEventsRequest{
+			Limit:        limit,
+			PageToken:    r.URL.Query().Get("pageToken"),
+			NamespaceKey: r.URL.Query().Get("namespace"),
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		activity := make([]dashboardActivityEntry, 0, len(resp.Events))
+		for _, event := range resp.Events {
+			entry := dashboardActivityEntry{
+				ID:           event.Id,
+				Type:         event.Type,
+				Action:       event.Action,
+				Actor:        event.Actor,
+				NamespaceKey: event.NamespaceKey,
+			}
+
+			if event.Timestamp != nil {
+				entry.Timestamp = event.Timestamp.AsTime().UTC().Format(time.RFC3339)
+			}
+
+			activity = append(activity, entry)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(dashboardActivityResponse{
+			Activity:      activity,
+			NextPageToken: resp.NextPageToken,
+		})
+	}
+}
+
+// dashboardActivityLimit parses the "limit" query parameter, defaulting to
+// dashboardActivityDefaultLimit when it's unset.
+func dashboardActivityLimit(raw string) (int32, error) {
+	if raw == "" {
+		return dashboardActivityDefaultLimit, nil
+	}
+
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("invalid limit: %q", raw)
+	}
+
+	return int32(limit), nil
+}
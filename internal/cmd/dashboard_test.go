@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/rpc/flipt"
+	rpcaudit "go.flipt.io/flipt/rpc/flipt/audit"
+	"google.golang.org/grpc"
+)
+
+func newDashboardTestHandler(t *testing.T, requireAuth bool) (http.Handler, string) {
+	t.Helper()
+
+	conn, clientToken := newTestAuthenticatedGRPCConn(t, requireAuth, func(server *grpc.Server) {
+		flipt.RegisterFliptServer(server, fakeFliptServer{})
+	})
+
+	return newDashboardHandler(&config.Config{}, conn), clientToken
+}
+
+// fakeAuditServiceServer is an rpcaudit.AuditServiceServer that answers
+// ListAuditEvents with a fixed, empty list, enough to exercise the auth path
+// without needing a full audit event store behind it.
+type fakeAuditServiceServer struct {
+	rpcaudit.UnimplementedAuditServiceServer
+}
+
+func (fakeAuditServiceServer) ListAuditEvents(context.Context, *rpcaudit.ListAuditEventsRequest) (*rpcaudit.ListAuditEventsResponse, error) {
+	return &rpcaudit.ListAuditEventsResponse{}, nil
+}
+
+func newDashboardActivityTestHandler(t *testing.T, requireAuth bool) (http.Handler, string) {
+	t.Helper()
+
+	conn, clientToken := newTestAuthenticatedGRPCConn(t, requireAuth, func(server *grpc.Server) {
+		flipt.RegisterFliptServer(server, fakeFliptServer{})
+		rpcaudit.RegisterAuditServiceServer(server, fakeAuditServiceServer{})
+	})
+
+	cfg := &config.Config{}
+	cfg.Audit.Storage.Enabled = true
+
+	return newDashboardHandler(cfg, conn), clientToken
+}
+
+func TestDashboardHandler_AuthNotRequired(t *testing.T) {
+	handler, _ := newDashboardTestHandler(t, false)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/summary", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestDashboardHandler_AuthRequired(t *testing.T) {
+	handler, clientToken := newDashboardTestHandler(t, true)
+
+	t.Run("missing credentials are rejected", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/summary", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("forwarded Authorization header is honored", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/summary", nil)
+		req.Header.Set("Authorization", "Bearer "+clientToken)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestDashboardActivityHandler_AuthNotRequired(t *testing.T) {
+	handler, _ := newDashboardActivityTestHandler(t, false)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/activity", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestDashboardActivityHandler_AuthRequired(t *testing.T) {
+	handler, clientToken := newDashboardActivityTestHandler(t, true)
+
+	t.Run("missing credentials are rejected", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/activity", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("forwarded Authorization header is honored", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/activity", nil)
+		req.Header.Set("Authorization", "Bearer "+clientToken)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
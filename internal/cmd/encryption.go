@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/crypto"
+)
+
+// newEncryptor constructs the crypto.Encryptor described by cfg, or nil if
+// encryption at rest is disabled.
+func newEncryptor(cfg config.EncryptionConfig) (crypto.Encryptor, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Type {
+	case config.EncryptionTypeLocal:
+		key, err := base64.StdEncoding.DecodeString(cfg.Local.Key)
+		if err != nil {
+			return nil, fmt.Errorf("decoding encryption.local.key: %w", err)
+		}
+
+		return crypto.NewAESGCM(key)
+	default:
+		return nil, fmt.Errorf("unsupported encryption type: %q", cfg.Type)
+	}
+}
@@ -3,11 +3,14 @@ package cmd
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"errors"
 	"fmt"
 	"net"
+	nethttp "net/http"
 	"net/url"
+	"os"
 	"strconv"
 	"sync"
 	"time"
@@ -18,23 +21,43 @@ import (
 	"go.flipt.io/flipt/internal/cache/redis"
 	"go.flipt.io/flipt/internal/config"
 	"go.flipt.io/flipt/internal/containers"
+	"go.flipt.io/flipt/internal/evaluationview"
 	"go.flipt.io/flipt/internal/info"
+	"go.flipt.io/flipt/internal/ramp"
 	fliptserver "go.flipt.io/flipt/internal/server"
 	"go.flipt.io/flipt/internal/server/audit"
+	"go.flipt.io/flipt/internal/server/audit/kafka"
 	"go.flipt.io/flipt/internal/server/audit/logfile"
+	"go.flipt.io/flipt/internal/server/audit/nats"
+	"go.flipt.io/flipt/internal/server/audit/object"
+	auditquery "go.flipt.io/flipt/internal/server/audit/query"
+	"go.flipt.io/flipt/internal/server/audit/slack"
+	auditsql "go.flipt.io/flipt/internal/server/audit/sql"
+	"go.flipt.io/flipt/internal/server/audit/sse"
 	"go.flipt.io/flipt/internal/server/audit/template"
 	"go.flipt.io/flipt/internal/server/audit/webhook"
 	"go.flipt.io/flipt/internal/server/auth"
 	"go.flipt.io/flipt/internal/server/evaluation"
+	"go.flipt.io/flipt/internal/server/idempotency"
+	"go.flipt.io/flipt/internal/server/loadshed"
 	"go.flipt.io/flipt/internal/server/metadata"
 	middlewaregrpc "go.flipt.io/flipt/internal/server/middleware/grpc"
+	"go.flipt.io/flipt/internal/server/ratelimit"
+	fliptsync "go.flipt.io/flipt/internal/server/sync"
+	fliptwatch "go.flipt.io/flipt/internal/server/watch"
 	"go.flipt.io/flipt/internal/storage"
+	storageauditsql "go.flipt.io/flipt/internal/storage/audit/sql"
 	storagecache "go.flipt.io/flipt/internal/storage/cache"
 	"go.flipt.io/flipt/internal/storage/fs"
+	fscache "go.flipt.io/flipt/internal/storage/fs/cache"
+	"go.flipt.io/flipt/internal/storage/oplock"
+	oplockredis "go.flipt.io/flipt/internal/storage/oplock/redis"
+	oplocksql "go.flipt.io/flipt/internal/storage/oplock/sql"
 	fliptsql "go.flipt.io/flipt/internal/storage/sql"
 	"go.flipt.io/flipt/internal/storage/sql/mysql"
 	"go.flipt.io/flipt/internal/storage/sql/postgres"
 	"go.flipt.io/flipt/internal/storage/sql/sqlite"
+	"go.flipt.io/flipt/rpc/flipt"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/jaeger"
@@ -51,9 +74,14 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	_ "google.golang.org/grpc/encoding/gzip" // registers the gzip compressor so clients may opt into it
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
+	_ "go.flipt.io/flipt/internal/compression/zstd" // registers the zstd compressor so clients may opt into it
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"go.flipt.io/flipt/internal/storage/fs/git"
 	"go.flipt.io/flipt/internal/storage/fs/local"
@@ -94,9 +122,62 @@ type GRPCServer struct {
 	cfg    *config.Config
 	ln     net.Listener
 
+	// refresher is set when the configured storage backend supports being
+	// forced to immediately re-sync (e.g. git, object). It is nil otherwise.
+	refresher fs.Refresher
+
+	// snapshotStatus is set when the configured storage backend builds its
+	// snapshot from a declarative source (e.g. git, object, local). It is
+	// nil otherwise.
+	snapshotStatus fs.SnapshotStatusReporter
+
+	// gitCommitter is set when git storage has write-back enabled. It
+	// commits API-driven mutations back to the tracked git repository.
+	gitCommitter *git.Committer
+
+	// sseHub is set when the SSE audit sink is enabled. It fans flag change
+	// events out to subscribers of the SSE HTTP endpoint.
+	sseHub *sse.Hub
+
+	// rampService is set when one or more ramp schedules are configured
+	// against a writable storage backend. It drives the gradual rollout
+	// percentage steps and exposes pause/resume/abort control.
+	rampService *ramp.Service
+
+	// evaluationViewRefresher is set when evaluation.enabled is configured
+	// against a storage backend that supports it. It periodically rebuilds
+	// the evaluation_rules_view materialized table.
+	evaluationViewRefresher *evaluationview.Refresher
+
 	shutdownFuncs []func(context.Context) error
 }
 
+// Ramp returns the configured ramp.Service, or nil if no ramp schedules are
+// running.
+func (s *GRPCServer) Ramp() *ramp.Service {
+	return s.rampService
+}
+
+// Refresher returns the storage refresher associated with this server, if
+// the configured storage backend supports on-demand refresh. It returns nil
+// otherwise.
+func (s *GRPCServer) Refresher() fs.Refresher {
+	return s.refresher
+}
+
+// SSEHub returns the hub backing the SSE audit sink, if enabled. It returns
+// nil otherwise.
+func (s *GRPCServer) SSEHub() *sse.Hub {
+	return s.sseHub
+}
+
+// EvaluationViewRefresher returns the background job that maintains the
+// evaluation_rules_view materialized table, if enabled. It returns nil
+// otherwise.
+func (s *GRPCServer) EvaluationViewRefresher() *evaluationview.Refresher {
+	return s.evaluationViewRefresher
+}
+
 // NewGRPCServer constructs the core Flipt gRPC service including its dependencies
 // (e.g. tracing, metrics, storage, migrations, caching and cleanup).
 // It returns an instance of *GRPCServer which callers can Run().
@@ -106,6 +187,7 @@ func NewGRPCServer(
 	cfg *config.Config,
 	info info.Flipt,
 	forceMigrate bool,
+	warnings []string,
 ) (*GRPCServer, error) {
 	logger = logger.With(zap.String("server", "grpc"))
 	server := &GRPCServer{
@@ -113,6 +195,10 @@ func NewGRPCServer(
 		cfg:    cfg,
 	}
 
+	if cfg.Flags.MaxVariantAttachmentSize > 0 {
+		flipt.SetMaxVariantAttachmentSize(cfg.Flags.MaxVariantAttachmentSize)
+	}
+
 	var err error
 	server.ln, err = net.Listen("tcp", fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.GRPCPort))
 	if err != nil {
@@ -123,7 +209,10 @@ func NewGRPCServer(
 		return server.ln.Close()
 	})
 
-	var store storage.Store
+	var (
+		store    storage.Store
+		rampLock oplock.Service
+	)
 
 	switch cfg.Storage.Type {
 	case "", config.DatabaseStorageType:
@@ -145,6 +234,8 @@ func NewGRPCServer(
 			return nil, fmt.Errorf("unsupported driver: %s", driver)
 		}
 
+		rampLock = oplocksql.New(logger, driver, builder)
+
 		logger.Debug("database driver configured", zap.Stringer("driver", driver))
 	case config.GitStorageType:
 		opts := []containers.Option[git.Source]{
@@ -152,17 +243,39 @@ func NewGRPCServer(
 			git.WithPollInterval(cfg.Storage.Git.PollInterval),
 		}
 
+		if len(cfg.Storage.Git.Refs) > 0 {
+			opts = append(opts, git.WithRefs(cfg.Storage.Git.Refs))
+		}
+
+		if cfg.Storage.Git.CloneDepth > 0 {
+			opts = append(opts, git.WithShallowClone(int(cfg.Storage.Git.CloneDepth)))
+		}
+
+		if cfg.Storage.Git.SingleBranch {
+			opts = append(opts, git.WithSingleBranch(true))
+		}
+
+		if cfg.Storage.Git.CloneCachePath != "" {
+			opts = append(opts, git.WithClonePath(cfg.Storage.Git.CloneCachePath))
+		}
+
+		var gitAuth transport.AuthMethod
+
 		auth := cfg.Storage.Git.Authentication
 		switch {
 		case auth.BasicAuth != nil:
-			opts = append(opts, git.WithAuth(&http.BasicAuth{
+			gitAuth = &http.BasicAuth{
 				Username: auth.BasicAuth.Username,
 				Password: auth.BasicAuth.Password,
-			}))
+			}
 		case auth.TokenAuth != nil:
-			opts = append(opts, git.WithAuth(&http.TokenAuth{
+			gitAuth = &http.TokenAuth{
 				Token: auth.TokenAuth.AccessToken,
-			}))
+			}
+		}
+
+		if gitAuth != nil {
+			opts = append(opts, git.WithAuth(gitAuth))
 		}
 
 		source, err := git.NewSource(logger, cfg.Storage.Git.Repository, opts...)
@@ -170,22 +283,48 @@ func NewGRPCServer(
 			return nil, err
 		}
 
-		store, err = fs.NewStore(logger, source)
+		fsSource, snapshotCacheShutdown, err := wrapSnapshotCache(ctx, logger, cfg, source, cfg.Storage.Git.PollInterval)
 		if err != nil {
 			return nil, err
 		}
+
+		server.onShutdown(snapshotCacheShutdown)
+
+		store, err = fs.NewStore(logger, fsSource, fs.WithSnapshotErrorPolicy(cfg.Storage.SnapshotErrorPolicy))
+		if err != nil {
+			return nil, err
+		}
+
+		// git storage is writable when write-back has been configured: a
+		// Committer commits (and pushes) API-driven mutations to the
+		// configured branch instead of the store operating read-only.
+		if cfg.Storage.Git.WriteBack.Enabled {
+			branch := cfg.Storage.Git.WriteBack.Branch
+			if branch == "" {
+				branch = cfg.Storage.Git.Ref
+			}
+
+			server.gitCommitter = git.NewCommitter(
+				cfg.Storage.Git.Repository,
+				branch,
+				gitAuth,
+				git.WithCommitAuthor(cfg.Storage.Git.WriteBack.AuthorName, cfg.Storage.Git.WriteBack.AuthorEmail),
+			)
+
+			logger.Debug("git write-back enabled", zap.String("branch", branch))
+		}
 	case config.LocalStorageType:
 		source, err := local.NewSource(logger, cfg.Storage.Local.Path)
 		if err != nil {
 			return nil, err
 		}
 
-		store, err = fs.NewStore(logger, source)
+		store, err = fs.NewStore(logger, source, fs.WithSnapshotErrorPolicy(cfg.Storage.SnapshotErrorPolicy))
 		if err != nil {
 			return nil, err
 		}
 	case config.ObjectStorageType:
-		store, err = NewObjectStore(cfg, logger)
+		store, err = NewObjectStore(ctx, cfg, logger)
 		if err != nil {
 			return nil, err
 		}
@@ -193,6 +332,16 @@ func NewGRPCServer(
 		return nil, fmt.Errorf("unexpected storage type: %q", cfg.Storage.Type)
 	}
 
+	// keep a handle on the refresher, if the underlying store supports one,
+	// before it is potentially wrapped (e.g. by the cache store below).
+	if refresher, ok := store.(fs.Refresher); ok {
+		server.refresher = refresher
+	}
+
+	if reporter, ok := store.(fs.SnapshotStatusReporter); ok {
+		server.snapshotStatus = reporter
+	}
+
 	logger.Debug("store enabled", zap.Stringer("type", store))
 
 	// Initialize tracingProvider regardless of configuration. No extraordinary resources
@@ -203,7 +352,7 @@ func NewGRPCServer(
 			semconv.ServiceNameKey.String("flipt"),
 			semconv.ServiceVersionKey.String(info.Version),
 		)),
-		tracesdk.WithSampler(tracesdk.AlwaysSample()),
+		tracesdk.WithSampler(traceSampler(cfg.Tracing.Sampling)),
 	)
 
 	if cfg.Tracing.Enabled {
@@ -226,6 +375,7 @@ func NewGRPCServer(
 			return status.Errorf(codes.Internal, "%v", p)
 		})),
 		grpc_ctxtags.UnaryServerInterceptor(),
+		middlewaregrpc.RequestIDUnaryInterceptor,
 		grpc_zap.UnaryServerInterceptor(logger),
 		grpc_prometheus.UnaryServerInterceptor,
 		otelgrpc.UnaryServerInterceptor(),
@@ -252,8 +402,10 @@ func NewGRPCServer(
 
 	var (
 		fliptsrv           = fliptserver.New(logger, store)
-		metasrv            = metadata.NewServer(cfg, info)
+		metasrv            = metadata.NewServer(cfg, info, metadata.WithWarnings(warnings), metadata.WithSnapshotStatus(server.snapshotStatus))
 		evalsrv            = evaluation.New(logger, store)
+		syncsrv            = fliptsync.New(logger, store)
+		watchsrv           = fliptwatch.New(logger, store)
 		authOpts           = []containers.Option[auth.InterceptorOptions]{}
 		skipAuthIfExcluded = func(server any, excluded bool) {
 			if excluded {
@@ -278,17 +430,42 @@ func NewGRPCServer(
 		}
 	}
 
-	var tokenDeletedEnabled bool
-	if checker != nil {
-		tokenDeletedEnabled = checker.Check("token:deleted")
+	if len(cfg.Rollout.Plans) > 0 {
+		if rampLock == nil {
+			logger.Warn("rollout.plans configured but storage type does not support ramp schedules (skipping)", zap.String("type", string(cfg.Storage.Type)))
+		} else if rolloutStore, ok := store.(storage.RolloutStore); ok {
+			auditEnabled := checker != nil && checker.Check("rollout:updated")
+
+			server.rampService = ramp.NewService(logger, rampLock, rolloutStore, ramp.NewPlans(cfg.Rollout), auditEnabled)
+			server.rampService.Run(ctx)
+
+			server.onShutdown(func(ctx context.Context) error {
+				logger.Info("shutting down ramp service...")
+				return server.rampService.Shutdown(ctx)
+			})
+		}
+	}
+
+	if cfg.Evaluation.Enabled {
+		if viewRefresher, ok := store.(storage.EvaluationViewRefresher); ok {
+			server.evaluationViewRefresher = evaluationview.NewRefresher(logger, viewRefresher, cfg.Evaluation.RefreshInterval)
+			server.evaluationViewRefresher.Run(ctx)
+
+			server.onShutdown(func(ctx context.Context) error {
+				logger.Info("shutting down evaluation rules view refresher...")
+				return server.evaluationViewRefresher.Shutdown(ctx)
+			})
+		} else {
+			logger.Warn("evaluation.enabled configured but storage type does not support the materialized evaluation view (skipping)", zap.String("type", string(cfg.Storage.Type)))
+		}
 	}
 
-	register, authInterceptors, authShutdown, err := authenticationGRPC(
+	register, authInterceptors, authStreamInterceptors, authShutdown, err := authenticationGRPC(
 		ctx,
 		logger,
 		cfg,
 		forceMigrate,
-		tokenDeletedEnabled,
+		checker,
 		authOpts...,
 	)
 	if err != nil {
@@ -301,6 +478,8 @@ func NewGRPCServer(
 	register.Add(fliptsrv)
 	register.Add(metasrv)
 	register.Add(evalsrv)
+	register.Add(syncsrv)
+	register.Add(watchsrv)
 
 	// forward internal gRPC logging to zap
 	grpcLogLevel, err := zapcore.ParseLevel(cfg.Log.GRPCLevel)
@@ -319,6 +498,57 @@ func NewGRPCServer(
 		)...,
 	)
 
+	if len(cfg.Storage.ReadOnlyNamespaces) > 0 {
+		interceptors = append(interceptors, middlewaregrpc.ReadOnlyNamespaceUnaryInterceptor(cfg.Storage))
+
+		logger.Debug("read-only namespaces configured", zap.Strings("namespaces", cfg.Storage.ReadOnlyNamespaces))
+	}
+
+	// auth stream interceptors must be wired in regardless of whether rate
+	// limiting is enabled, so that server-streaming RPCs (e.g. WatchNamespace,
+	// WatchFlag, SyncFlags) enforce the same authentication/authorization as
+	// every unary RPC.
+	streamInterceptors := append([]grpc.StreamServerInterceptor{}, authStreamInterceptors...)
+
+	// rate limiting must come after auth interceptors so that requests can be
+	// identified by authenticated token where present.
+	if cfg.RateLimiting.Enabled {
+		limiter := ratelimit.New(cfg.RateLimiting.RequestsPerSecond, cfg.RateLimiting.Burst)
+		interceptors = append(interceptors, middlewaregrpc.RateLimitUnaryInterceptor(limiter))
+		streamInterceptors = append(streamInterceptors, middlewaregrpc.RateLimitStreamInterceptor(limiter))
+
+		logger.Debug("rate limiting enabled",
+			zap.Float64("requests_per_second", cfg.RateLimiting.RequestsPerSecond),
+			zap.Int("burst", cfg.RateLimiting.Burst),
+		)
+	}
+
+	if cfg.LoadShedding.Enabled {
+		limiter := loadshed.New(cfg.LoadShedding.MinLimit, cfg.LoadShedding.MaxLimit, cfg.LoadShedding.TargetLatency)
+		interceptors = append(interceptors, middlewaregrpc.LoadSheddingUnaryInterceptor(limiter))
+
+		logger.Debug("load shedding enabled",
+			zap.Int("min_limit", cfg.LoadShedding.MinLimit),
+			zap.Int("max_limit", cfg.LoadShedding.MaxLimit),
+			zap.Duration("target_latency", cfg.LoadShedding.TargetLatency),
+		)
+	}
+
+	// idempotency must come after auth interceptors
+	if cfg.Idempotency.Enabled {
+		idempotencyStore := idempotency.New(cfg.Idempotency.TTL)
+		idempotencyStore.Run(ctx)
+
+		server.onShutdown(func(ctx context.Context) error {
+			logger.Info("shutting down idempotency store...")
+			return idempotencyStore.Shutdown(ctx)
+		})
+
+		interceptors = append(interceptors, middlewaregrpc.IdempotencyUnaryInterceptor(idempotencyStore, logger))
+
+		logger.Debug("idempotency keys enabled", zap.Duration("ttl", cfg.Idempotency.TTL))
+	}
+
 	// cache must come after auth interceptors
 	if cfg.Cache.Enabled && cacher != nil {
 		interceptors = append(interceptors, middlewaregrpc.CacheUnaryInterceptor(cacher, logger))
@@ -328,7 +558,7 @@ func NewGRPCServer(
 	sinks := make([]audit.Sink, 0)
 
 	if cfg.Audit.Sinks.LogFile.Enabled {
-		logFileSink, err := logfile.NewSink(logger, cfg.Audit.Sinks.LogFile.File)
+		logFileSink, err := logfile.NewSink(logger, cfg.Audit.Sinks.LogFile)
 		if err != nil {
 			return nil, fmt.Errorf("opening file at path: %s", cfg.Audit.Sinks.LogFile.File)
 		}
@@ -342,6 +572,10 @@ func NewGRPCServer(
 			opts = append(opts, webhook.WithMaxBackoffDuration(cfg.Audit.Sinks.Webhook.MaxBackoffDuration))
 		}
 
+		if cfg.Audit.CloudEvents.Enabled {
+			opts = append(opts, webhook.WithCloudEvents(cfg.Audit.CloudEvents.Source, cfg.Audit.CloudEvents.TypePrefix))
+		}
+
 		var webhookSink audit.Sink
 
 		// Enable basic webhook sink if URL is non-empty, otherwise enable template sink if the length of templates is greater
@@ -363,6 +597,81 @@ func NewGRPCServer(
 		sinks = append(sinks, webhookSink)
 	}
 
+	if cfg.Audit.Sinks.Kafka.Enabled {
+		kafkaSink, err := kafka.NewSink(logger, cfg.Audit.Sinks.Kafka, cfg.Audit.CloudEvents)
+		if err != nil {
+			return nil, fmt.Errorf("configuring kafka audit sink: %w", err)
+		}
+
+		sinks = append(sinks, kafkaSink)
+	}
+
+	if cfg.Audit.Sinks.Object.Enabled {
+		var (
+			writer object.Writer
+			err    error
+		)
+
+		switch cfg.Audit.Sinks.Object.Type {
+		case config.ObjectSinkTypeS3:
+			writer, err = object.NewS3Writer(ctx, cfg.Audit.Sinks.Object.S3)
+		case config.ObjectSinkTypeGCS:
+			writer, err = object.NewGCSWriter(ctx, cfg.Audit.Sinks.Object.GCS)
+		default:
+			err = fmt.Errorf("unsupported object sink type: %q", cfg.Audit.Sinks.Object.Type)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("configuring object audit sink: %w", err)
+		}
+
+		var prefix string
+		switch cfg.Audit.Sinks.Object.Type {
+		case config.ObjectSinkTypeS3:
+			prefix = cfg.Audit.Sinks.Object.S3.Prefix
+		case config.ObjectSinkTypeGCS:
+			prefix = cfg.Audit.Sinks.Object.GCS.Prefix
+		}
+
+		sinks = append(sinks, object.NewSink(logger, writer, prefix, cfg.Audit.Sinks.Object.FlushPeriod))
+	}
+
+	if cfg.Audit.Sinks.Slack.Enabled {
+		sinks = append(sinks, slack.NewSink(logger, cfg.Audit.Sinks.Slack))
+	}
+
+	if cfg.Audit.Sinks.NATS.Enabled {
+		natsSink, err := nats.NewSink(logger, cfg.Audit.Sinks.NATS, cfg.Audit.CloudEvents)
+		if err != nil {
+			return nil, fmt.Errorf("configuring nats audit sink: %w", err)
+		}
+
+		sinks = append(sinks, natsSink)
+	}
+
+	if cfg.Audit.Sinks.SSE.Enabled {
+		sseHub := sse.NewHub(logger)
+		server.sseHub = sseHub
+		sinks = append(sinks, sseHub)
+	}
+
+	if cfg.Audit.Storage.Enabled {
+		_, builder, driver, dbShutdown, err := getDB(ctx, logger, cfg, forceMigrate)
+		if err != nil {
+			return nil, fmt.Errorf("configuring audit storage: %w", err)
+		}
+
+		server.onShutdown(dbShutdown)
+
+		auditStore := storageauditsql.NewStore(driver, builder, logger)
+
+		sinks = append(sinks, auditsql.NewSink(logger, auditStore))
+
+		auditsrv := auditquery.NewServer(logger, auditStore)
+		skipAuthIfExcluded(auditsrv, cfg.Authentication.Exclude.Management)
+		register.Add(auditsrv)
+	}
+
 	// based on audit sink configuration from the user, provision the audit sinks and add them to a slice,
 	// and if the slice has a non-zero length, add the audit sink interceptor
 	if len(sinks) > 0 {
@@ -389,23 +698,73 @@ func NewGRPCServer(
 	otel.SetTracerProvider(tracingProvider)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 
-	grpcOpts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(interceptors...)}
+	grpcOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  cfg.Server.GRPCKeepalive.Time,
+			Timeout:               cfg.Server.GRPCKeepalive.Timeout,
+			MaxConnectionAge:      cfg.Server.GRPCKeepalive.MaxConnectionAge,
+			MaxConnectionAgeGrace: cfg.Server.GRPCKeepalive.MaxConnectionAgeGrace,
+		}),
+	}
+
+	if len(streamInterceptors) > 0 {
+		grpcOpts = append(grpcOpts, grpc.ChainStreamInterceptor(streamInterceptors...))
+	}
+
+	if cfg.Server.GRPCKeepalive.MaxConcurrentStreams > 0 {
+		grpcOpts = append(grpcOpts, grpc.MaxConcurrentStreams(cfg.Server.GRPCKeepalive.MaxConcurrentStreams))
+	}
 
 	if cfg.Server.Protocol == config.HTTPS {
-		creds, err := credentials.NewServerTLSFromFile(cfg.Server.CertFile, cfg.Server.CertKey)
+		tlsCert, err := tls.LoadX509KeyPair(cfg.Server.CertFile, cfg.Server.CertKey)
 		if err != nil {
 			return nil, fmt.Errorf("loading TLS credentials: %w", err)
 		}
 
-		grpcOpts = append(grpcOpts, grpc.Creds(creds))
+		tlsConfig := &tls.Config{Certificates: []tls.Certificate{tlsCert}}
+
+		// when mtls authentication is enabled, require and verify client certificates
+		// signed by the configured CA against the server's TLS listener.
+		if cfg.Authentication.Methods.MTLS.Enabled {
+			caCert, err := os.ReadFile(cfg.Authentication.Methods.MTLS.Method.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("configuring mtls authentication: reading ca_file: %w", err)
+			}
+
+			clientCAs := x509.NewCertPool()
+			if !clientCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("configuring mtls authentication: parsing ca_file: no certificates found")
+			}
+
+			tlsConfig.ClientCAs = clientCAs
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
 
 	// initialize grpc server
 	server.Server = grpc.NewServer(grpcOpts...)
 
-	// register grpcServer graceful stop on shutdown
-	server.onShutdown(func(context.Context) error {
-		server.GracefulStop()
+	// register grpcServer graceful stop on shutdown, forcibly closing any
+	// still in-flight connections if the context is cancelled before
+	// GracefulStop has finished draining them
+	server.onShutdown(func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			server.logger.Warn("shutdown grace period exceeded, forcibly closing in-flight grpc connections")
+			server.Stop()
+			<-stopped
+		}
+
 		return nil
 	})
 
@@ -420,7 +779,7 @@ func NewGRPCServer(
 }
 
 // NewObjectStore create a new storate.Store from the object config
-func NewObjectStore(cfg *config.Config, logger *zap.Logger) (storage.Store, error) {
+func NewObjectStore(ctx context.Context, cfg *config.Config, logger *zap.Logger) (storage.Store, error) {
 	objectCfg := cfg.Storage.Object
 	var store storage.Store
 	// keep this as a case statement in anticipation of
@@ -437,11 +796,38 @@ func NewObjectStore(cfg *config.Config, logger *zap.Logger) (storage.Store, erro
 		if objectCfg.S3.Region != "" {
 			opts = append(opts, s3.WithRegion(objectCfg.S3.Region))
 		}
+		if objectCfg.S3.CAPath != "" {
+			opts = append(opts, s3.WithCAPath(objectCfg.S3.CAPath))
+		}
+		if objectCfg.S3.PathStyle {
+			opts = append(opts, s3.WithPathStyle(true))
+		}
+		if objectCfg.S3.RoleARN != "" {
+			opts = append(opts, s3.WithRoleARN(objectCfg.S3.RoleARN))
+			if objectCfg.S3.RoleSessionName != "" {
+				opts = append(opts, s3.WithRoleSessionName(objectCfg.S3.RoleSessionName))
+			}
+			if objectCfg.S3.RoleExternalID != "" {
+				opts = append(opts, s3.WithRoleExternalID(objectCfg.S3.RoleExternalID))
+			}
+			if objectCfg.S3.WebIdentityTokenFile != "" {
+				opts = append(opts, s3.WithWebIdentityTokenFile(objectCfg.S3.WebIdentityTokenFile))
+			}
+		}
+		if objectCfg.S3.AccessKeyID != "" {
+			opts = append(opts, s3.WithStaticCredentials(objectCfg.S3.AccessKeyID, objectCfg.S3.SecretAccessKey, objectCfg.S3.SessionToken))
+		}
 		source, err := s3.NewSource(logger, objectCfg.S3.Bucket, opts...)
 		if err != nil {
 			return nil, err
 		}
-		store, err = fs.NewStore(logger, source)
+
+		fsSource, _, err := wrapSnapshotCache(ctx, logger, cfg, source, objectCfg.S3.PollInterval)
+		if err != nil {
+			return nil, err
+		}
+
+		store, err = fs.NewStore(logger, fsSource, fs.WithSnapshotErrorPolicy(cfg.Storage.SnapshotErrorPolicy))
 		if err != nil {
 			return nil, err
 		}
@@ -449,6 +835,79 @@ func NewObjectStore(cfg *config.Config, logger *zap.Logger) (storage.Store, erro
 	return store, nil
 }
 
+// wrapSnapshotCache wraps source so that, when storage.snapshot_cache is
+// enabled, only a single elected replica fetches from source; every
+// replica (including that leader) instead serves the snapshot it
+// publishes to the configured shared cache, at most once per interval.
+// When snapshot caching is disabled, source is returned unchanged.
+func wrapSnapshotCache(ctx context.Context, logger *zap.Logger, cfg *config.Config, source fs.FSSource, interval time.Duration) (fs.FSSource, errFunc, error) {
+	cacheCfg := cfg.Storage.SnapshotCache
+	noop := func(context.Context) error { return nil }
+	if !cacheCfg.Enabled {
+		return source, noop, nil
+	}
+
+	rdb, shutdown, err := getSnapshotCacheRedis(ctx, cacheCfg)
+	if err != nil {
+		return nil, noop, fmt.Errorf("configuring snapshot cache: %w", err)
+	}
+
+	cacher := redis.NewCache(config.CacheConfig{TTL: interval * 3}, goredis_cache.New(&goredis_cache.Options{
+		Redis: rdb,
+	}))
+
+	lock := oplockredis.New(rdb, cacheCfg.Key)
+
+	return fscache.New(logger, source, lock, cacher, cacheCfg.Key, interval), shutdown, nil
+}
+
+var (
+	snapshotCacheRedisOnce sync.Once
+	snapshotCacheRedis     *goredis.Client
+	snapshotCacheRedisFunc errFunc = func(context.Context) error { return nil }
+	snapshotCacheRedisErr  error
+)
+
+// getSnapshotCacheRedis constructs and health-checks the redis client used
+// to distribute published storage snapshots, independently of (and
+// potentially pointing at a different Redis instance than) the
+// general-purpose response cache configured via cache.redis.
+func getSnapshotCacheRedis(ctx context.Context, cfg config.SnapshotCacheConfig) (*goredis.Client, errFunc, error) {
+	snapshotCacheRedisOnce.Do(func() {
+		var tlsConfig *tls.Config
+		if cfg.Redis.RequireTLS {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		rdb := goredis.NewClient(&goredis.Options{
+			Addr:            fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port),
+			TLSConfig:       tlsConfig,
+			Password:        cfg.Redis.Password,
+			DB:              cfg.Redis.DB,
+			PoolSize:        cfg.Redis.PoolSize,
+			MinIdleConns:    cfg.Redis.MinIdleConn,
+			ConnMaxIdleTime: cfg.Redis.ConnMaxIdleTime,
+			DialTimeout:     cfg.Redis.NetTimeout,
+			ReadTimeout:     cfg.Redis.NetTimeout * 2,
+			WriteTimeout:    cfg.Redis.NetTimeout * 2,
+			PoolTimeout:     cfg.Redis.NetTimeout * 2,
+		})
+
+		snapshotCacheRedisFunc = func(ctx context.Context) error {
+			return rdb.Shutdown(ctx).Err()
+		}
+
+		if status := rdb.Ping(ctx); status.Err() != nil {
+			snapshotCacheRedisErr = fmt.Errorf("connecting to redis: %w", status.Err())
+			return
+		}
+
+		snapshotCacheRedis = rdb
+	})
+
+	return snapshotCacheRedis, snapshotCacheRedisFunc, snapshotCacheRedisErr
+}
+
 // Run begins serving gRPC requests.
 // This methods blocks until Shutdown is called.
 func (s *GRPCServer) Run() error {
@@ -486,16 +945,81 @@ var (
 	traceExpErr  error
 )
 
+// traceSampler builds the root tracesdk.Sampler for the configured sampling
+// strategy. It always falls back to always-sample, so a misconfigured or
+// zero-value SamplingConfig behaves the same as it did before sampling was
+// configurable.
+func traceSampler(cfg config.SamplingConfig) tracesdk.Sampler {
+	switch cfg.Type {
+	case config.SamplingRatio:
+		return tracesdk.TraceIDRatioBased(cfg.Ratio)
+	case config.SamplingParentBased:
+		return tracesdk.ParentBased(tracesdk.TraceIDRatioBased(cfg.Ratio))
+	default:
+		return tracesdk.AlwaysSample()
+	}
+}
+
+// authRoundTripper decorates every request with the configured basic auth
+// credentials and/or static headers before delegating to next.
+type authRoundTripper struct {
+	username string
+	password string
+	headers  map[string]string
+	next     nethttp.RoundTripper
+}
+
+func (t *authRoundTripper) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	req = req.Clone(req.Context())
+
+	if t.username != "" {
+		req.SetBasicAuth(t.username, t.password)
+	}
+
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+
+	return t.next.RoundTrip(req)
+}
+
 func getTraceExporter(ctx context.Context, cfg *config.Config) (tracesdk.SpanExporter, errFunc, error) {
 	traceExpOnce.Do(func() {
 		switch cfg.Tracing.Exporter {
 		case config.TracingJaeger:
+			if cfg.Tracing.Jaeger.Endpoint != "" {
+				// collector (HTTP) mode, optionally authenticated
+				opts := []jaeger.CollectorEndpointOption{jaeger.WithEndpoint(cfg.Tracing.Jaeger.Endpoint)}
+				if cfg.Tracing.Jaeger.Username != "" {
+					opts = append(opts,
+						jaeger.WithUsername(cfg.Tracing.Jaeger.Username),
+						jaeger.WithPassword(cfg.Tracing.Jaeger.Password),
+					)
+				}
+
+				traceExp, traceExpErr = jaeger.New(jaeger.WithCollectorEndpoint(opts...))
+				break
+			}
+
+			// agent (UDP) mode
 			traceExp, traceExpErr = jaeger.New(jaeger.WithAgentEndpoint(
 				jaeger.WithAgentHost(cfg.Tracing.Jaeger.Host),
 				jaeger.WithAgentPort(strconv.FormatInt(int64(cfg.Tracing.Jaeger.Port), 10)),
 			))
 		case config.TracingZipkin:
-			traceExp, traceExpErr = zipkin.New(cfg.Tracing.Zipkin.Endpoint)
+			var opts []zipkin.Option
+			if len(cfg.Tracing.Zipkin.Headers) > 0 || cfg.Tracing.Zipkin.Username != "" {
+				opts = append(opts, zipkin.WithClient(&nethttp.Client{
+					Transport: &authRoundTripper{
+						username: cfg.Tracing.Zipkin.Username,
+						password: cfg.Tracing.Zipkin.Password,
+						headers:  cfg.Tracing.Zipkin.Headers,
+						next:     nethttp.DefaultTransport,
+					},
+				}))
+			}
+
+			traceExp, traceExpErr = zipkin.New(cfg.Tracing.Zipkin.Endpoint, opts...)
 		case config.TracingOTLP:
 			u, err := url.Parse(cfg.Tracing.OTLP.Endpoint)
 			if err != nil {
@@ -615,7 +1139,7 @@ func getDB(ctx context.Context, logger *zap.Logger, cfg *config.Config, forceMig
 			return
 		}
 
-		if err := migrator.Up(forceMigrate); err != nil {
+		if err := migrator.Up(forceMigrate || cfg.Database.AutoMigrate); err != nil {
 			migrator.Close()
 			dbErr = err
 			return
@@ -643,6 +1167,11 @@ func getDB(ctx context.Context, logger *zap.Logger, cfg *config.Config, forceMig
 
 		if err := db.PingContext(ctx); err != nil {
 			dbErr = fmt.Errorf("pinging db: %w", err)
+			return
+		}
+
+		if err := fliptsql.EnsureDefaultNamespace(ctx, driver, builder, logger, cfg.Storage.DefaultNamespace); err != nil {
+			dbErr = err
 		}
 	})
 
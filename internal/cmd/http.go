@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
+	iofs "io/fs"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/fatih/color"
 	"github.com/go-chi/chi/v5"
@@ -20,12 +21,17 @@ import (
 	"go.flipt.io/flipt/internal/config"
 	"go.flipt.io/flipt/internal/gateway"
 	"go.flipt.io/flipt/internal/info"
+	"go.flipt.io/flipt/internal/server/audit/sse"
+	"go.flipt.io/flipt/internal/server/ratelimit"
+	"go.flipt.io/flipt/internal/storage/fs"
 	"go.flipt.io/flipt/rpc/flipt"
+	rpcaudit "go.flipt.io/flipt/rpc/flipt/audit"
 	"go.flipt.io/flipt/rpc/flipt/evaluation"
 	"go.flipt.io/flipt/rpc/flipt/meta"
 	"go.flipt.io/flipt/ui"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // HTTPServer is a wrapper around the construction and registration of Flipt's HTTP server.
@@ -46,6 +52,8 @@ func NewHTTPServer(
 	cfg *config.Config,
 	conn *grpc.ClientConn,
 	info info.Flipt,
+	refresher fs.Refresher,
+	sseHub *sse.Hub,
 ) (*HTTPServer, error) {
 	logger = logger.With(zap.Stringer("server", cfg.Server.Protocol))
 
@@ -73,14 +81,20 @@ func NewHTTPServer(
 		return nil, fmt.Errorf("registering grpc gateway: %w", err)
 	}
 
+	if cfg.Audit.Storage.Enabled {
+		if err := rpcaudit.RegisterAuditServiceHandler(ctx, api, conn); err != nil {
+			return nil, fmt.Errorf("registering grpc gateway: %w", err)
+		}
+	}
+
 	if cfg.Cors.Enabled {
 		cors := cors.New(cors.Options{
 			AllowedOrigins:   cfg.Cors.AllowedOrigins,
-			AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+			AllowedMethods:   cfg.Cors.AllowedMethods,
+			AllowedHeaders:   cfg.Cors.AllowedHeaders,
 			ExposedHeaders:   []string{"Link"},
-			AllowCredentials: true,
-			MaxAge:           300,
+			AllowCredentials: cfg.Cors.AllowCredentials,
+			MaxAge:           cfg.Cors.MaxAge,
 		})
 
 		r.Use(cors.Handler)
@@ -88,13 +102,34 @@ func NewHTTPServer(
 	}
 
 	// TODO: replace with more robust 'mode' detection
-	if !info.IsDevelopment() {
+	if !info.IsDevelopment() && cfg.Headers.Enabled {
 		r.Use(middleware.SetHeader("X-Content-Type-Options", "nosniff"))
-		r.Use(middleware.SetHeader("Content-Security-Policy", "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; img-src * data:; frame-ancestors 'none';"))
+
+		if cfg.Headers.ContentSecurityPolicy != "" {
+			r.Use(middleware.SetHeader("Content-Security-Policy", cfg.Headers.ContentSecurityPolicy))
+		}
+
+		if cfg.Headers.FrameOptions != "" {
+			r.Use(middleware.SetHeader("X-Frame-Options", cfg.Headers.FrameOptions))
+		}
+
+		if cfg.Headers.StrictTransportSecurity != "" {
+			r.Use(middleware.SetHeader("Strict-Transport-Security", cfg.Headers.StrictTransportSecurity))
+		}
+	}
+
+	for header, value := range cfg.Headers.Custom {
+		r.Use(middleware.SetHeader(header, value))
 	}
 
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	if len(cfg.Server.TrustedProxies) > 0 {
+		realIP, err := newRealIPMiddleware(cfg.Server.TrustedProxies)
+		if err != nil {
+			return nil, fmt.Errorf("configuring server.trusted_proxies: %w", err)
+		}
+		r.Use(realIP)
+	}
 	r.Use(middleware.Heartbeat("/health"))
 	r.Use(func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -106,7 +141,20 @@ func NewHTTPServer(
 			h.ServeHTTP(w, r)
 		})
 	})
-	r.Use(middleware.Compress(gzip.DefaultCompression))
+	if cfg.Server.Compression == config.CompressionGzip {
+		r.Use(middleware.Compress(gzip.DefaultCompression))
+	}
+	if cfg.RateLimiting.Enabled {
+		r.Use(newRateLimitMiddleware(ratelimit.New(cfg.RateLimiting.RequestsPerSecond, cfg.RateLimiting.Burst)))
+	}
+	if cfg.Server.MaxRequestBodySize > 0 {
+		r.Use(func(h http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Body = http.MaxBytesReader(w, r.Body, cfg.Server.MaxRequestBodySize)
+				h.ServeHTTP(w, r)
+			})
+		})
+	}
 	r.Use(middleware.Recoverer)
 
 	if cfg.Diagnostics.Profiling.Enabled {
@@ -115,6 +163,10 @@ func NewHTTPServer(
 
 	r.Mount("/metrics", promhttp.Handler())
 
+	if webhook := storageWebhook(cfg.Storage); webhook.Enabled && refresher != nil {
+		r.Post("/internal/v1/storage/refresh", newStorageRefreshHandler(logger, refresher, webhook))
+	}
+
 	r.Group(func(r chi.Router) {
 		r.Use(removeTrailingSlash)
 
@@ -140,6 +192,13 @@ func NewHTTPServer(
 
 		r.Mount("/api/v1", api)
 		r.Mount("/evaluate/v1", evaluateAPI)
+		r.Mount("/ofrep/v1/evaluate", newOFREPHandler(logger, conn))
+		r.Mount("/dashboard/v1", newDashboardHandler(cfg, conn))
+		r.Mount("/search/v1", newSearchHandler(conn))
+
+		if sseHub != nil {
+			r.Get("/api/v1/namespaces/{namespace}/events", newSSEHandler(logger, sseHub))
+		}
 
 		// mount all authentication related HTTP components
 		// to the chi router.
@@ -167,19 +226,31 @@ func NewHTTPServer(
 		})
 	})
 
-	fs, err := ui.FS()
-	if err != nil {
-		return nil, fmt.Errorf("mounting ui: %w", err)
-	}
+	if cfg.UI.Enabled {
+		uiFS, err := ui.FS()
+		if err != nil {
+			return nil, fmt.Errorf("mounting ui: %w", err)
+		}
 
-	r.Mount("/", http.FileServer(http.FS(fs)))
+		handler, err := uiHandler(uiFS, cfg.UI)
+		if err != nil {
+			return nil, fmt.Errorf("mounting ui: %w", err)
+		}
+
+		if basePath := cfg.UI.BasePath; basePath != "/" {
+			r.Mount(basePath, http.StripPrefix(basePath, handler))
+		} else {
+			r.Mount("/", handler)
+		}
+	}
 
 	server.Server = &http.Server{
 		Addr:           fmt.Sprintf("%s:%d", cfg.Server.Host, httpPort),
 		Handler:        r,
-		ReadTimeout:    10 * time.Second,
-		WriteTimeout:   30 * time.Second,
-		MaxHeaderBytes: 1 << 20,
+		ReadTimeout:    cfg.Server.ReadTimeout,
+		WriteTimeout:   cfg.Server.WriteTimeout,
+		IdleTimeout:    cfg.Server.IdleTimeout,
+		MaxHeaderBytes: cfg.Server.MaxHeaderBytes,
 	}
 
 	logger.Debug("starting http server")
@@ -234,11 +305,43 @@ func (h *HTTPServer) Run() error {
 	return nil
 }
 
-// Shutdown triggers the shutdown operation of the HTTP API.
+// Shutdown triggers the shutdown operation of the HTTP API. It waits for
+// in-flight requests to drain, forcibly closing any that are still open
+// once the context is done rather than leaving them to linger past the
+// configured grace period.
 func (h *HTTPServer) Shutdown(ctx context.Context) error {
 	h.logger.Info("shutting down HTTP server...")
 
-	return h.Server.Shutdown(ctx)
+	if err := h.Server.Shutdown(ctx); err != nil {
+		h.logger.Warn("shutdown grace period exceeded, forcibly closing in-flight http connections", zap.Error(err))
+		return h.Server.Close()
+	}
+
+	return nil
+}
+
+// forwardedContext returns a context derived from r's, carrying r's
+// Authorization header and Cookie header as outgoing gRPC metadata, using
+// the same metadata keys auth.UnaryInterceptor reads incoming requests for.
+//
+// grpc-gateway's generated handlers (mounted under /api/v1, /evaluate/v1,
+// /meta) do this automatically via runtime.AnnotateContext, since they're
+// built from a runtime.ServeMux. The OFREP, dashboard, and search handlers
+// talk to the gRPC server directly over the loopback connection instead, so
+// they have to forward the caller's credentials themselves, or every
+// request to them fails once authentication.required is set.
+func forwardedContext(r *http.Request) context.Context {
+	ctx := r.Context()
+
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	if cookie := r.Header.Get("Cookie"); cookie != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "grpcgateway-cookie", cookie)
+	}
+
+	return ctx
 }
 
 func removeTrailingSlash(h http.Handler) http.Handler {
@@ -247,3 +350,42 @@ func removeTrailingSlash(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r)
 	})
 }
+
+// uiHandler serves the embedded UI filesystem, rewriting index.html's
+// root-relative asset references so they resolve correctly when the UI is
+// mounted under a non-root base path or its assets are hosted externally.
+func uiHandler(uiFS iofs.FS, cfg config.UIConfig) (http.Handler, error) {
+	index, err := iofs.ReadFile(uiFS, "index.html")
+	if err != nil {
+		return nil, fmt.Errorf("reading index.html: %w", err)
+	}
+
+	prefix := strings.TrimSuffix(cfg.BasePath, "/")
+	if cfg.AssetsURL != "" {
+		prefix = strings.TrimSuffix(cfg.AssetsURL, "/")
+	}
+
+	if prefix != "" {
+		index = bytes.ReplaceAll(index, []byte(`="/`), []byte(`="`+prefix+`/`))
+	}
+
+	fileServer := http.FileServer(http.FS(uiFS))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" || r.URL.Path == "/index.html" {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write(index)
+			return
+		}
+
+		// when assets are hosted externally, Flipt only ever serves the
+		// rewritten index.html above; everything else should be fetched
+		// from the external origin rather than Flipt's own filesystem.
+		if cfg.AssetsURL != "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		fileServer.ServeHTTP(w, r)
+	}), nil
+}
@@ -3,12 +3,24 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"testing/fstest"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/config"
+	serverauth "go.flipt.io/flipt/internal/server/auth"
+	storageauth "go.flipt.io/flipt/internal/storage/auth"
+	authmemory "go.flipt.io/flipt/internal/storage/auth/memory"
+	authrpc "go.flipt.io/flipt/rpc/flipt/auth"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 const (
@@ -59,3 +71,104 @@ func TestTrailingSlashMiddleware(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, res.StatusCode)
 	res.Body.Close()
 }
+
+func TestForwardedContext(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer sometoken")
+	r.Header.Set("Cookie", "flipt_client_token=sometoken")
+
+	md, ok := metadata.FromOutgoingContext(forwardedContext(r))
+	require.True(t, ok)
+	assert.Equal(t, []string{"Bearer sometoken"}, md.Get("authorization"))
+	assert.Equal(t, []string{"flipt_client_token=sometoken"}, md.Get("grpcgateway-cookie"))
+}
+
+func TestForwardedContext_NoCredentials(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	md, ok := metadata.FromOutgoingContext(forwardedContext(r))
+	assert.False(t, ok || len(md) > 0)
+}
+
+// newTestAuthenticatedGRPCConn dials an in-memory gRPC server registering
+// registerServices, guarded by auth.UnaryInterceptor when requireAuth is
+// set, and returns the connection along with a client token valid against
+// the server's backing authentication store.
+func newTestAuthenticatedGRPCConn(t *testing.T, requireAuth bool, registerServices func(*grpc.Server)) (*grpc.ClientConn, string) {
+	t.Helper()
+
+	authenticator := authmemory.NewStore()
+	clientToken, _, err := authenticator.CreateAuthentication(context.Background(), &storageauth.CreateAuthenticationRequest{
+		Method: authrpc.Method_METHOD_TOKEN,
+	})
+	require.NoError(t, err)
+
+	var opts []grpc.ServerOption
+	if requireAuth {
+		opts = append(opts, grpc.UnaryInterceptor(serverauth.UnaryInterceptor(zaptest.NewLogger(t), authenticator)))
+	}
+
+	server := grpc.NewServer(opts...)
+	registerServices(server)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn, clientToken
+}
+
+func TestUIHandler(t *testing.T) {
+	uiFS := fstest.MapFS{
+		"index.html": &fstest.MapFile{Data: []byte(`<script src="/assets/main.js"></script>`)},
+		"assets/main.js": &fstest.MapFile{
+			Data: []byte(`console.log("hi")`),
+		},
+	}
+
+	t.Run("root base path", func(t *testing.T) {
+		h, err := uiHandler(uiFS, config.UIConfig{BasePath: "/"})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `src="/assets/main.js"`)
+
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/assets/main.js", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("non-root base path rewrites asset references", func(t *testing.T) {
+		h, err := uiHandler(uiFS, config.UIConfig{BasePath: "/ui"})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `src="/ui/assets/main.js"`)
+	})
+
+	t.Run("external assets url rewrites references and skips local assets", func(t *testing.T) {
+		h, err := uiHandler(uiFS, config.UIConfig{BasePath: "/", AssetsURL: "https://cdn.example.com"})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `src="https://cdn.example.com/assets/main.js"`)
+
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/assets/main.js", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
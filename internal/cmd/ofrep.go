@@ -0,0 +1,300 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.flipt.io/flipt/rpc/flipt"
+	"go.flipt.io/flipt/rpc/flipt/evaluation"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OFREP error codes, as defined by the OpenFeature Remote Evaluation
+// Protocol specification.
+const (
+	ofrepErrorCodeFlagNotFound = "FLAG_NOT_FOUND"
+	ofrepErrorCodeParseError   = "PARSE_ERROR"
+	ofrepErrorCodeGeneral      = "GENERAL"
+)
+
+// ofrepEvaluateRequest is the request body for both the single and bulk
+// OFREP evaluation endpoints.
+type ofrepEvaluateRequest struct {
+	Context map[string]any `json:"context"`
+}
+
+// ofrepFlagResult is a single flag's result, as returned by the single flag
+// evaluation endpoint or as an entry in the bulk evaluation endpoint's
+// "flags" array.
+type ofrepFlagResult struct {
+	Key          string `json:"key"`
+	Value        any    `json:"value,omitempty"`
+	Variant      string `json:"variant,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+	ErrorCode    string `json:"errorCode,omitempty"`
+	ErrorDetails string `json:"errorDetails,omitempty"`
+}
+
+// ofrepBulkResponse is the response body for the bulk evaluation endpoint.
+type ofrepBulkResponse struct {
+	Flags []ofrepFlagResult `json:"flags"`
+}
+
+// newOFREPHandler mounts the OFREP HTTP surface on top of the existing
+// EvaluationService and FliptService, so any OFREP-capable OpenFeature SDK
+// can evaluate Flipt flags without a custom provider.
+// See: https://github.com/open-feature/protocol
+func newOFREPHandler(logger *zap.Logger, conn *grpc.ClientConn) http.Handler {
+	var (
+		fliptClient = flipt.NewFliptClient(conn)
+		evalClient  = evaluation.NewEvaluationServiceClient(conn)
+	)
+
+	r := chi.NewRouter()
+	r.Post("/flags/{key}", ofrepEvaluateFlagHandler(logger, fliptClient, evalClient))
+	r.Post("/flags", ofrepEvaluateBulkHandler(logger, fliptClient, evalClient))
+
+	return r
+}
+
+func ofrepEvaluateFlagHandler(logger *zap.Logger, fliptClient flipt.FliptClient, evalClient evaluation.EvaluationServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var (
+			ctx       = forwardedContext(r)
+			key       = chi.URLParam(r, "key")
+			namespace = ofrepNamespace(r)
+		)
+
+		req, err := decodeOFREPRequest(r)
+		if err != nil {
+			writeOFREPResult(w, http.StatusBadRequest, ofrepFlagResult{
+				Key:          key,
+				ErrorCode:    ofrepErrorCodeParseError,
+				ErrorDetails: err.Error(),
+			})
+			return
+		}
+
+		flag, err := fliptClient.GetFlag(ctx, &flipt.GetFlagRequest{Key: key, NamespaceKey: namespace})
+		if err != nil {
+			result, code := ofrepErrorResult(key, err)
+			writeOFREPResult(w, code, result)
+			return
+		}
+
+		entityID, evalCtx := ofrepEvaluationContext(req.Context)
+
+		result, err := ofrepEvaluateFlag(ctx, evalClient, namespace, flag, entityID, evalCtx)
+		if err != nil {
+			logger.Error("evaluating ofrep flag", zap.String("flag_key", key), zap.Error(err))
+			result, code := ofrepErrorResult(key, err)
+			writeOFREPResult(w, code, result)
+			return
+		}
+
+		writeOFREPResult(w, http.StatusOK, result)
+	}
+}
+
+func ofrepEvaluateBulkHandler(logger *zap.Logger, fliptClient flipt.FliptClient, evalClient evaluation.EvaluationServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var (
+			ctx       = forwardedContext(r)
+			namespace = ofrepNamespace(r)
+		)
+
+		req, err := decodeOFREPRequest(r)
+		if err != nil {
+			writeOFREPResult(w, http.StatusBadRequest, ofrepFlagResult{
+				ErrorCode:    ofrepErrorCodeParseError,
+				ErrorDetails: err.Error(),
+			})
+			return
+		}
+
+		entityID, evalCtx := ofrepEvaluationContext(req.Context)
+
+		flags, err := ofrepListFlags(ctx, fliptClient, namespace)
+		if err != nil {
+			result, code := ofrepErrorResult("", err)
+			writeOFREPResult(w, code, result)
+			return
+		}
+
+		resp := ofrepBulkResponse{Flags: make([]ofrepFlagResult, 0, len(flags))}
+		for _, flag := range flags {
+			result, err := ofrepEvaluateFlag(ctx, evalClient, namespace, flag, entityID, evalCtx)
+			if err != nil {
+				logger.Error("evaluating ofrep flag", zap.String("flag_key", flag.Key), zap.Error(err))
+				result, _ = ofrepErrorResult(flag.Key, err)
+			}
+
+			resp.Flags = append(resp.Flags, result)
+		}
+
+		writeOFREPResult(w, http.StatusOK, resp)
+	}
+}
+
+// ofrepListFlags returns every flag in namespace, following pagination until
+// the flag list is exhausted.
+func ofrepListFlags(ctx context.Context, fliptClient flipt.FliptClient, namespace string) ([]*flipt.Flag, error) {
+	var (
+		flags     []*flipt.Flag
+		pageToken string
+	)
+
+	for {
+		list, err := fliptClient.ListFlags(ctx, &flipt.ListFlagRequest{NamespaceKey: namespace, PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+
+		flags = append(flags, list.Flags...)
+
+		if list.NextPageToken == "" {
+			break
+		}
+
+		pageToken = list.NextPageToken
+	}
+
+	return flags, nil
+}
+
+// ofrepEvaluateFlag evaluates flag against the evaluation engine via
+// evalClient, translating the response into OFREP's flag result shape.
+func ofrepEvaluateFlag(ctx context.Context, evalClient evaluation.EvaluationServiceClient, namespace string, flag *flipt.Flag, entityID string, evalCtx map[string]string) (ofrepFlagResult, error) {
+	req := &evaluation.EvaluationRequest{
+		NamespaceKey: namespace,
+		FlagKey:      flag.Key,
+		EntityId:     entityID,
+		Context:      evalCtx,
+	}
+
+	if flag.Type == flipt.FlagType_BOOLEAN_FLAG_TYPE {
+		resp, err := evalClient.Boolean(ctx, req)
+		if err != nil {
+			return ofrepFlagResult{}, err
+		}
+
+		return ofrepFlagResult{
+			Key:    flag.Key,
+			Value:  resp.Enabled,
+			Reason: ofrepReason(resp.Reason),
+		}, nil
+	}
+
+	resp, err := evalClient.Variant(ctx, req)
+	if err != nil {
+		return ofrepFlagResult{}, err
+	}
+
+	var value any = resp.VariantKey
+	if resp.VariantAttachment != "" {
+		var attachment any
+		if err := json.Unmarshal([]byte(resp.VariantAttachment), &attachment); err == nil {
+			value = attachment
+		}
+	}
+
+	return ofrepFlagResult{
+		Key:     flag.Key,
+		Value:   value,
+		Variant: resp.VariantKey,
+		Reason:  ofrepReason(resp.Reason),
+	}, nil
+}
+
+func ofrepReason(reason evaluation.EvaluationReason) string {
+	switch reason {
+	case evaluation.EvaluationReason_MATCH_EVALUATION_REASON:
+		return "TARGETING_MATCH"
+	case evaluation.EvaluationReason_FLAG_DISABLED_EVALUATION_REASON:
+		return "DISABLED"
+	case evaluation.EvaluationReason_DEFAULT_EVALUATION_REASON:
+		return "DEFAULT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ofrepNamespace returns the Flipt namespace an OFREP request should
+// evaluate against. Flipt's namespacing isn't part of the OFREP spec, so
+// callers opt into a non-default namespace via the "namespace" query
+// parameter.
+func ofrepNamespace(r *http.Request) string {
+	if namespace := r.URL.Query().Get("namespace"); namespace != "" {
+		return namespace
+	}
+
+	return flipt.DefaultNamespace
+}
+
+// ofrepEvaluationContext splits an OFREP evaluation context into the entity
+// ID used for percentage-based rollouts (the well-known "targetingKey"
+// attribute) and the remaining attributes, stringified for Flipt's
+// evaluation context.
+func ofrepEvaluationContext(raw map[string]any) (entityID string, evalCtx map[string]string) {
+	evalCtx = make(map[string]string, len(raw))
+
+	for k, v := range raw {
+		if v == nil {
+			continue
+		}
+
+		if s, ok := v.(string); ok {
+			evalCtx[k] = s
+		} else {
+			evalCtx[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	entityID = evalCtx["targetingKey"]
+
+	return entityID, evalCtx
+}
+
+func decodeOFREPRequest(r *http.Request) (ofrepEvaluateRequest, error) {
+	var req ofrepEvaluateRequest
+
+	if r.Body == nil {
+		return req, nil
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		return req, errors.New("invalid request body")
+	}
+
+	return req, nil
+}
+
+// ofrepErrorResult translates an error returned from the gRPC evaluation
+// engine into an OFREP error result and the HTTP status code it should be
+// returned with.
+func ofrepErrorResult(key string, err error) (ofrepFlagResult, int) {
+	result := ofrepFlagResult{Key: key, ErrorDetails: err.Error()}
+
+	if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+		result.ErrorCode = ofrepErrorCodeFlagNotFound
+		return result, http.StatusNotFound
+	}
+
+	result.ErrorCode = ofrepErrorCodeGeneral
+
+	return result, http.StatusInternalServerError
+}
+
+func writeOFREPResult(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.flipt.io/flipt/rpc/flipt"
+	"go.flipt.io/flipt/rpc/flipt/evaluation"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+)
+
+// fakeFliptServer is a flipt.FliptServer that answers ListFlags with a fixed,
+// empty list, enough to exercise the auth path without needing a full flag
+// store behind it.
+type fakeFliptServer struct {
+	flipt.UnimplementedFliptServer
+}
+
+func (fakeFliptServer) ListFlags(context.Context, *flipt.ListFlagRequest) (*flipt.FlagList, error) {
+	return &flipt.FlagList{}, nil
+}
+
+func (fakeFliptServer) ListNamespaces(context.Context, *flipt.ListNamespaceRequest) (*flipt.NamespaceList, error) {
+	return &flipt.NamespaceList{}, nil
+}
+
+func newOFREPTestHandler(t *testing.T, requireAuth bool) (http.Handler, string) {
+	t.Helper()
+
+	conn, clientToken := newTestAuthenticatedGRPCConn(t, requireAuth, func(server *grpc.Server) {
+		flipt.RegisterFliptServer(server, fakeFliptServer{})
+		evaluation.RegisterEvaluationServiceServer(server, evaluation.UnimplementedEvaluationServiceServer{})
+	})
+
+	return newOFREPHandler(zaptest.NewLogger(t), conn), clientToken
+}
+
+func TestOFREPHandler_AuthNotRequired(t *testing.T) {
+	handler, _ := newOFREPTestHandler(t, false)
+
+	rec := httptest.NewRecorder()
+	// no Authorization header at all
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/flags", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestOFREPHandler_AuthRequired(t *testing.T) {
+	handler, clientToken := newOFREPTestHandler(t, true)
+
+	t.Run("missing credentials are rejected", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/flags", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "not authenticated")
+	})
+
+	t.Run("forwarded Authorization header is honored", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/flags", nil)
+		req.Header.Set("Authorization", "Bearer "+clientToken)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"net/http"
+
+	"go.flipt.io/flipt/internal/server/ratelimit"
+)
+
+// newRateLimitMiddleware returns HTTP middleware which rejects requests with
+// a 429 once the caller has exceeded the configured requests-per-second/burst,
+// identifying callers by their Authorization header where present and by
+// remote address otherwise.
+func newRateLimitMiddleware(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get("Authorization")
+			if key == "" {
+				key = r.RemoteAddr
+			}
+
+			if !limiter.Allow(key) {
+				http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
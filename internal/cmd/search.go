@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"go.flipt.io/flipt/rpc/flipt"
+	"google.golang.org/grpc"
+)
+
+// searchResult is a single flag or segment match, qualified with the
+// namespace it was found in so the UI can link straight to it without the
+// caller needing to already know which namespace it lives in.
+type searchResult struct {
+	Type         string `json:"type"`
+	NamespaceKey string `json:"namespaceKey"`
+	Key          string `json:"key"`
+	Name         string `json:"name"`
+}
+
+// searchAllResponse is the response body for the cross-namespace search
+// endpoint.
+type searchAllResponse struct {
+	Results []searchResult `json:"results"`
+}
+
+// newSearchHandler mounts the cross-namespace search HTTP surface on top of
+// the existing FliptService, so callers can find a flag or segment by key
+// or name without already knowing which namespace it lives in.
+func newSearchHandler(conn *grpc.ClientConn) http.Handler {
+	client := flipt.NewFliptClient(conn)
+
+	r := chi.NewRouter()
+	r.Get("/all", searchAllHandler(client))
+
+	return r
+}
+
+func searchAllHandler(client flipt.FliptClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := strings.TrimSpace(r.URL.Query().Get("q"))
+		if query == "" {
+			http.Error(w, `missing required query parameter "q"`, http.StatusBadRequest)
+			return
+		}
+
+		results, err := searchAll(forwardedContext(r), client, query)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(searchAllResponse{Results: results})
+	}
+}
+
+// searchAll searches flag and segment keys/names across every namespace the
+// caller can read, returning namespace-qualified results. Namespace
+// visibility and any per-namespace authorization is enforced upstream by
+// ListNamespaces/ListFlags/ListSegments, the same as every other management
+// API call.
+func searchAll(ctx context.Context, client flipt.FliptClient, query string) ([]searchResult, error) {
+	namespaces, err := dashboardListNamespaces(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []searchResult
+
+	for _, namespace := range namespaces {
+		flags, err := dashboardListFlags(ctx, client, namespace.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, flag := range flags {
+			if searchMatches(query, flag.Key, flag.Name) {
+				results = append(results, searchResult{
+					Type:         "flag",
+					NamespaceKey: namespace.Key,
+					Key:          flag.Key,
+					Name:         flag.Name,
+				})
+			}
+		}
+
+		segments, err := searchListSegments(ctx, client, namespace.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, segment := range segments {
+			if searchMatches(query, segment.Key, segment.Name) {
+				results = append(results, searchResult{
+					Type:         "segment",
+					NamespaceKey: namespace.Key,
+					Key:          segment.Key,
+					Name:         segment.Name,
+				})
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// searchListSegments returns every segment in namespace, following
+// pagination until the segment list is exhausted.
+func searchListSegments(ctx context.Context, client flipt.FliptClient, namespace string) ([]*flipt.Segment, error) {
+	var (
+		segments  []*flipt.Segment
+		pageToken string
+	)
+
+	for {
+		list, err := client.ListSegments(ctx, &flipt.ListSegmentRequest{NamespaceKey: namespace, PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+
+		segments = append(segments, list.Segments...)
+
+		if list.NextPageToken == "" {
+			break
+		}
+
+		pageToken = list.NextPageToken
+	}
+
+	return segments, nil
+}
+
+// searchMatches reports whether query is a case-insensitive substring of
+// either key or name.
+func searchMatches(query, key, name string) bool {
+	query = strings.ToLower(query)
+	return strings.Contains(strings.ToLower(key), query) || strings.Contains(strings.ToLower(name), query)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.flipt.io/flipt/rpc/flipt"
+	"google.golang.org/grpc"
+)
+
+func newSearchTestHandler(t *testing.T, requireAuth bool) (http.Handler, string) {
+	t.Helper()
+
+	conn, clientToken := newTestAuthenticatedGRPCConn(t, requireAuth, func(server *grpc.Server) {
+		flipt.RegisterFliptServer(server, fakeFliptServer{})
+	})
+
+	return newSearchHandler(conn), clientToken
+}
+
+func TestSearchHandler_AuthNotRequired(t *testing.T) {
+	handler, _ := newSearchTestHandler(t, false)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/all?q=test", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestSearchHandler_AuthRequired(t *testing.T) {
+	handler, clientToken := newSearchTestHandler(t, true)
+
+	t.Run("missing credentials are rejected", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/all?q=test", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("forwarded Authorization header is honored", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/all?q=test", nil)
+		req.Header.Set("Authorization", "Bearer "+clientToken)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
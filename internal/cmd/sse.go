@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.flipt.io/flipt/internal/server/audit/sse"
+	"go.uber.org/zap"
+)
+
+// sseHeartbeatInterval is how often a comment is written to idle connections
+// to keep them from being closed by intermediate proxies.
+const sseHeartbeatInterval = 15 * time.Second
+
+// newSSEHandler streams flag change events for a single namespace to the
+// client as they happen, backed by hub. Clients may resume a dropped
+// connection from the point they left off by sending the Last-Event-ID
+// header from their most recently received event.
+func newSSEHandler(logger *zap.Logger, hub *sse.Hub) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming not supported", http.StatusInternalServerError)
+			return
+		}
+
+		namespace := chi.URLParam(r, "namespace")
+
+		var lastEventID int64
+		if id := r.Header.Get("Last-Event-ID"); id != "" {
+			parsed, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				http.Error(w, "invalid Last-Event-ID", http.StatusBadRequest)
+				return
+			}
+
+			lastEventID = parsed
+		}
+
+		backlog, events, unsubscribe := hub.Subscribe(namespace, lastEventID)
+		defer unsubscribe()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+
+		for _, e := range backlog {
+			if !writeSSEEvent(w, e) {
+				return
+			}
+		}
+		flusher.Flush()
+
+		heartbeat := time.NewTicker(sseHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		ctx := r.Context()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-events:
+				if !ok {
+					return
+				}
+
+				if !writeSSEEvent(w, e) {
+					return
+				}
+
+				flusher.Flush()
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+					logger.Debug("writing sse heartbeat", zap.Error(err))
+					return
+				}
+
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// writeSSEEvent writes e to w in the text/event-stream wire format, and
+// reports whether the write succeeded.
+func writeSSEEvent(w http.ResponseWriter, e sse.Event) bool {
+	payload, err := json.Marshal(e.Event)
+	if err != nil {
+		// malformed payloads are skipped rather than closing the stream.
+		return true
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Event.Type, payload)
+	return err == nil
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"go.flipt.io/flipt/internal/config"
+)
+
+var (
+	headerTrueClientIP  = http.CanonicalHeaderKey("True-Client-IP")
+	headerXRealIP       = http.CanonicalHeaderKey("X-Real-IP")
+	headerXForwardedFor = http.CanonicalHeaderKey("X-Forwarded-For")
+)
+
+// newRealIPMiddleware returns HTTP middleware which sets a request's
+// RemoteAddr from the True-Client-IP, X-Real-IP or X-Forwarded-For headers,
+// but only when the immediate peer (r.RemoteAddr) matches one of the
+// configured trusted proxies. This prevents an untrusted client from simply
+// spoofing these headers to forge its own address, which would otherwise
+// undermine rate limiting and audit logging.
+func newRealIPMiddleware(trustedProxies []string) (func(http.Handler) http.Handler, error) {
+	nets := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		ipNet, err := config.ParseTrustedProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, ipNet)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isTrustedPeer(r.RemoteAddr, nets) {
+				if rip := forwardedClientIP(r); rip != "" {
+					r.RemoteAddr = rip
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+func isTrustedPeer(remoteAddr string, trusted []*net.IPNet) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, ipNet := range trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func forwardedClientIP(r *http.Request) string {
+	var ip string
+
+	switch {
+	case r.Header.Get(headerTrueClientIP) != "":
+		ip = r.Header.Get(headerTrueClientIP)
+	case r.Header.Get(headerXRealIP) != "":
+		ip = r.Header.Get(headerXRealIP)
+	case r.Header.Get(headerXForwardedFor) != "":
+		xff := r.Header.Get(headerXForwardedFor)
+		if i := strings.Index(xff, ","); i != -1 {
+			xff = xff[:i]
+		}
+		ip = strings.TrimSpace(xff)
+	}
+
+	if ip == "" || net.ParseIP(ip) == nil {
+		return ""
+	}
+
+	return ip
+}
@@ -0,0 +1,38 @@
+// Package zstd registers zstd as an available gRPC compressor, alongside
+// the gzip compressor gRPC registers by default, so that a client may opt
+// into it via grpc.UseCompressor("zstd").
+package zstd
+
+import (
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+)
+
+// Name is the wire name of this compressor, as sent in the grpc-encoding
+// header.
+const Name = "zstd"
+
+func init() {
+	encoding.RegisterCompressor(compressor{})
+}
+
+type compressor struct{}
+
+func (compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w)
+}
+
+func (compressor) Decompress(r io.Reader) (io.Reader, error) {
+	dec, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return dec.IOReadCloser(), nil
+}
+
+func (compressor) Name() string {
+	return Name
+}
@@ -13,14 +13,16 @@ var _ defaulter = (*AuditConfig)(nil)
 // AuditConfig contains fields, which enable and configure
 // Flipt's various audit sink mechanisms.
 type AuditConfig struct {
-	Sinks  SinksConfig  `json:"sinks,omitempty" mapstructure:"sinks" yaml:"sinks,omitempty"`
-	Buffer BufferConfig `json:"buffer,omitempty" mapstructure:"buffer" yaml:"buffer,omitempty"`
-	Events []string     `json:"events,omitempty" mapstructure:"events" yaml:"events,omitempty"`
+	Sinks       SinksConfig       `json:"sinks,omitempty" mapstructure:"sinks" yaml:"sinks,omitempty"`
+	Storage     AuditStorage      `json:"storage,omitempty" mapstructure:"storage" yaml:"storage,omitempty"`
+	Buffer      BufferConfig      `json:"buffer,omitempty" mapstructure:"buffer" yaml:"buffer,omitempty"`
+	Events      []string          `json:"events,omitempty" mapstructure:"events" yaml:"events,omitempty"`
+	CloudEvents CloudEventsConfig `json:"cloudevents,omitempty" mapstructure:"cloudevents" yaml:"cloudevents,omitempty"`
 }
 
 // Enabled returns true if any nested sink is enabled
 func (c AuditConfig) Enabled() bool {
-	return c.Sinks.LogFile.Enabled || c.Sinks.Webhook.Enabled
+	return c.Sinks.LogFile.Enabled || c.Sinks.Webhook.Enabled || c.Sinks.Kafka.Enabled || c.Sinks.Object.Enabled || c.Sinks.Slack.Enabled || c.Sinks.NATS.Enabled || c.Sinks.SSE.Enabled || c.Storage.Enabled
 }
 
 func (c AuditConfig) IsZero() bool {
@@ -31,18 +33,50 @@ func (c *AuditConfig) setDefaults(v *viper.Viper) error {
 	v.SetDefault("audit", map[string]any{
 		"sinks": map[string]any{
 			"log": map[string]any{
-				"enabled": "false",
-				"file":    "",
+				"enabled":     "false",
+				"file":        "",
+				"max_size":    0,
+				"max_age":     0,
+				"max_backups": 0,
+				"compress":    "false",
 			},
 			"webhook": map[string]any{
 				"enabled": "false",
 			},
+			"kafka": map[string]any{
+				"enabled":       "false",
+				"batch_size":    100,
+				"batch_timeout": "1s",
+				"require_tls":   "false",
+			},
+			"object": map[string]any{
+				"enabled":      "false",
+				"type":         "",
+				"flush_period": "5m",
+			},
+			"slack": map[string]any{
+				"enabled": "false",
+			},
+			"nats": map[string]any{
+				"enabled": "false",
+			},
+			"sse": map[string]any{
+				"enabled": "false",
+			},
+		},
+		"storage": map[string]any{
+			"enabled": "false",
 		},
 		"buffer": map[string]any{
 			"capacity":     2,
 			"flush_period": "2m",
 		},
 		"events": []string{"*:*"},
+		"cloudevents": map[string]any{
+			"enabled":     "false",
+			"source":      "flipt",
+			"type_prefix": "io.flipt.event",
+		},
 	})
 
 	return nil
@@ -63,6 +97,49 @@ func (c *AuditConfig) validate() error {
 		}
 	}
 
+	if c.Sinks.Kafka.Enabled {
+		if len(c.Sinks.Kafka.Brokers) == 0 {
+			return errors.New("brokers not specified")
+		}
+
+		if c.Sinks.Kafka.Topic == "" {
+			return errors.New("topic not specified")
+		}
+	}
+
+	if c.Sinks.Object.Enabled {
+		switch c.Sinks.Object.Type {
+		case ObjectSinkTypeS3:
+			if c.Sinks.Object.S3.Bucket == "" {
+				return errors.New("bucket not specified")
+			}
+		case ObjectSinkTypeGCS:
+			if c.Sinks.Object.GCS.Bucket == "" {
+				return errors.New("bucket not specified")
+			}
+		default:
+			return errors.New("type must be one of 's3' or 'gcs'")
+		}
+
+		if c.Sinks.Object.FlushPeriod < time.Minute {
+			return errors.New("flush period below 1 minute")
+		}
+	}
+
+	if c.Sinks.Slack.Enabled && c.Sinks.Slack.WebhookURL == "" {
+		return errors.New("webhook url not specified")
+	}
+
+	if c.Sinks.NATS.Enabled {
+		if c.Sinks.NATS.URL == "" {
+			return errors.New("url not specified")
+		}
+
+		if c.Sinks.NATS.Subject == "" {
+			return errors.New("subject not specified")
+		}
+	}
+
 	if c.Buffer.Capacity < 2 || c.Buffer.Capacity > 10 {
 		return errors.New("buffer capacity below 2 or above 10")
 	}
@@ -74,11 +151,32 @@ func (c *AuditConfig) validate() error {
 	return nil
 }
 
+// AuditStorage contains configuration for persisting audit events to Flipt's
+// database, so they can be queried back out again through the AuditService API.
+type AuditStorage struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+}
+
+// CloudEventsConfig contains configuration for wrapping audit events in a
+// CloudEvents 1.0 envelope before they are sent to the webhook and Kafka
+// sinks, so downstream event routers and knative consumers can ingest them
+// without an adapter.
+type CloudEventsConfig struct {
+	Enabled    bool   `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	Source     string `json:"source,omitempty" mapstructure:"source" yaml:"source,omitempty"`
+	TypePrefix string `json:"typePrefix,omitempty" mapstructure:"type_prefix" yaml:"typePrefix,omitempty"`
+}
+
 // SinksConfig contains configuration held in structures for the different sinks
 // that we will send audits to.
 type SinksConfig struct {
 	LogFile LogFileSinkConfig `json:"log,omitempty" mapstructure:"log" yaml:"log,omitempty"`
 	Webhook WebhookSinkConfig `json:"webhook,omitempty" mapstructure:"webhook" yaml:"webhook,omitempty"`
+	Kafka   KafkaSinkConfig   `json:"kafka,omitempty" mapstructure:"kafka" yaml:"kafka,omitempty"`
+	Object  ObjectSinkConfig  `json:"object,omitempty" mapstructure:"object" yaml:"object,omitempty"`
+	Slack   SlackSinkConfig   `json:"slack,omitempty" mapstructure:"slack" yaml:"slack,omitempty"`
+	NATS    NATSSinkConfig    `json:"nats,omitempty" mapstructure:"nats" yaml:"nats,omitempty"`
+	SSE     SSESinkConfig     `json:"sse,omitempty" mapstructure:"sse" yaml:"sse,omitempty"`
 }
 
 // WebhookSinkConfig contains configuration for sending POST requests to specific
@@ -92,10 +190,109 @@ type WebhookSinkConfig struct {
 }
 
 // LogFileSinkConfig contains fields that hold configuration for sending audits
-// to a log file.
+// to a log file. MaxSize, MaxAge, MaxBackups, and Compress configure rotation of
+// the file; leaving all of them unset disables rotation and the file is appended
+// to indefinitely.
 type LogFileSinkConfig struct {
-	Enabled bool   `json:"enabled,omitempty" mapstructure:"enabled" yaml:"enabled,omitempty"`
-	File    string `json:"file,omitempty" mapstructure:"file" yaml:"file,omitempty"`
+	Enabled    bool   `json:"enabled,omitempty" mapstructure:"enabled" yaml:"enabled,omitempty"`
+	File       string `json:"file,omitempty" mapstructure:"file" yaml:"file,omitempty"`
+	MaxSize    int    `json:"maxSize,omitempty" mapstructure:"max_size" yaml:"maxSize,omitempty"`
+	MaxAge     int    `json:"maxAge,omitempty" mapstructure:"max_age" yaml:"maxAge,omitempty"`
+	MaxBackups int    `json:"maxBackups,omitempty" mapstructure:"max_backups" yaml:"maxBackups,omitempty"`
+	Compress   bool   `json:"compress,omitempty" mapstructure:"compress" yaml:"compress,omitempty"`
+}
+
+// KafkaSinkConfig contains configuration for producing audit events onto a Kafka topic.
+type KafkaSinkConfig struct {
+	Enabled        bool                      `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	Brokers        []string                  `json:"brokers,omitempty" mapstructure:"brokers" yaml:"brokers,omitempty"`
+	Topic          string                    `json:"topic,omitempty" mapstructure:"topic" yaml:"topic,omitempty"`
+	RequireTLS     bool                      `json:"requireTLS,omitempty" mapstructure:"require_tls" yaml:"requireTLS,omitempty"`
+	BatchSize      int                       `json:"batchSize,omitempty" mapstructure:"batch_size" yaml:"batchSize,omitempty"`
+	BatchTimeout   time.Duration             `json:"batchTimeout,omitempty" mapstructure:"batch_timeout" yaml:"batchTimeout,omitempty"`
+	Authentication KafkaAuthenticationConfig `json:"authentication,omitempty" mapstructure:"authentication" yaml:"authentication,omitempty"`
+}
+
+// KafkaAuthenticationConfig contains the SASL credentials used to authenticate with the configured
+// Kafka brokers. Mechanism supports "plain", "scram-sha-256", and "scram-sha-512"; it is left empty
+// to connect without SASL.
+type KafkaAuthenticationConfig struct {
+	Mechanism string `json:"mechanism,omitempty" mapstructure:"mechanism" yaml:"mechanism,omitempty"`
+	Username  string `json:"username,omitempty" mapstructure:"username" yaml:"username,omitempty"`
+	Password  string `json:"-" mapstructure:"password" yaml:"-"`
+}
+
+// ObjectSinkType enumerates the cloud object store backends supported by the
+// object sink.
+type ObjectSinkType string
+
+const (
+	ObjectSinkTypeS3  ObjectSinkType = "s3"
+	ObjectSinkTypeGCS ObjectSinkType = "gcs"
+)
+
+// ObjectSinkConfig contains configuration for buffering audit events and
+// periodically flushing them as newline-delimited JSON objects to a bucket
+// in a cloud object store.
+type ObjectSinkConfig struct {
+	Enabled     bool                `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	Type        ObjectSinkType      `json:"type,omitempty" mapstructure:"type" yaml:"type,omitempty"`
+	FlushPeriod time.Duration       `json:"flushPeriod,omitempty" mapstructure:"flush_period" yaml:"flushPeriod,omitempty"`
+	S3          ObjectS3SinkConfig  `json:"s3,omitempty" mapstructure:"s3" yaml:"s3,omitempty"`
+	GCS         ObjectGCSSinkConfig `json:"gcs,omitempty" mapstructure:"gcs" yaml:"gcs,omitempty"`
+}
+
+// ObjectS3SinkConfig configures the S3 bucket that audit event batches are
+// written to when ObjectSinkConfig.Type is "s3".
+type ObjectS3SinkConfig struct {
+	Bucket   string `json:"bucket,omitempty" mapstructure:"bucket" yaml:"bucket,omitempty"`
+	Prefix   string `json:"prefix,omitempty" mapstructure:"prefix" yaml:"prefix,omitempty"`
+	Region   string `json:"region,omitempty" mapstructure:"region" yaml:"region,omitempty"`
+	Endpoint string `json:"endpoint,omitempty" mapstructure:"endpoint" yaml:"endpoint,omitempty"`
+}
+
+// ObjectGCSSinkConfig configures the GCS bucket that audit event batches are
+// written to when ObjectSinkConfig.Type is "gcs". Credentials are resolved
+// via Application Default Credentials.
+type ObjectGCSSinkConfig struct {
+	Bucket string `json:"bucket,omitempty" mapstructure:"bucket" yaml:"bucket,omitempty"`
+	Prefix string `json:"prefix,omitempty" mapstructure:"prefix" yaml:"prefix,omitempty"`
+}
+
+// SlackSinkConfig contains configuration for posting a formatted notification
+// to a Slack or Mattermost incoming webhook when a flag is toggled or a
+// rollout changes in one of Namespaces. An empty Namespaces notifies for
+// every namespace.
+type SlackSinkConfig struct {
+	Enabled    bool     `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	WebhookURL string   `json:"webhookUrl,omitempty" mapstructure:"webhook_url" yaml:"webhookUrl,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty" mapstructure:"namespaces" yaml:"namespaces,omitempty"`
+}
+
+// NATSSinkConfig contains configuration for publishing audit events as
+// messages on a NATS subject, so that other internal services - including
+// other Flipt replicas - can react to changes without polling.
+type NATSSinkConfig struct {
+	Enabled        bool                     `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	URL            string                   `json:"url,omitempty" mapstructure:"url" yaml:"url,omitempty"`
+	Subject        string                   `json:"subject,omitempty" mapstructure:"subject" yaml:"subject,omitempty"`
+	Authentication NATSAuthenticationConfig `json:"authentication,omitempty" mapstructure:"authentication" yaml:"authentication,omitempty"`
+}
+
+// NATSAuthenticationConfig contains the credentials used to authenticate
+// with the configured NATS server. Either Token, or Username and Password,
+// may be set; it is left empty to connect without authentication.
+type NATSAuthenticationConfig struct {
+	Token    string `json:"-" mapstructure:"token" yaml:"-"`
+	Username string `json:"username,omitempty" mapstructure:"username" yaml:"username,omitempty"`
+	Password string `json:"-" mapstructure:"password" yaml:"-"`
+}
+
+// SSESinkConfig contains configuration for streaming flag changes to
+// connected clients over Server-Sent Events, in addition to recording them
+// for audit purposes.
+type SSESinkConfig struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
 }
 
 // BufferConfig holds configuration for the buffering of sending the audit
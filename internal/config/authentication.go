@@ -115,6 +115,15 @@ func (c *AuthenticationConfig) setDefaults(v *viper.Viper) error {
 		"session": map[string]any{
 			"token_lifetime": "24h",
 			"state_lifetime": "10m",
+			"storage": map[string]any{
+				"backend": string(AuthenticationSessionStorageSQL),
+				"redis": map[string]any{
+					"host":     "localhost",
+					"port":     6379,
+					"password": "",
+					"db":       0,
+				},
+			},
 		},
 		"methods": methods,
 	})
@@ -197,8 +206,31 @@ type AuthenticationSession struct {
 	StateLifetime time.Duration `json:"stateLifetime,omitempty" mapstructure:"state_lifetime" yaml:"state_lifetime,omitempty"`
 	// CSRF configures CSRF provention mechanisms.
 	CSRF AuthenticationSessionCSRF `json:"csrf,omitempty" mapstructure:"csrf" yaml:"csrf,omitempty"`
+	// Storage configures where authentication state (client tokens, OIDC sessions) is persisted.
+	Storage AuthenticationSessionStorage `json:"storage,omitempty" mapstructure:"storage" yaml:"storage,omitempty"`
 }
 
+// AuthenticationSessionStorage selects and configures the backing store for authentication state.
+type AuthenticationSessionStorage struct {
+	// Backend selects which storage backend persists authentication state.
+	// Defaults to "sql", storing alongside the rest of Flipt's data.
+	Backend AuthenticationSessionStorageBackend `json:"backend,omitempty" mapstructure:"backend" yaml:"backend,omitempty"`
+	// Redis configures the connection used when Backend is "redis", allowing stateless
+	// replicas behind a load balancer to share authentication state without the primary
+	// database in the hot path.
+	Redis RedisCacheConfig `json:"redis,omitempty" mapstructure:"redis" yaml:"redis,omitempty"`
+}
+
+// AuthenticationSessionStorageBackend is either sql or redis.
+type AuthenticationSessionStorageBackend string
+
+const (
+	// AuthenticationSessionStorageSQL stores authentication state in Flipt's configured SQL database. This is the default.
+	AuthenticationSessionStorageSQL = AuthenticationSessionStorageBackend("sql")
+	// AuthenticationSessionStorageRedis stores authentication state in Redis.
+	AuthenticationSessionStorageRedis = AuthenticationSessionStorageBackend("redis")
+)
+
 // AuthenticationSessionCSRF configures cross-site request forgery prevention.
 type AuthenticationSessionCSRF struct {
 	// Key is the private key string used to authenticate csrf tokens.
@@ -212,6 +244,8 @@ type AuthenticationMethods struct {
 	Github     AuthenticationMethod[AuthenticationMethodGithubConfig]     `json:"github,omitempty" mapstructure:"github" yaml:"github,omitempty"`
 	OIDC       AuthenticationMethod[AuthenticationMethodOIDCConfig]       `json:"oidc,omitempty" mapstructure:"oidc" yaml:"oidc,omitempty"`
 	Kubernetes AuthenticationMethod[AuthenticationMethodKubernetesConfig] `json:"kubernetes,omitempty" mapstructure:"kubernetes" yaml:"kubernetes,omitempty"`
+	JWT        AuthenticationMethod[AuthenticationMethodJWTConfig]        `json:"jwt,omitempty" mapstructure:"jwt" yaml:"jwt,omitempty"`
+	MTLS       AuthenticationMethod[AuthenticationMethodMTLSConfig]       `json:"mtls,omitempty" mapstructure:"mtls" yaml:"mtls,omitempty"`
 }
 
 // AllMethods returns all the AuthenticationMethod instances available.
@@ -221,6 +255,8 @@ func (a *AuthenticationMethods) AllMethods() []StaticAuthenticationMethodInfo {
 		a.Github.info(),
 		a.OIDC.info(),
 		a.Kubernetes.info(),
+		a.JWT.info(),
+		a.MTLS.info(),
 	}
 }
 
@@ -326,7 +362,11 @@ type AuthenticationMethodTokenConfig struct {
 	Bootstrap AuthenticationMethodTokenBootstrapConfig `json:"bootstrap" mapstructure:"bootstrap" yaml:"bootstrap"`
 }
 
-func (a AuthenticationMethodTokenConfig) setDefaults(map[string]any) {}
+func (a AuthenticationMethodTokenConfig) setDefaults(defaults map[string]any) {
+	defaults["bootstrap"] = map[string]any{
+		"scope": string(AuthenticationMethodTokenBootstrapScopeAdmin),
+	}
+}
 
 // info describes properties of the authentication method "token".
 func (a AuthenticationMethodTokenConfig) info() AuthenticationMethodInfo {
@@ -341,7 +381,29 @@ func (a AuthenticationMethodTokenConfig) info() AuthenticationMethodInfo {
 type AuthenticationMethodTokenBootstrapConfig struct {
 	Token      string        `json:"-" mapstructure:"token" yaml:"token"`
 	Expiration time.Duration `json:"expiration,omitempty" mapstructure:"expiration" yaml:"expiration,omitempty"`
-}
+	// Scope restricts what the bootstrap token is permitted to do.
+	// Defaults to "admin", which grants unrestricted access.
+	Scope AuthenticationMethodTokenBootstrapScope `json:"scope,omitempty" mapstructure:"scope" yaml:"scope,omitempty"`
+}
+
+// AuthenticationMethodTokenBootstrapScope restricts the set of APIs a bootstrap
+// token is permitted to call.
+type AuthenticationMethodTokenBootstrapScope string
+
+const (
+	// AuthenticationMethodTokenBootstrapScopeAdmin grants unrestricted access. This is the default.
+	AuthenticationMethodTokenBootstrapScopeAdmin = AuthenticationMethodTokenBootstrapScope("admin")
+	// AuthenticationMethodTokenBootstrapScopeWrite restricts the token to the management APIs
+	// permitted for the built-in "editor" role (read and write, but not namespace management).
+	AuthenticationMethodTokenBootstrapScopeWrite = AuthenticationMethodTokenBootstrapScope("write")
+	// AuthenticationMethodTokenBootstrapScopeRead restricts the token to read-only management
+	// APIs, for tooling that needs visibility into flag state but must never mutate it.
+	AuthenticationMethodTokenBootstrapScopeRead = AuthenticationMethodTokenBootstrapScope("read")
+	// AuthenticationMethodTokenBootstrapScopeEvaluate restricts the token to only the evaluation
+	// APIs, for SDKs and applications that only need to evaluate flags and must never be able to
+	// read or manage flag state directly.
+	AuthenticationMethodTokenBootstrapScopeEvaluate = AuthenticationMethodTokenBootstrapScope("evaluate")
+)
 
 // AuthenticationMethodOIDCConfig configures the OIDC authentication method.
 // This method can be used to establish browser based sessions.
@@ -410,12 +472,30 @@ type AuthenticationMethodKubernetesConfig struct {
 	// ServiceAccountTokenPath is the location on disk to the Flipt instances service account token.
 	// This should be the token issued for the service account associated with Flipt in the environment.
 	ServiceAccountTokenPath string `json:"serviceAccountTokenPath,omitempty" mapstructure:"service_account_token_path" yaml:"service_account_token_path,omitempty"`
-}
+	// Mode selects how a presented service account token is verified.
+	// Defaults to "oidc".
+	Mode KubernetesAuthenticationMode `json:"mode,omitempty" mapstructure:"mode" yaml:"mode,omitempty"`
+}
+
+// KubernetesAuthenticationMode selects how the "kubernetes" authentication
+// method verifies a presented service account token.
+type KubernetesAuthenticationMode string
+
+const (
+	// KubernetesAuthenticationModeOIDC verifies tokens against the cluster's
+	// OIDC discovery endpoint and JWKS key material. This is the default.
+	KubernetesAuthenticationModeOIDC = KubernetesAuthenticationMode("oidc")
+	// KubernetesAuthenticationModeTokenReview verifies tokens by calling the
+	// Kubernetes TokenReview API. This is useful when the cluster's service
+	// account issuer doesn't expose a reachable OIDC discovery endpoint.
+	KubernetesAuthenticationModeTokenReview = KubernetesAuthenticationMode("token_review")
+)
 
 func (a AuthenticationMethodKubernetesConfig) setDefaults(defaults map[string]any) {
 	defaults["discovery_url"] = "https://kubernetes.default.svc.cluster.local"
 	defaults["ca_path"] = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
 	defaults["service_account_token_path"] = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaults["mode"] = string(KubernetesAuthenticationModeOIDC)
 }
 
 // info describes properties of the authentication method "kubernetes".
@@ -426,17 +506,89 @@ func (a AuthenticationMethodKubernetesConfig) info() AuthenticationMethodInfo {
 	}
 }
 
+// AuthenticationMethodJWTConfig contains the fields necessary to validate externally-issued
+// JWTs presented directly as Flipt bearer credentials. Unlike the other authentication
+// methods, no Flipt specific client token is minted: the presented JWT itself is verified
+// on every request, so services already holding a valid token (e.g. from a service mesh)
+// can call Flipt without first exchanging it.
+type AuthenticationMethodJWTConfig struct {
+	// JWKSURL is the URL serving the JSON Web Key Set used to verify token signatures.
+	JWKSURL string `json:"jwksURL,omitempty" mapstructure:"jwks_url" yaml:"jwks_url,omitempty"`
+	// Issuer is the expected value of the token's "iss" claim.
+	Issuer string `json:"issuer,omitempty" mapstructure:"issuer" yaml:"issuer,omitempty"`
+	// Audience is the expected value of the token's "aud" claim.
+	// If empty, the audience is not checked.
+	Audience string `json:"audience,omitempty" mapstructure:"audience" yaml:"audience,omitempty"`
+}
+
+func (a AuthenticationMethodJWTConfig) setDefaults(defaults map[string]any) {}
+
+// info describes properties of the authentication method "jwt".
+func (a AuthenticationMethodJWTConfig) info() AuthenticationMethodInfo {
+	return AuthenticationMethodInfo{
+		Method:            auth.Method_METHOD_JWT,
+		SessionCompatible: false,
+	}
+}
+
+// AuthenticationMethodMTLSConfig contains the fields necessary to authenticate callers by the
+// client certificate they present during a mutual TLS handshake. Like the "jwt" method, no
+// Flipt specific client token is minted: the certificate presented on the connection is itself
+// the proof of identity, and is re-verified on every request.
+type AuthenticationMethodMTLSConfig struct {
+	// CAFile is the path to a PEM encoded certificate bundle used to verify presented
+	// client certificates. Flipt's gRPC/HTTP server is configured to request (and
+	// require) a client certificate signed by one of these CAs once this method is enabled.
+	CAFile string `json:"-" mapstructure:"ca_file" yaml:"-"`
+	// AllowedSubjects restricts authentication to certificates whose subject common name
+	// matches one of these values. If empty (along with AllowedDNSNames), any certificate
+	// verified against CAFile is allowed.
+	AllowedSubjects []string `json:"allowedSubjects,omitempty" mapstructure:"allowed_subjects" yaml:"allowed_subjects,omitempty"`
+	// AllowedDNSNames restricts authentication to certificates presenting at least one
+	// subject alternative name (SAN) matching one of these values. If empty (along with
+	// AllowedSubjects), SANs aren't checked.
+	AllowedDNSNames []string `json:"allowedDnsNames,omitempty" mapstructure:"allowed_dns_names" yaml:"allowed_dns_names,omitempty"`
+}
+
+func (a AuthenticationMethodMTLSConfig) setDefaults(defaults map[string]any) {}
+
+// info describes properties of the authentication method "mtls".
+func (a AuthenticationMethodMTLSConfig) info() AuthenticationMethodInfo {
+	return AuthenticationMethodInfo{
+		Method:            auth.Method_METHOD_MTLS,
+		SessionCompatible: false,
+	}
+}
+
 // AuthenticationMethodGithubConfig contains configuration and information for completing an OAuth
-// 2.0 flow with GitHub as a provider.
+// 2.0 flow with GitHub (or GitHub Enterprise) as a provider.
 type AuthenticationMethodGithubConfig struct {
 	ClientId        string   `json:"-" mapstructure:"client_id" yaml:"-"`
 	ClientSecret    string   `json:"-" mapstructure:"client_secret" yaml:"-"`
 	RedirectAddress string   `json:"redirectAddress,omitempty" mapstructure:"redirect_address" yaml:"redirect_address,omitempty"`
 	Scopes          []string `json:"scopes,omitempty" mapstructure:"scopes" yaml:"scopes,omitempty"`
+	// ServerURL is the base URL of the GitHub (Enterprise) instance hosting the OAuth
+	// endpoints. Defaults to the public "https://github.com".
+	ServerURL string `json:"serverUrl,omitempty" mapstructure:"server_url" yaml:"server_url,omitempty"`
+	// ApiURL is the base URL of the GitHub (Enterprise) REST API used to fetch the
+	// authenticated user's profile and organization/team memberships. Defaults to the
+	// public "https://api.github.com".
+	ApiURL string `json:"apiUrl,omitempty" mapstructure:"api_url" yaml:"api_url,omitempty"`
+	// AllowedOrganizations restricts login to members of at least one of these GitHub
+	// organizations. Requires the "read:org" OAuth scope. If empty (along with
+	// AllowedTeams), organization membership isn't checked.
+	AllowedOrganizations []string `json:"allowedOrganizations,omitempty" mapstructure:"allowed_organizations" yaml:"allowed_organizations,omitempty"`
+	// AllowedTeams restricts login to members of at least one of these GitHub teams,
+	// each specified as "org/team-slug". Requires the "read:org" OAuth scope. If empty
+	// (along with AllowedOrganizations), team membership isn't checked.
+	AllowedTeams []string `json:"allowedTeams,omitempty" mapstructure:"allowed_teams" yaml:"allowed_teams,omitempty"`
+}
+
+func (a AuthenticationMethodGithubConfig) setDefaults(defaults map[string]any) {
+	defaults["server_url"] = "https://github.com"
+	defaults["api_url"] = "https://api.github.com"
 }
 
-func (a AuthenticationMethodGithubConfig) setDefaults(defaults map[string]any) {}
-
 // info describes properties of the authentication method "github".
 func (a AuthenticationMethodGithubConfig) info() AuthenticationMethodInfo {
 	info := AuthenticationMethodInfo{
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// builtinRoles are the role names Flipt ships with out of the box. Custom
+// roles configured under AuthorizationConfig.Roles must inherit from one of
+// these.
+var builtinRoles = map[string]struct{}{
+	"viewer": {},
+	"editor": {},
+	"admin":  {},
+}
+
+// AuthorizationConfig configures Flipt's role-based access control (RBAC)
+// policy, which is enforced on top of authentication.
+type AuthorizationConfig struct {
+	// Required designates whether authenticated requests are also subject to
+	// role checks. Requires Authentication.Required to also be true, as
+	// authorization has no meaning without an authenticated identity.
+	Required bool `json:"required" mapstructure:"required" yaml:"required"`
+
+	// Roles allows defining custom roles in addition to the built-in
+	// "viewer", "editor" and "admin" roles. A custom role inherits the
+	// permissions of one of the built-in roles, optionally restricted to a
+	// single namespace.
+	Roles map[string]AuthorizationRole `json:"roles,omitempty" mapstructure:"roles" yaml:"roles,omitempty"`
+
+	// Policy configures an external OPA/Rego policy engine which, in
+	// addition to role checks, is consulted on every management request for
+	// organizations whose access rules can't be expressed with roles alone.
+	Policy PolicyConfig `json:"policy,omitempty" mapstructure:"policy" yaml:"policy,omitempty"`
+}
+
+// PolicyConfig configures authorization decisions backed by an external
+// Open Policy Agent server. Flipt queries the server's Data API with the
+// subject, verb, resource and namespace of the request as input, and denies
+// the request unless the response is an unambiguous `true`.
+type PolicyConfig struct {
+	// Required enables policy enforcement. Requires
+	// AuthorizationConfig.Required to also be true, as policy checks run
+	// alongside (not instead of) role checks.
+	Required bool `json:"required" mapstructure:"required" yaml:"required"`
+
+	// URL is the address of the policy decision to query, e.g.
+	// "http://opa:8181/v1/data/flipt/authz/allow".
+	URL string `json:"url,omitempty" mapstructure:"url" yaml:"url,omitempty"`
+}
+
+// AuthorizationRole defines a custom role which can be assigned to an
+// authentication (e.g. a token or OIDC identity) in place of a built-in role.
+type AuthorizationRole struct {
+	// Inherits is the built-in role ("viewer", "editor" or "admin") this
+	// custom role derives its permitted actions from.
+	Inherits string `json:"inherits" mapstructure:"inherits" yaml:"inherits"`
+	// Namespace restricts the role to only apply to the named namespace.
+	// Requests against any other namespace are denied.
+	Namespace string `json:"namespace,omitempty" mapstructure:"namespace" yaml:"namespace,omitempty"`
+}
+
+func (c *AuthorizationConfig) validate() error {
+	if !c.Required {
+		return nil
+	}
+
+	for name, role := range c.Roles {
+		if _, ok := builtinRoles[role.Inherits]; !ok {
+			return errFieldWrap(fmt.Sprintf("authorization.roles.%s.inherits", name), errValidationRequired)
+		}
+	}
+
+	if c.Policy.Required {
+		if c.Policy.URL == "" {
+			return errFieldWrap("authorization.policy.url", errValidationRequired)
+		}
+
+		u, err := url.Parse(c.Policy.URL)
+		if err != nil {
+			return errFieldWrap("authorization.policy.url", err)
+		}
+
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("authorization.policy.url: must be an absolute URL")
+		}
+	}
+
+	return nil
+}
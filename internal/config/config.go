@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 	"github.com/uber/jaeger-client-go"
@@ -25,6 +27,7 @@ var DecodeHooks = []mapstructure.DecodeHookFunc{
 	stringToEnumHookFunc(stringToCacheBackend),
 	stringToEnumHookFunc(stringToTracingExporter),
 	stringToEnumHookFunc(stringToScheme),
+	stringToEnumHookFunc(stringToCompressionType),
 	stringToEnumHookFunc(stringToDatabaseProtocol),
 	stringToEnumHookFunc(stringToAuthMethod),
 }
@@ -45,13 +48,22 @@ type Config struct {
 	Version        string               `json:"version,omitempty" mapstructure:"version,omitempty" yaml:"version,omitempty"`
 	Audit          AuditConfig          `json:"audit,omitempty" mapstructure:"audit" yaml:"audit,omitempty"`
 	Authentication AuthenticationConfig `json:"authentication,omitempty" mapstructure:"authentication" yaml:"authentication,omitempty"`
+	Authorization  AuthorizationConfig  `json:"authorization,omitempty" mapstructure:"authorization" yaml:"authorization,omitempty"`
 	Cache          CacheConfig          `json:"cache,omitempty" mapstructure:"cache" yaml:"cache,omitempty"`
 	Cors           CorsConfig           `json:"cors,omitempty" mapstructure:"cors" yaml:"cors,omitempty"`
 	Database       DatabaseConfig       `json:"db,omitempty" mapstructure:"db" yaml:"db,omitempty"`
 	Diagnostics    DiagnosticConfig     `json:"diagnostics,omitempty" mapstructure:"diagnostics" yaml:"diagnostics,omitempty"`
+	Encryption     EncryptionConfig     `json:"encryption,omitempty" mapstructure:"encryption" yaml:"encryption,omitempty"`
+	Evaluation     EvaluationConfig     `json:"evaluation,omitempty" mapstructure:"evaluation" yaml:"evaluation,omitempty"`
 	Experimental   ExperimentalConfig   `json:"experimental,omitempty" mapstructure:"experimental" yaml:"experimental,omitempty"`
+	Flags          FlagsConfig          `json:"flags,omitempty" mapstructure:"flags" yaml:"flags,omitempty"`
+	Headers        HeadersConfig        `json:"headers,omitempty" mapstructure:"headers" yaml:"headers,omitempty"`
+	Idempotency    IdempotencyConfig    `json:"idempotency,omitempty" mapstructure:"idempotency" yaml:"idempotency,omitempty"`
+	LoadShedding   LoadSheddingConfig   `json:"loadShedding,omitempty" mapstructure:"load_shedding" yaml:"load_shedding,omitempty"`
 	Log            LogConfig            `json:"log,omitempty" mapstructure:"log" yaml:"log,omitempty"`
 	Meta           MetaConfig           `json:"meta,omitempty" mapstructure:"meta" yaml:"meta,omitempty"`
+	RateLimiting   RateLimitingConfig   `json:"rateLimiting,omitempty" mapstructure:"rate_limiting" yaml:"rate_limiting,omitempty"`
+	Rollout        RolloutConfig        `json:"rollout,omitempty" mapstructure:"rollout" yaml:"rollout,omitempty"`
 	Server         ServerConfig         `json:"server,omitempty" mapstructure:"server" yaml:"server,omitempty"`
 	Storage        StorageConfig        `json:"storage,omitempty" mapstructure:"storage" yaml:"storage,omitempty"`
 	Tracing        TracingConfig        `json:"tracing,omitempty" mapstructure:"tracing" yaml:"tracing,omitempty"`
@@ -61,6 +73,10 @@ type Config struct {
 type Result struct {
 	Config   *Config
 	Warnings []string
+
+	// vaultSecrets holds any secrets resolved from Vault with a
+	// renewable lease, for the config.Watcher to watch for expiry.
+	vaultSecrets []*vaultapi.Secret
 }
 
 func Load(path string) (*Result, error) {
@@ -75,8 +91,14 @@ func Load(path string) (*Result, error) {
 		cfg = Default()
 	} else {
 		cfg = &Config{}
-		v.SetConfigFile(path)
-		if err := v.ReadInConfig(); err != nil {
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading configuration: %w", err)
+		}
+
+		v.SetConfigType(strings.TrimPrefix(filepath.Ext(path), "."))
+		if err := v.ReadConfig(bytes.NewReader(expandEnv(data))); err != nil {
 			return nil, fmt.Errorf("loading configuration: %w", err)
 		}
 	}
@@ -162,6 +184,16 @@ func Load(path string) (*Result, error) {
 		return nil, err
 	}
 
+	// resolve any secret-bearing fields configured via the "*_file"
+	// convention or a "vault:" reference before running validation, so
+	// validators see the resolved values.
+	vaultSecrets, err := resolveSecrets(v, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	result.vaultSecrets = vaultSecrets
+
 	// run any validation steps
 	for _, validator := range validators {
 		if err := validator.validate(); err != nil {
@@ -443,11 +475,17 @@ func Default() *Config {
 
 		UI: UIConfig{
 			DefaultTheme: SystemUITheme,
+			Enabled:      true,
+			BasePath:     "/",
 		},
 
 		Cors: CorsConfig{
-			Enabled:        false,
-			AllowedOrigins: []string{"*"},
+			Enabled:          false,
+			AllowedOrigins:   []string{"*"},
+			AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
+			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+			AllowCredentials: true,
+			MaxAge:           300,
 		},
 
 		Cache: CacheConfig{
@@ -476,17 +514,41 @@ func Default() *Config {
 			},
 		},
 
+		Encryption: EncryptionConfig{
+			Enabled: false,
+			Type:    EncryptionTypeLocal,
+		},
+
+		Evaluation: EvaluationConfig{
+			Enabled:         false,
+			RefreshInterval: 1 * time.Minute,
+		},
+
 		Server: ServerConfig{
-			Host:      "0.0.0.0",
-			Protocol:  HTTP,
-			HTTPPort:  8080,
-			HTTPSPort: 443,
-			GRPCPort:  9000,
+			Host:                "0.0.0.0",
+			Protocol:            HTTP,
+			HTTPPort:            8080,
+			HTTPSPort:           443,
+			GRPCPort:            9000,
+			Compression:         CompressionGzip,
+			ReadTimeout:         10 * time.Second,
+			WriteTimeout:        30 * time.Second,
+			IdleTimeout:         120 * time.Second,
+			MaxHeaderBytes:      1 << 20,
+			ShutdownGracePeriod: 5 * time.Second,
+			GRPCKeepalive: GRPCKeepaliveConfig{
+				Time:    2 * time.Hour,
+				Timeout: 20 * time.Second,
+			},
 		},
 
 		Tracing: TracingConfig{
 			Enabled:  false,
 			Exporter: TracingJaeger,
+			Sampling: SamplingConfig{
+				Type:  SamplingAlways,
+				Ratio: 1.0,
+			},
 			Jaeger: JaegerTracingConfig{
 				Host: jaeger.DefaultUDPSpanServerHost,
 				Port: jaeger.DefaultUDPSpanServerPort,
@@ -503,22 +565,74 @@ func Default() *Config {
 			URL:                       "file:" + dbPath,
 			MaxIdleConn:               2,
 			PreparedStatementsEnabled: true,
+			AutoMigrate:               false,
 		},
 
 		Storage: StorageConfig{
 			Type: DatabaseStorageType,
+			DefaultNamespace: DefaultNamespaceConfig{
+				Key:  "default",
+				Name: "Default",
+			},
+			SnapshotCache: SnapshotCacheConfig{
+				Backend: SnapshotCacheRedis,
+				Redis: RedisCacheConfig{
+					Host: "localhost",
+					Port: 6379,
+				},
+				Key: "flipt/snapshot",
+			},
+			SnapshotErrorPolicy: SnapshotErrorPolicyConfig{
+				Mode: SnapshotErrorModeFailOpen,
+			},
 		},
 
 		Meta: MetaConfig{
 			CheckForUpdates:  true,
 			TelemetryEnabled: true,
 			StateDirectory:   "",
+			Environment:      "default",
+		},
+
+		RateLimiting: RateLimitingConfig{
+			Enabled:           false,
+			RequestsPerSecond: 1000,
+			Burst:             50,
+		},
+
+		Flags: FlagsConfig{
+			MaxVariantAttachmentSize: 10000,
+		},
+
+		Headers: HeadersConfig{
+			Enabled:               true,
+			ContentSecurityPolicy: "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; img-src * data:; frame-ancestors 'none';",
+			FrameOptions:          "DENY",
+		},
+
+		Idempotency: IdempotencyConfig{
+			Enabled: false,
+			TTL:     5 * time.Minute,
+		},
+
+		LoadShedding: LoadSheddingConfig{
+			Enabled:       false,
+			MinLimit:      10,
+			MaxLimit:      1000,
+			TargetLatency: 500 * time.Millisecond,
 		},
 
 		Authentication: AuthenticationConfig{
 			Session: AuthenticationSession{
 				TokenLifetime: 24 * time.Hour,
 				StateLifetime: 10 * time.Minute,
+				Storage: AuthenticationSessionStorage{
+					Backend: AuthenticationSessionStorageSQL,
+					Redis: RedisCacheConfig{
+						Host: "localhost",
+						Port: 6379,
+					},
+				},
 			},
 		},
 
@@ -528,12 +642,23 @@ func Default() *Config {
 					Enabled: false,
 					File:    "",
 				},
+				Kafka: KafkaSinkConfig{
+					BatchSize:    100,
+					BatchTimeout: time.Second,
+				},
+				Object: ObjectSinkConfig{
+					FlushPeriod: 5 * time.Minute,
+				},
 			},
 			Buffer: BufferConfig{
 				Capacity:    2,
 				FlushPeriod: 2 * time.Minute,
 			},
 			Events: []string{"*:*"},
+			CloudEvents: CloudEventsConfig{
+				Source:     "flipt",
+				TypePrefix: "io.flipt.event",
+			},
 		},
 	}
 }
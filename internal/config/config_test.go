@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -365,6 +366,11 @@ func TestLoad(t *testing.T) {
 			path:    "./testdata/database/missing_name.yml",
 			wantErr: errValidationRequired,
 		},
+		{
+			name:    "authorization role with unknown inherits",
+			path:    "./testdata/authorization/unknown_inherits.yml",
+			wantErr: errValidationRequired,
+		},
 		{
 			name:    "authentication token negative interval",
 			path:    "./testdata/authentication/token_negative_interval.yml",
@@ -397,6 +403,11 @@ func TestLoad(t *testing.T) {
 				cfg.Authentication.Methods = AuthenticationMethods{
 					Token: AuthenticationMethod[AuthenticationMethodTokenConfig]{
 						Enabled: true,
+						Method: AuthenticationMethodTokenConfig{
+							Bootstrap: AuthenticationMethodTokenBootstrapConfig{
+								Scope: AuthenticationMethodTokenBootstrapScopeAdmin,
+							},
+						},
 						Cleanup: &AuthenticationCleanupSchedule{
 							Interval:    time.Hour,
 							GracePeriod: 30 * time.Minute,
@@ -425,6 +436,50 @@ func TestLoad(t *testing.T) {
 							DiscoveryURL:            "https://kubernetes.default.svc.cluster.local",
 							CAPath:                  "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
 							ServiceAccountTokenPath: "/var/run/secrets/kubernetes.io/serviceaccount/token",
+							Mode:                    KubernetesAuthenticationModeOIDC,
+						},
+						Cleanup: &AuthenticationCleanupSchedule{
+							Interval:    time.Hour,
+							GracePeriod: 30 * time.Minute,
+						},
+					},
+				}
+				return cfg
+			},
+		},
+		{
+			name: "authentication jwt",
+			path: "./testdata/authentication/jwt.yml",
+			expected: func() *Config {
+				cfg := Default()
+				cfg.Authentication.Methods = AuthenticationMethods{
+					JWT: AuthenticationMethod[AuthenticationMethodJWTConfig]{
+						Enabled: true,
+						Method: AuthenticationMethodJWTConfig{
+							JWKSURL:  "https://issuer.example.com/.well-known/jwks.json",
+							Issuer:   "https://issuer.example.com",
+							Audience: "flipt",
+						},
+						Cleanup: &AuthenticationCleanupSchedule{
+							Interval:    time.Hour,
+							GracePeriod: 30 * time.Minute,
+						},
+					},
+				}
+				return cfg
+			},
+		},
+		{
+			name: "authentication mtls",
+			path: "./testdata/authentication/mtls.yml",
+			expected: func() *Config {
+				cfg := Default()
+				cfg.Authentication.Methods = AuthenticationMethods{
+					MTLS: AuthenticationMethod[AuthenticationMethodMTLSConfig]{
+						Enabled: true,
+						Method: AuthenticationMethodMTLSConfig{
+							AllowedSubjects: []string{"flipt-client"},
+							AllowedDNSNames: []string{"flipt-client.internal"},
 						},
 						Cleanup: &AuthenticationCleanupSchedule{
 							Interval:    time.Hour,
@@ -447,12 +502,23 @@ func TestLoad(t *testing.T) {
 							Enabled: true,
 							File:    "/path/to/logs.txt",
 						},
+						Kafka: KafkaSinkConfig{
+							BatchSize:    100,
+							BatchTimeout: time.Second,
+						},
+						Object: ObjectSinkConfig{
+							FlushPeriod: 5 * time.Minute,
+						},
 					},
 					Buffer: BufferConfig{
 						Capacity:    10,
 						FlushPeriod: 3 * time.Minute,
 					},
 					Events: []string{"*:*"},
+					CloudEvents: CloudEventsConfig{
+						Source:     "flipt",
+						TypePrefix: "io.flipt.event",
+					},
 				}
 
 				cfg.Log = LogConfig{
@@ -467,8 +533,12 @@ func TestLoad(t *testing.T) {
 					},
 				}
 				cfg.Cors = CorsConfig{
-					Enabled:        true,
-					AllowedOrigins: []string{"foo.com", "bar.com", "baz.com"},
+					Enabled:          true,
+					AllowedOrigins:   []string{"foo.com", "bar.com", "baz.com"},
+					AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
+					AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+					AllowCredentials: true,
+					MaxAge:           300,
 				}
 				cfg.Cache.Enabled = true
 				cfg.Cache.Backend = CacheMemory
@@ -477,17 +547,31 @@ func TestLoad(t *testing.T) {
 					EvictionInterval: 5 * time.Minute,
 				}
 				cfg.Server = ServerConfig{
-					Host:      "127.0.0.1",
-					Protocol:  HTTPS,
-					HTTPPort:  8081,
-					HTTPSPort: 8080,
-					GRPCPort:  9001,
-					CertFile:  "./testdata/ssl_cert.pem",
-					CertKey:   "./testdata/ssl_key.pem",
+					Host:                "127.0.0.1",
+					Protocol:            HTTPS,
+					HTTPPort:            8081,
+					HTTPSPort:           8080,
+					GRPCPort:            9001,
+					CertFile:            "./testdata/ssl_cert.pem",
+					CertKey:             "./testdata/ssl_key.pem",
+					Compression:         CompressionGzip,
+					ReadTimeout:         10 * time.Second,
+					WriteTimeout:        30 * time.Second,
+					IdleTimeout:         120 * time.Second,
+					MaxHeaderBytes:      1 << 20,
+					ShutdownGracePeriod: 5 * time.Second,
+					GRPCKeepalive: GRPCKeepaliveConfig{
+						Time:    2 * time.Hour,
+						Timeout: 20 * time.Second,
+					},
 				}
 				cfg.Tracing = TracingConfig{
 					Enabled:  true,
 					Exporter: TracingOTLP,
+					Sampling: SamplingConfig{
+						Type:  SamplingAlways,
+						Ratio: 1.0,
+					},
 					Jaeger: JaegerTracingConfig{
 						Host: "localhost",
 						Port: 6831,
@@ -500,7 +584,10 @@ func TestLoad(t *testing.T) {
 					},
 				}
 				cfg.Storage = StorageConfig{
-					Type: GitStorageType,
+					DefaultNamespace:    DefaultNamespaceConfig{Key: "default", Name: "Default"},
+					SnapshotCache:       SnapshotCacheConfig{Backend: SnapshotCacheRedis, Redis: RedisCacheConfig{Host: "localhost", Port: 6379}, Key: "flipt/snapshot"},
+					SnapshotErrorPolicy: SnapshotErrorPolicyConfig{Mode: SnapshotErrorModeFailOpen},
+					Type:                GitStorageType,
 					Git: &Git{
 						Repository:   "https://github.com/flipt-io/flipt.git",
 						Ref:          "production",
@@ -511,6 +598,14 @@ func TestLoad(t *testing.T) {
 								Password: "pass",
 							},
 						},
+						WriteBack: WriteBack{
+							CommitMessageTemplate: "chore: update Flipt state via API",
+							AuthorName:            "Flipt",
+							AuthorEmail:           "dev@flipt.io",
+							PullRequest: PullRequest{
+								BranchTemplate: "flipt/{{.Timestamp}}",
+							},
+						},
 					},
 				}
 				cfg.Database = DatabaseConfig{
@@ -523,6 +618,7 @@ func TestLoad(t *testing.T) {
 				cfg.Meta = MetaConfig{
 					CheckForUpdates:  false,
 					TelemetryEnabled: false,
+					Environment:      "default",
 				}
 				cfg.Authentication = AuthenticationConfig{
 					Required: true,
@@ -534,10 +630,22 @@ func TestLoad(t *testing.T) {
 						CSRF: AuthenticationSessionCSRF{
 							Key: "abcdefghijklmnopqrstuvwxyz1234567890", //gitleaks:allow
 						},
+						Storage: AuthenticationSessionStorage{
+							Backend: AuthenticationSessionStorageSQL,
+							Redis: RedisCacheConfig{
+								Host: "localhost",
+								Port: 6379,
+							},
+						},
 					},
 					Methods: AuthenticationMethods{
 						Token: AuthenticationMethod[AuthenticationMethodTokenConfig]{
 							Enabled: true,
+							Method: AuthenticationMethodTokenConfig{
+								Bootstrap: AuthenticationMethodTokenBootstrapConfig{
+									Scope: AuthenticationMethodTokenBootstrapScopeAdmin,
+								},
+							},
 							Cleanup: &AuthenticationCleanupSchedule{
 								Interval:    2 * time.Hour,
 								GracePeriod: 48 * time.Hour,
@@ -566,6 +674,7 @@ func TestLoad(t *testing.T) {
 								DiscoveryURL:            "https://some-other-k8s.namespace.svc",
 								CAPath:                  "/path/to/ca/certificate/ca.pem",
 								ServiceAccountTokenPath: "/path/to/sa/token",
+								Mode:                    KubernetesAuthenticationModeOIDC,
 							},
 							Cleanup: &AuthenticationCleanupSchedule{
 								Interval:    2 * time.Hour,
@@ -577,6 +686,8 @@ func TestLoad(t *testing.T) {
 								ClientId:        "abcdefg",
 								ClientSecret:    "bcdefgh",
 								RedirectAddress: "http://auth.flipt.io",
+								ServerURL:       "https://github.com",
+								ApiURL:          "https://api.github.com",
 							},
 							Enabled: true,
 							Cleanup: &AuthenticationCleanupSchedule{
@@ -624,7 +735,10 @@ func TestLoad(t *testing.T) {
 			expected: func() *Config {
 				cfg := Default()
 				cfg.Storage = StorageConfig{
-					Type: LocalStorageType,
+					DefaultNamespace:    DefaultNamespaceConfig{Key: "default", Name: "Default"},
+					SnapshotCache:       SnapshotCacheConfig{Backend: SnapshotCacheRedis, Redis: RedisCacheConfig{Host: "localhost", Port: 6379}, Key: "flipt/snapshot"},
+					SnapshotErrorPolicy: SnapshotErrorPolicyConfig{Mode: SnapshotErrorModeFailOpen},
+					Type:                LocalStorageType,
 					Local: &Local{
 						Path: ".",
 					},
@@ -638,11 +752,22 @@ func TestLoad(t *testing.T) {
 			expected: func() *Config {
 				cfg := Default()
 				cfg.Storage = StorageConfig{
-					Type: GitStorageType,
+					DefaultNamespace:    DefaultNamespaceConfig{Key: "default", Name: "Default"},
+					SnapshotCache:       SnapshotCacheConfig{Backend: SnapshotCacheRedis, Redis: RedisCacheConfig{Host: "localhost", Port: 6379}, Key: "flipt/snapshot"},
+					SnapshotErrorPolicy: SnapshotErrorPolicyConfig{Mode: SnapshotErrorModeFailOpen},
+					Type:                GitStorageType,
 					Git: &Git{
 						Ref:          "main",
 						Repository:   "git@github.com:foo/bar.git",
 						PollInterval: 30 * time.Second,
+						WriteBack: WriteBack{
+							CommitMessageTemplate: "chore: update Flipt state via API",
+							AuthorName:            "Flipt",
+							AuthorEmail:           "dev@flipt.io",
+							PullRequest: PullRequest{
+								BranchTemplate: "flipt/{{.Timestamp}}",
+							},
+						},
 					},
 				}
 				return cfg
@@ -664,7 +789,10 @@ func TestLoad(t *testing.T) {
 			expected: func() *Config {
 				cfg := Default()
 				cfg.Storage = StorageConfig{
-					Type: ObjectStorageType,
+					DefaultNamespace:    DefaultNamespaceConfig{Key: "default", Name: "Default"},
+					SnapshotCache:       SnapshotCacheConfig{Backend: SnapshotCacheRedis, Redis: RedisCacheConfig{Host: "localhost", Port: 6379}, Key: "flipt/snapshot"},
+					SnapshotErrorPolicy: SnapshotErrorPolicyConfig{Mode: SnapshotErrorModeFailOpen},
+					Type:                ObjectStorageType,
 					Object: &Object{
 						Type: S3ObjectSubStorageType,
 						S3: &S3{
@@ -682,7 +810,10 @@ func TestLoad(t *testing.T) {
 			expected: func() *Config {
 				cfg := Default()
 				cfg.Storage = StorageConfig{
-					Type: ObjectStorageType,
+					DefaultNamespace:    DefaultNamespaceConfig{Key: "default", Name: "Default"},
+					SnapshotCache:       SnapshotCacheConfig{Backend: SnapshotCacheRedis, Redis: RedisCacheConfig{Host: "localhost", Port: 6379}, Key: "flipt/snapshot"},
+					SnapshotErrorPolicy: SnapshotErrorPolicyConfig{Mode: SnapshotErrorModeFailOpen},
+					Type:                ObjectStorageType,
 					Object: &Object{
 						Type: S3ObjectSubStorageType,
 						S3: &S3{
@@ -810,6 +941,28 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestLoad_SecretFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db-password")
+	require.NoError(t, os.WriteFile(path, []byte("s3cr3t\n"), 0600))
+
+	t.Setenv("FLIPT_DB_PASSWORD_FILE", path)
+
+	res, err := Load("./testdata/default.yml")
+	require.NoError(t, err)
+
+	assert.Equal(t, "s3cr3t", res.Config.Database.Password)
+}
+
+func TestLoad_EnvExpansion(t *testing.T) {
+	t.Setenv("FLIPT_TEST_SERVER_HOST", "example.com")
+
+	res, err := Load("./testdata/env_expansion.yml")
+	require.NoError(t, err)
+
+	assert.Equal(t, "INFO", res.Config.Log.Level)
+	assert.Equal(t, "example.com", res.Config.Server.Host)
+}
+
 func TestServeHTTP(t *testing.T) {
 	var (
 		cfg = Default()
@@ -849,6 +1002,19 @@ func getEnvVars(prefix string, v map[any]any) (vals [][2]string) {
 		switch v := value.(type) {
 		case map[any]any:
 			vals = append(vals, getEnvVars(fmt.Sprintf("%s_%v", prefix, key), v)...)
+		case []any:
+			items := make([]string, len(v))
+			for i, item := range v {
+				items[i] = fmt.Sprintf("%v", item)
+			}
+
+			// config fields backed by []string are decoded from a single env var by
+			// splitting on whitespace (see stringToSliceHookFunc), so encode list
+			// values the same way rather than Go's default "[a b]" representation.
+			vals = append(vals, [2]string{
+				fmt.Sprintf("%s_%s", strings.ToUpper(prefix), strings.ToUpper(fmt.Sprintf("%v", key))),
+				strings.Join(items, " "),
+			})
 		default:
 			vals = append(vals, [2]string{
 				fmt.Sprintf("%s_%s", strings.ToUpper(prefix), strings.ToUpper(fmt.Sprintf("%v", key))),
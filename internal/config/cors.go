@@ -1,6 +1,10 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"net/http"
+
+	"github.com/spf13/viper"
+)
 
 // cheers up the unparam linter
 var _ defaulter = (*CorsConfig)(nil)
@@ -8,14 +12,22 @@ var _ defaulter = (*CorsConfig)(nil)
 // CorsConfig contains fields, which configure behaviour in the
 // HTTPServer relating to the CORS header-based mechanisms.
 type CorsConfig struct {
-	Enabled        bool     `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
-	AllowedOrigins []string `json:"allowedOrigins,omitempty" mapstructure:"allowed_origins" yaml:"allowed_origins,omitempty"`
+	Enabled          bool     `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	AllowedOrigins   []string `json:"allowedOrigins,omitempty" mapstructure:"allowed_origins" yaml:"allowed_origins,omitempty"`
+	AllowedMethods   []string `json:"allowedMethods,omitempty" mapstructure:"allowed_methods" yaml:"allowed_methods,omitempty"`
+	AllowedHeaders   []string `json:"allowedHeaders,omitempty" mapstructure:"allowed_headers" yaml:"allowed_headers,omitempty"`
+	AllowCredentials bool     `json:"allowCredentials" mapstructure:"allow_credentials" yaml:"allow_credentials"`
+	MaxAge           int      `json:"maxAge,omitempty" mapstructure:"max_age" yaml:"max_age,omitempty"`
 }
 
 func (c *CorsConfig) setDefaults(v *viper.Viper) error {
 	v.SetDefault("cors", map[string]any{
-		"enabled":         false,
-		"allowed_origins": "*",
+		"enabled":           false,
+		"allowed_origins":   "*",
+		"allowed_methods":   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
+		"allowed_headers":   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		"allow_credentials": true,
+		"max_age":           300,
 	})
 
 	return nil
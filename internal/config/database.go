@@ -40,6 +40,15 @@ type DatabaseConfig struct {
 	Port                      int              `json:"port,omitempty" mapstructure:"port,omitempty" yaml:"port,omitempty"`
 	Protocol                  DatabaseProtocol `json:"protocol,omitempty" mapstructure:"protocol,omitempty" yaml:"protocol,omitempty"`
 	PreparedStatementsEnabled bool             `json:"preparedStatementsEnabled,omitempty" mapstructure:"prepared_statements_enabled" yaml:"prepared_statements_enabled,omitempty"`
+
+	// AutoMigrate controls whether Flipt applies pending database schema
+	// migrations automatically on startup. It defaults to false: Flipt
+	// fails fast with an error on startup if migrations are pending,
+	// matching its historical behavior. Enable this only if your database
+	// administration policy allows the application to apply schema
+	// changes (DDL) itself; otherwise run `flipt migrate` (or check
+	// `flipt migrate status`) as part of your deployment process instead.
+	AutoMigrate bool `json:"autoMigrate,omitempty" mapstructure:"auto_migrate" yaml:"auto_migrate,omitempty"`
 }
 
 func (c *DatabaseConfig) setDefaults(v *viper.Viper) error {
@@ -65,6 +74,7 @@ func (c *DatabaseConfig) setDefaults(v *viper.Viper) error {
 	}
 
 	v.SetDefault("db.prepared_statements_enabled", true)
+	v.SetDefault("db.auto_migrate", false)
 	return nil
 }
 
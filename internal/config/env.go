@@ -0,0 +1,27 @@
+package config
+
+import (
+	"os"
+	"regexp"
+)
+
+// envVarPattern matches "${VAR}" and "${VAR:fallback}" references.
+var envVarPattern = regexp.MustCompile(`\$\{(\w+)(?::([^}]*))?\}`)
+
+// expandEnv interpolates "${VAR}" and "${VAR:fallback}" references in data
+// with values from the environment, so a single configuration file can be
+// reused across environments without a separate templating step. A
+// reference to an unset variable with no fallback is replaced with an
+// empty string, matching the behaviour of shell parameter expansion.
+func expandEnv(data []byte) []byte {
+	return envVarPattern.ReplaceAllFunc(data, func(match []byte) []byte {
+		groups := envVarPattern.FindSubmatch(match)
+		name, fallback := string(groups[1]), string(groups[2])
+
+		if value, ok := os.LookupEnv(name); ok {
+			return []byte(value)
+		}
+
+		return []byte(fallback)
+	})
+}
@@ -13,6 +13,8 @@ var (
 	errValidationRequired = errors.New("non-empty value is required")
 	// errPositiveNonZeroDuration is returned when a negative or zero time.Duration is provided.
 	errPositiveNonZeroDuration = errors.New("positive non-zero duration required")
+	// errPositiveNonZero is returned when a negative or zero numeric value is provided.
+	errPositiveNonZero = errors.New("positive non-zero value required")
 )
 
 func errFieldWrap(field string, err error) error {
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// cheers up the unparam linter
+var (
+	_ defaulter = (*EvaluationConfig)(nil)
+	_ validator = (*EvaluationConfig)(nil)
+)
+
+// EvaluationConfig contains fields, which enable and configure the
+// background job that keeps the evaluation_rules_view materialized table
+// refreshed, so the hot evaluation read path can be served from a single
+// indexed lookup instead of re-joining rules/segments/constraints/
+// distributions on every call.
+type EvaluationConfig struct {
+	Enabled         bool          `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	RefreshInterval time.Duration `json:"refreshInterval,omitempty" mapstructure:"refresh_interval" yaml:"refresh_interval,omitempty"`
+}
+
+func (c *EvaluationConfig) setDefaults(v *viper.Viper) error {
+	v.SetDefault("evaluation", map[string]any{
+		"enabled":          false,
+		"refresh_interval": 1 * time.Minute,
+	})
+
+	return nil
+}
+
+// IsZero returns true if the materialized evaluation view refresher is not
+// enabled. This is used for marshalling to YAML for `config init`.
+func (c EvaluationConfig) IsZero() bool {
+	return !c.Enabled
+}
+
+func (c *EvaluationConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.RefreshInterval <= 0 {
+		return errFieldWrap("evaluation.refresh_interval", errPositiveNonZero)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package config
+
+import "github.com/spf13/viper"
+
+// cheers up the unparam linter
+var (
+	_ defaulter = (*FlagsConfig)(nil)
+)
+
+// FlagsConfig contains fields which configure limits around flag resources,
+// such as the maximum size of a variant's attachment payload.
+type FlagsConfig struct {
+	// MaxVariantAttachmentSize is the maximum size, in bytes, of a variant's
+	// attachment payload. Attachments larger than this are rejected.
+	MaxVariantAttachmentSize int `json:"maxVariantAttachmentSize,omitempty" mapstructure:"max_variant_attachment_size" yaml:"max_variant_attachment_size,omitempty"`
+}
+
+func (c *FlagsConfig) setDefaults(v *viper.Viper) error {
+	v.SetDefault("flags", map[string]any{
+		"max_variant_attachment_size": 10000,
+	})
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package config
+
+import "github.com/spf13/viper"
+
+// cheers up the unparam linter
+var _ defaulter = (*HeadersConfig)(nil)
+
+// HeadersConfig contains fields, which configure standard security related
+// HTTP response headers, as well as arbitrary custom headers, applied to
+// both UI and API responses.
+type HeadersConfig struct {
+	Enabled                 bool              `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	ContentSecurityPolicy   string            `json:"contentSecurityPolicy,omitempty" mapstructure:"content_security_policy" yaml:"content_security_policy,omitempty"`
+	FrameOptions            string            `json:"frameOptions,omitempty" mapstructure:"frame_options" yaml:"frame_options,omitempty"`
+	StrictTransportSecurity string            `json:"strictTransportSecurity,omitempty" mapstructure:"strict_transport_security" yaml:"strict_transport_security,omitempty"`
+	Custom                  map[string]string `json:"custom,omitempty" mapstructure:"custom" yaml:"custom,omitempty"`
+}
+
+func (c *HeadersConfig) setDefaults(v *viper.Viper) error {
+	v.SetDefault("headers", map[string]any{
+		"enabled":                 true,
+		"content_security_policy": "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; img-src * data:; frame-ancestors 'none';",
+		"frame_options":           "DENY",
+	})
+
+	return nil
+}
+
+// IsZero returns true if the headers config has not been enabled and no
+// custom headers have been configured.
+func (c HeadersConfig) IsZero() bool {
+	return !c.Enabled && len(c.Custom) == 0
+}
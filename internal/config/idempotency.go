@@ -0,0 +1,48 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// cheers up the unparam linter
+var (
+	_ defaulter = (*IdempotencyConfig)(nil)
+	_ validator = (*IdempotencyConfig)(nil)
+)
+
+// IdempotencyConfig contains fields, which configure deduplication of
+// retried Create{Flag,Segment,Rule,Distribution} requests which carry a
+// matching Idempotency-Key header.
+type IdempotencyConfig struct {
+	Enabled bool          `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	TTL     time.Duration `json:"ttl,omitempty" mapstructure:"ttl" yaml:"ttl,omitempty"`
+}
+
+func (c *IdempotencyConfig) setDefaults(v *viper.Viper) error {
+	v.SetDefault("idempotency", map[string]any{
+		"enabled": false,
+		"ttl":     5 * time.Minute,
+	})
+
+	return nil
+}
+
+// IsZero returns true if idempotency key deduplication is not enabled.
+// This is used for marshalling to YAML for `config init`.
+func (c IdempotencyConfig) IsZero() bool {
+	return !c.Enabled
+}
+
+func (c *IdempotencyConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.TTL <= 0 {
+		return errFieldWrap("idempotency.ttl", errPositiveNonZero)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// cheers up the unparam linter
+var (
+	_ defaulter = (*LoadSheddingConfig)(nil)
+	_ validator = (*LoadSheddingConfig)(nil)
+)
+
+// LoadSheddingConfig contains fields, which enable and configure an
+// adaptive concurrency limit applied to evaluation RPCs. Once the server
+// is serving at its currently admitted concurrency, excess evaluation
+// requests are rejected immediately with a retryable status, protecting
+// the latency of the traffic that is served instead of queueing work the
+// server cannot keep up with.
+type LoadSheddingConfig struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	// MinLimit is the smallest concurrency the limiter will back off to.
+	MinLimit int `json:"minLimit,omitempty" mapstructure:"min_limit" yaml:"min_limit,omitempty"`
+	// MaxLimit is the largest concurrency the limiter will grow to.
+	MaxLimit int `json:"maxLimit,omitempty" mapstructure:"max_limit" yaml:"max_limit,omitempty"`
+	// TargetLatency is the per-request latency above which the limiter
+	// treats the server as overloaded and backs off.
+	TargetLatency time.Duration `json:"targetLatency,omitempty" mapstructure:"target_latency" yaml:"target_latency,omitempty"`
+}
+
+func (c *LoadSheddingConfig) setDefaults(v *viper.Viper) error {
+	v.SetDefault("load_shedding", map[string]any{
+		"enabled":        false,
+		"min_limit":      10,
+		"max_limit":      1000,
+		"target_latency": 500 * time.Millisecond,
+	})
+
+	return nil
+}
+
+// IsZero returns true if load shedding is not enabled.
+// This is used for marshalling to YAML for `config init`.
+func (c LoadSheddingConfig) IsZero() bool {
+	return !c.Enabled
+}
+
+func (c *LoadSheddingConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MinLimit <= 0 {
+		return errFieldWrap("load_shedding.min_limit", errPositiveNonZero)
+	}
+
+	if c.MaxLimit < c.MinLimit {
+		return fmt.Errorf("load_shedding.max_limit: must be greater than or equal to min_limit")
+	}
+
+	if c.TargetLatency <= 0 {
+		return errFieldWrap("load_shedding.target_latency", errPositiveNonZero)
+	}
+
+	return nil
+}
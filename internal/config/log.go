@@ -12,7 +12,7 @@ var _ defaulter = (*LogConfig)(nil)
 // LogConfig contains fields which control, direct and filter
 // the logging telemetry produces by Flipt.
 type LogConfig struct {
-	Level     string      `json:"level,omitempty" mapstructure:"level" yaml:"level,omitempty"`
+	Level     string      `json:"level,omitempty" mapstructure:"level" yaml:"level,omitempty" reload:"true"`
 	File      string      `json:"file,omitempty" mapstructure:"file" yaml:"file,omitempty"`
 	Encoding  LogEncoding `json:"encoding,omitempty" mapstructure:"encoding" yaml:"encoding,omitempty"`
 	GRPCLevel string      `json:"grpcLevel,omitempty" mapstructure:"grpc_level" yaml:"grpc_level,omitempty"`
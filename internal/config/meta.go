@@ -9,13 +9,22 @@ var _ defaulter = (*MetaConfig)(nil)
 type MetaConfig struct {
 	CheckForUpdates  bool   `json:"checkForUpdates" mapstructure:"check_for_updates" yaml:"check_for_updates"`
 	TelemetryEnabled bool   `json:"telemetryEnabled" mapstructure:"telemetry_enabled" yaml:"telemetry_enabled"`
-	StateDirectory   string `json:"stateDirectory,omitempty" mapstructure:"state_directory" yaml:"state_directory,omitempty"`
+	// TelemetryEndpoint overrides the default Flipt telemetry service endpoint.
+	// Only used when TelemetryEnabled is true.
+	TelemetryEndpoint string `json:"telemetryEndpoint,omitempty" mapstructure:"telemetry_endpoint" yaml:"telemetry_endpoint,omitempty"`
+	StateDirectory    string `json:"stateDirectory,omitempty" mapstructure:"state_directory" yaml:"state_directory,omitempty"`
+	// Environment labels this instance (e.g. "production", "staging") for
+	// operators running separate Flipt instances per environment today.
+	// It is surfaced through GetConfiguration and logging only; namespaces,
+	// storage, and tokens are not yet partitioned by it.
+	Environment string `json:"environment,omitempty" mapstructure:"environment" yaml:"environment,omitempty"`
 }
 
 func (c *MetaConfig) setDefaults(v *viper.Viper) error {
 	v.SetDefault("meta", map[string]any{
 		"check_for_updates": true,
 		"telemetry_enabled": true,
+		"environment":       "default",
 	})
 
 	return nil
@@ -0,0 +1,49 @@
+package config
+
+import "github.com/spf13/viper"
+
+// cheers up the unparam linter
+var (
+	_ defaulter = (*RateLimitingConfig)(nil)
+	_ validator = (*RateLimitingConfig)(nil)
+)
+
+// RateLimitingConfig contains fields, which configure request rate
+// limiting applied per authenticated token and per client IP address.
+type RateLimitingConfig struct {
+	Enabled           bool    `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty" mapstructure:"requests_per_second" yaml:"requests_per_second,omitempty"`
+	Burst             int     `json:"burst,omitempty" mapstructure:"burst" yaml:"burst,omitempty"`
+}
+
+func (c *RateLimitingConfig) setDefaults(v *viper.Viper) error {
+	v.SetDefault("rate_limiting", map[string]any{
+		"enabled":             false,
+		"requests_per_second": 1000,
+		"burst":               50,
+	})
+
+	return nil
+}
+
+// IsZero returns true if rate limiting is not enabled.
+// This is used for marshalling to YAML for `config init`.
+func (c RateLimitingConfig) IsZero() bool {
+	return !c.Enabled
+}
+
+func (c *RateLimitingConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.RequestsPerSecond <= 0 {
+		return errFieldWrap("rate_limiting.requests_per_second", errPositiveNonZero)
+	}
+
+	if c.Burst <= 0 {
+		return errFieldWrap("rate_limiting.burst", errPositiveNonZero)
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	vaultapi "github.com/hashicorp/vault/api"
+	"go.uber.org/zap"
+
+	"go.flipt.io/flipt/internal/vault"
+)
+
+// reloadTag marks a struct field as safe to apply at runtime when the
+// configuration is reloaded, without requiring the process to restart.
+// Only a small set of fields are currently marked this way; everything
+// else is reported as requiring a restart to take effect.
+const reloadTag = "reload"
+
+// DiffKeys compares two configurations field by field, returning the
+// dotted paths (matching the keys accepted by Flipt's configuration file
+// and environment variables) of every field that differs between them.
+// Differences are split into hot, which are safe to apply without
+// restarting Flipt, and restart, which require one.
+func DiffKeys(oldCfg, newCfg *Config) (hot, restart []string) {
+	diffFields(reflect.ValueOf(oldCfg).Elem(), reflect.ValueOf(newCfg).Elem(), nil, &hot, &restart)
+
+	sort.Strings(hot)
+	sort.Strings(restart)
+
+	return
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func diffFields(oldV, newV reflect.Value, path []string, hot, restart *[]string) {
+	typ := oldV.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		fieldPath := path
+		if key := fieldKey(field); key != "" {
+			fieldPath = append(append([]string{}, path...), key)
+		}
+
+		ov, nv := oldV.Field(i), newV.Field(i)
+
+		for ov.Kind() == reflect.Pointer {
+			if ov.IsNil() || nv.IsNil() {
+				if ov.IsNil() != nv.IsNil() {
+					record(fieldPath, field, hot, restart)
+				}
+				break
+			}
+
+			ov, nv = ov.Elem(), nv.Elem()
+		}
+
+		if ov.Kind() != reflect.Pointer {
+			if ov.Kind() == reflect.Struct && ov.Type() != durationType {
+				diffFields(ov, nv, fieldPath, hot, restart)
+				continue
+			}
+
+			if !reflect.DeepEqual(ov.Interface(), nv.Interface()) {
+				record(fieldPath, field, hot, restart)
+			}
+		}
+	}
+}
+
+func record(path []string, field reflect.StructField, hot, restart *[]string) {
+	key := strings.Join(path, ".")
+
+	if field.Tag.Get(reloadTag) == "true" {
+		*hot = append(*hot, key)
+		return
+	}
+
+	*restart = append(*restart, key)
+}
+
+// Watcher observes a Flipt configuration file for changes, reloading it
+// on SIGHUP or on a filesystem change and reporting which configuration
+// keys were affected.
+type Watcher struct {
+	logger *zap.Logger
+	path   string
+
+	mu  sync.RWMutex
+	cfg *Config
+
+	vaultMu     sync.Mutex
+	vaultCancel context.CancelFunc
+
+	// OnReload, when set, is invoked after every reload that produces a
+	// different configuration, with the dotted paths of fields that were
+	// applied live ("hot") and those which require a restart to take
+	// effect.
+	OnReload func(cfg *Config, hot, restart []string)
+}
+
+// NewWatcher constructs a Watcher for the configuration file at path,
+// seeded with the already loaded cfg.
+func NewWatcher(logger *zap.Logger, path string, cfg *Config) *Watcher {
+	return &Watcher{logger: logger, path: path, cfg: cfg}
+}
+
+// Config returns the most recently loaded configuration.
+func (w *Watcher) Config() *Config {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.cfg
+}
+
+// Run watches for SIGHUP and changes to the underlying configuration
+// file, reloading on either, until ctx is cancelled.
+func (w *Watcher) Run(ctx context.Context) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer fsw.Close()
+
+	// watch the containing directory rather than the file itself, since
+	// most editors and config management tools replace the file rather
+	// than writing to it in place.
+	if err := fsw.Add(filepath.Dir(w.path)); err != nil {
+		return err
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	// re-resolve once up-front so that any Vault-backed secrets in the
+	// already-loaded configuration get picked up and watched for lease
+	// expiry; this reports no changes unless the environment shifted
+	// between the initial load and here.
+	w.reload("watcher started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-hup:
+			w.reload("received SIGHUP")
+		case event, ok := <-fsw.Events:
+			if !ok {
+				return nil
+			}
+
+			if filepath.Clean(event.Name) != filepath.Clean(w.path) {
+				continue
+			}
+
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+
+			w.reload("configuration file changed")
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return nil
+			}
+
+			w.logger.Error("watching configuration file", zap.Error(err))
+		}
+	}
+}
+
+func (w *Watcher) reload(reason string) {
+	res, err := Load(w.path)
+	if err != nil {
+		w.logger.Error("reloading configuration", zap.String("reason", reason), zap.Error(err))
+		return
+	}
+
+	w.mu.Lock()
+	old := w.cfg
+	w.cfg = res.Config
+	w.mu.Unlock()
+
+	w.watchVaultSecrets(res.vaultSecrets)
+
+	hot, restart := DiffKeys(old, res.Config)
+	if len(hot) == 0 && len(restart) == 0 {
+		w.logger.Debug("configuration reloaded, no changes detected", zap.String("reason", reason))
+		return
+	}
+
+	w.logger.Info("configuration reloaded",
+		zap.String("reason", reason),
+		zap.Strings("applied", hot),
+		zap.Strings("requires_restart", restart),
+	)
+
+	if w.OnReload != nil {
+		w.OnReload(res.Config, hot, restart)
+	}
+}
+
+// watchVaultSecrets replaces any previously watched Vault secret leases
+// with secrets, so that a lease expiring triggers a reload which
+// re-resolves it.
+func (w *Watcher) watchVaultSecrets(secrets []*vaultapi.Secret) {
+	w.vaultMu.Lock()
+	defer w.vaultMu.Unlock()
+
+	if w.vaultCancel != nil {
+		w.vaultCancel()
+		w.vaultCancel = nil
+	}
+
+	if len(secrets) == 0 {
+		return
+	}
+
+	client, err := vault.New()
+	if err != nil {
+		w.logger.Error("configuring vault client to watch secret leases", zap.Error(err))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.vaultCancel = cancel
+
+	for _, secret := range secrets {
+		if err := client.Watch(ctx, secret, func() {
+			w.reload("vault secret lease expired")
+		}); err != nil {
+			w.logger.Error("watching vault secret lease", zap.Error(err))
+		}
+	}
+}
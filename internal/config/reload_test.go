@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffKeys(t *testing.T) {
+	oldCfg := Default()
+	newCfg := Default()
+
+	newCfg.Log.Level = "DEBUG"
+	newCfg.Cache.TTL = 5 * time.Minute
+	newCfg.Cors.Enabled = true
+
+	hot, restart := DiffKeys(oldCfg, newCfg)
+
+	assert.Equal(t, []string{"log.level"}, hot)
+	assert.Equal(t, []string{"cache.ttl", "cors.enabled"}, restart)
+}
+
+func TestDiffKeys_NoChanges(t *testing.T) {
+	cfg := Default()
+
+	hot, restart := DiffKeys(cfg, cfg)
+
+	assert.Empty(t, hot)
+	assert.Empty(t, restart)
+}
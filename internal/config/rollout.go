@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// RolloutConfig configures gradual rollout automation ("ramp schedules")
+// which progressively adjust a threshold rollout's percentage toward its
+// final value over a sequence of timed steps.
+type RolloutConfig struct {
+	// Plans defines the set of ramp schedules Flipt should execute.
+	Plans []RolloutPlanConfig `json:"plans,omitempty" mapstructure:"plans" yaml:"plans,omitempty"`
+}
+
+// RolloutPlanConfig identifies a single threshold rollout and the sequence
+// of steps Flipt should ramp it through.
+type RolloutPlanConfig struct {
+	// NamespaceKey is the namespace containing the target rollout.
+	NamespaceKey string `json:"namespaceKey,omitempty" mapstructure:"namespace_key" yaml:"namespace_key,omitempty"`
+	// FlagKey is the flag the target rollout belongs to.
+	FlagKey string `json:"flagKey,omitempty" mapstructure:"flag_key" yaml:"flag_key,omitempty"`
+	// RolloutId is the identifier of the threshold rollout to ramp.
+	RolloutId string `json:"rolloutId,omitempty" mapstructure:"rollout_id" yaml:"rollout_id,omitempty"`
+	// Steps are executed in order, each holding the rollout at Percentage
+	// for Duration before advancing to the next step.
+	Steps []RolloutStepConfig `json:"steps,omitempty" mapstructure:"steps" yaml:"steps,omitempty"`
+}
+
+// RolloutStepConfig is a single step of a ramp schedule.
+type RolloutStepConfig struct {
+	// Percentage is the threshold percentage to set the rollout to for
+	// this step.
+	Percentage float32 `json:"percentage" mapstructure:"percentage" yaml:"percentage"`
+	// Duration is how long this step holds before advancing to the next one.
+	Duration time.Duration `json:"duration,omitempty" mapstructure:"duration" yaml:"duration,omitempty"`
+}
+
+// IsZero returns true if no ramp schedules have been configured.
+// This is used for marshalling to YAML for `config init`.
+func (c RolloutConfig) IsZero() bool {
+	return len(c.Plans) == 0
+}
+
+func (c *RolloutConfig) validate() error {
+	for i, plan := range c.Plans {
+		if plan.FlagKey == "" {
+			return errFieldWrap(fmt.Sprintf("rollout.plans[%d].flag_key", i), errValidationRequired)
+		}
+
+		if plan.RolloutId == "" {
+			return errFieldWrap(fmt.Sprintf("rollout.plans[%d].rollout_id", i), errValidationRequired)
+		}
+
+		if len(plan.Steps) == 0 {
+			return errFieldWrap(fmt.Sprintf("rollout.plans[%d].steps", i), errValidationRequired)
+		}
+
+		last := float32(-1)
+		for j, step := range plan.Steps {
+			if step.Percentage < 0 || step.Percentage > 100 {
+				return errFieldWrap(fmt.Sprintf("rollout.plans[%d].steps[%d].percentage", i, j), errValidationRequired)
+			}
+
+			if step.Percentage <= last {
+				return fmt.Errorf("rollout.plans[%d].steps[%d].percentage: steps must ramp to a strictly higher percentage than the previous step", i, j)
+			}
+
+			last = step.Percentage
+
+			if step.Duration <= 0 {
+				return errFieldWrap(fmt.Sprintf("rollout.plans[%d].steps[%d].duration", i, j), errPositiveNonZero)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/spf13/viper"
+	"go.flipt.io/flipt/internal/awssecrets"
+	"go.flipt.io/flipt/internal/vault"
+)
+
+// resolveSecrets walks cfg looking for secret-bearing fields -- those
+// tagged json:"-", the convention this package already uses to keep
+// credentials out of the JSON config endpoint and diagnostics dump --
+// and resolves their values from external sources where configured:
+//
+//   - a "<key>_file" configuration key (or "FLIPT_..._FILE" environment
+//     variable, via viper's automatic env lookup) reads the value from
+//     a file, as is conventional for Docker/Kubernetes secrets.
+//   - a field already set to "vault:<path>#<key>" reads the value from
+//     HashiCorp Vault.
+//   - a field already set to "aws-sm:<secret-id>" or
+//     "aws-ssm:<parameter-name>" reads the value from AWS Secrets
+//     Manager or SSM Parameter Store respectively.
+//
+// Fields are resolved before validation runs, so validators see the
+// resolved values. Any Vault secrets resolved with a renewable lease
+// are returned so the caller can watch them for expiry.
+func resolveSecrets(v *viper.Viper, cfg *Config) ([]*vaultapi.Secret, error) {
+	var (
+		vc           *vault.Client
+		ac           *awssecrets.Client
+		vaultSecrets []*vaultapi.Secret
+	)
+
+	err := walkSecretFields(reflect.ValueOf(cfg).Elem(), nil, func(fv reflect.Value, key string) error {
+		if ref, ok := vault.ParseReference(fv.String()); ok {
+			if vc == nil {
+				var err error
+				if vc, err = vault.New(); err != nil {
+					return fmt.Errorf("configuring vault client for %s: %w", key, err)
+				}
+			}
+
+			value, secret, err := vc.Resolve(context.Background(), ref)
+			if err != nil {
+				return fmt.Errorf("resolving %s from vault: %w", key, err)
+			}
+
+			fv.SetString(value)
+			if secret.Renewable {
+				vaultSecrets = append(vaultSecrets, secret)
+			}
+
+			return nil
+		}
+
+		if awssecrets.HasReference(fv.String()) {
+			if ac == nil {
+				var err error
+				if ac, err = awssecrets.New(context.Background()); err != nil {
+					return fmt.Errorf("configuring aws secrets client for %s: %w", key, err)
+				}
+			}
+
+			value, err := ac.Resolve(context.Background(), fv.String())
+			if err != nil {
+				return fmt.Errorf("resolving %s from aws: %w", key, err)
+			}
+
+			fv.SetString(value)
+
+			return nil
+		}
+
+		filePath := v.GetString(key + "_file")
+		if filePath == "" {
+			return nil
+		}
+
+		contents, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("reading %s_file: %w", key, err)
+		}
+
+		fv.SetString(strings.TrimSpace(string(contents)))
+
+		return nil
+	})
+
+	return vaultSecrets, err
+}
+
+// walkSecretFields descends into val (a struct), invoking fn with the
+// reflect.Value and dotted config key of every string field tagged
+// json:"-".
+func walkSecretFields(val reflect.Value, path []string, fn func(fv reflect.Value, key string) error) error {
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		fieldPath := path
+		if key := fieldKey(field); key != "" {
+			fieldPath = append(append([]string{}, path...), key)
+		}
+
+		fv := val.Field(i)
+		for fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				break
+			}
+
+			fv = fv.Elem()
+		}
+
+		if fv.Kind() == reflect.Pointer {
+			// nil pointer, nothing underneath to resolve
+			continue
+		}
+
+		if fv.Kind() == reflect.Struct && fv.Type() != durationType {
+			if err := walkSecretFields(fv, fieldPath, fn); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if fv.Kind() != reflect.String || field.Tag.Get("json") != "-" {
+			continue
+		}
+
+		if err := fn(fv, strings.Join(fieldPath, ".")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
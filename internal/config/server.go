@@ -2,7 +2,10 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
+	"net"
 	"os"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -13,22 +16,72 @@ var _ defaulter = (*ServerConfig)(nil)
 // ServerConfig contains fields, which configure both HTTP and gRPC
 // API serving.
 type ServerConfig struct {
-	Host      string `json:"host,omitempty" mapstructure:"host" yaml:"host,omitempty"`
-	Protocol  Scheme `json:"protocol,omitempty" mapstructure:"protocol" yaml:"protocol,omitempty"`
-	HTTPPort  int    `json:"httpPort,omitempty" mapstructure:"http_port" yaml:"http_port,omitempty"`
-	HTTPSPort int    `json:"httpsPort,omitempty" mapstructure:"https_port" yaml:"https_port,omitempty"`
-	GRPCPort  int    `json:"grpcPort,omitempty" mapstructure:"grpc_port" yaml:"grpc_port,omitempty"`
-	CertFile  string `json:"-" mapstructure:"cert_file" yaml:"-"`
-	CertKey   string `json:"-" mapstructure:"cert_key" yaml:"-"`
+	Host               string          `json:"host,omitempty" mapstructure:"host" yaml:"host,omitempty"`
+	Protocol           Scheme          `json:"protocol,omitempty" mapstructure:"protocol" yaml:"protocol,omitempty"`
+	HTTPPort           int             `json:"httpPort,omitempty" mapstructure:"http_port" yaml:"http_port,omitempty"`
+	HTTPSPort          int             `json:"httpsPort,omitempty" mapstructure:"https_port" yaml:"https_port,omitempty"`
+	GRPCPort           int             `json:"grpcPort,omitempty" mapstructure:"grpc_port" yaml:"grpc_port,omitempty"`
+	CertFile           string          `json:"-" mapstructure:"cert_file" yaml:"-"`
+	CertKey            string          `json:"-" mapstructure:"cert_key" yaml:"-"`
+	Compression        CompressionType `json:"compression,omitempty" mapstructure:"compression" yaml:"compression,omitempty"`
+	ReadTimeout        time.Duration   `json:"readTimeout,omitempty" mapstructure:"read_timeout" yaml:"read_timeout,omitempty"`
+	WriteTimeout       time.Duration   `json:"writeTimeout,omitempty" mapstructure:"write_timeout" yaml:"write_timeout,omitempty"`
+	IdleTimeout        time.Duration   `json:"idleTimeout,omitempty" mapstructure:"idle_timeout" yaml:"idle_timeout,omitempty"`
+	MaxHeaderBytes     int             `json:"maxHeaderBytes,omitempty" mapstructure:"max_header_bytes" yaml:"max_header_bytes,omitempty"`
+	MaxRequestBodySize int64           `json:"maxRequestBodySize,omitempty" mapstructure:"max_request_body_size" yaml:"max_request_body_size,omitempty"`
+	// ShutdownGracePeriod bounds how long Flipt waits on shutdown for
+	// in-flight HTTP and gRPC requests to drain before forcibly closing
+	// their connections.
+	ShutdownGracePeriod time.Duration       `json:"shutdownGracePeriod,omitempty" mapstructure:"shutdown_grace_period" yaml:"shutdown_grace_period,omitempty"`
+	GRPCKeepalive       GRPCKeepaliveConfig `json:"grpcKeepalive,omitempty" mapstructure:"grpc_keepalive" yaml:"grpc_keepalive,omitempty"`
+	// TrustedProxies is a list of CIDRs or IPs which are trusted to set
+	// client IP resolution headers (True-Client-IP, X-Real-IP,
+	// X-Forwarded-For). Requests whose immediate peer address does not
+	// match one of these ranges have those headers ignored, and the
+	// connection's own address is used as the client IP instead.
+	TrustedProxies []string `json:"trustedProxies,omitempty" mapstructure:"trusted_proxies" yaml:"trusted_proxies,omitempty"`
+}
+
+// GRPCKeepaliveConfig configures the gRPC server's connection keepalive
+// enforcement, so long-lived client connections sitting idle behind a load
+// balancer are proactively pinged and, if unresponsive, closed rather than
+// silently dropped by the intermediary.
+type GRPCKeepaliveConfig struct {
+	// Time is how long the server waits after the last received activity
+	// on a connection before sending a keepalive ping.
+	Time time.Duration `json:"time,omitempty" mapstructure:"time" yaml:"time,omitempty"`
+	// Timeout is how long the server waits for a ping ack before considering
+	// the connection dead and closing it.
+	Timeout time.Duration `json:"timeout,omitempty" mapstructure:"timeout" yaml:"timeout,omitempty"`
+	// MaxConnectionAge is the maximum amount of time a connection may exist
+	// before the server gracefully closes it. Zero means no limit.
+	MaxConnectionAge time.Duration `json:"maxConnectionAge,omitempty" mapstructure:"max_connection_age" yaml:"max_connection_age,omitempty"`
+	// MaxConnectionAgeGrace bounds how long an already-aged-out connection is
+	// given to finish in-flight RPCs before being forcibly closed.
+	MaxConnectionAgeGrace time.Duration `json:"maxConnectionAgeGrace,omitempty" mapstructure:"max_connection_age_grace" yaml:"max_connection_age_grace,omitempty"`
+	// MaxConcurrentStreams limits the number of concurrent streams per
+	// client connection. Zero means no limit.
+	MaxConcurrentStreams uint32 `json:"maxConcurrentStreams,omitempty" mapstructure:"max_concurrent_streams" yaml:"max_concurrent_streams,omitempty"`
 }
 
 func (c *ServerConfig) setDefaults(v *viper.Viper) error {
 	v.SetDefault("server", map[string]any{
-		"host":       "0.0.0.0",
-		"protocol":   HTTP,
-		"http_port":  8080,
-		"https_port": 443,
-		"grpc_port":  9000,
+		"host":                  "0.0.0.0",
+		"protocol":              HTTP,
+		"http_port":             8080,
+		"https_port":            443,
+		"grpc_port":             9000,
+		"compression":           CompressionGzip,
+		"read_timeout":          10 * time.Second,
+		"write_timeout":         30 * time.Second,
+		"idle_timeout":          120 * time.Second,
+		"max_header_bytes":      1 << 20,
+		"max_request_body_size": 0,
+		"shutdown_grace_period": 5 * time.Second,
+		"grpc_keepalive": map[string]any{
+			"time":    2 * time.Hour,
+			"timeout": 20 * time.Second,
+		},
 	})
 
 	return nil
@@ -54,9 +107,75 @@ func (c *ServerConfig) validate() (err error) {
 		}
 	}
 
+	if c.ReadTimeout < 0 {
+		return errFieldWrap("server.read_timeout", errPositiveNonZero)
+	}
+
+	if c.WriteTimeout < 0 {
+		return errFieldWrap("server.write_timeout", errPositiveNonZero)
+	}
+
+	if c.IdleTimeout < 0 {
+		return errFieldWrap("server.idle_timeout", errPositiveNonZero)
+	}
+
+	if c.MaxHeaderBytes < 0 {
+		return errFieldWrap("server.max_header_bytes", errPositiveNonZero)
+	}
+
+	if c.MaxRequestBodySize < 0 {
+		return errFieldWrap("server.max_request_body_size", errPositiveNonZero)
+	}
+
+	if c.ShutdownGracePeriod <= 0 {
+		return errFieldWrap("server.shutdown_grace_period", errPositiveNonZero)
+	}
+
+	if c.GRPCKeepalive.Time < 0 {
+		return errFieldWrap("server.grpc_keepalive.time", errPositiveNonZero)
+	}
+
+	if c.GRPCKeepalive.Timeout < 0 {
+		return errFieldWrap("server.grpc_keepalive.timeout", errPositiveNonZero)
+	}
+
+	if c.GRPCKeepalive.MaxConnectionAge < 0 {
+		return errFieldWrap("server.grpc_keepalive.max_connection_age", errPositiveNonZero)
+	}
+
+	if c.GRPCKeepalive.MaxConnectionAgeGrace < 0 {
+		return errFieldWrap("server.grpc_keepalive.max_connection_age_grace", errPositiveNonZero)
+	}
+
+	for _, proxy := range c.TrustedProxies {
+		if _, err := ParseTrustedProxy(proxy); err != nil {
+			return errFieldWrap("server.trusted_proxies", fmt.Errorf("invalid entry %q: %w", proxy, err))
+		}
+	}
+
 	return
 }
 
+// ParseTrustedProxy parses a trusted_proxies entry, which may be either a
+// bare IP address or a CIDR range, into a *net.IPNet matching that range.
+func ParseTrustedProxy(s string) (*net.IPNet, error) {
+	if _, ipNet, err := net.ParseCIDR(s); err == nil {
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("not a valid IP address or CIDR")
+	}
+
+	bits := 32
+	if ip.To4() == nil {
+		bits = 128
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
 // Scheme is either HTTP or HTTPS.
 // TODO: can we use a string instead?
 type Scheme uint
@@ -89,3 +208,37 @@ var (
 		"https": HTTPS,
 	}
 )
+
+// CompressionType is the HTTP response compression codec Flipt applies.
+// gRPC compression is negotiated independently with the client and isn't
+// affected by this setting.
+type CompressionType uint8
+
+func (c CompressionType) String() string {
+	return compressionTypeToString[c]
+}
+
+func (c CompressionType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c CompressionType) MarshalYAML() (interface{}, error) {
+	return c.String(), nil
+}
+
+const (
+	CompressionNone CompressionType = iota
+	CompressionGzip
+)
+
+var (
+	compressionTypeToString = map[CompressionType]string{
+		CompressionNone: "none",
+		CompressionGzip: "gzip",
+	}
+
+	stringToCompressionType = map[string]CompressionType{
+		"none": CompressionNone,
+		"gzip": CompressionGzip,
+	}
+)
@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/spf13/viper"
@@ -33,15 +34,154 @@ type StorageConfig struct {
 	Git      *Git        `json:"git,omitempty" mapstructure:"git,omitempty" yaml:"git,omitempty"`
 	Object   *Object     `json:"object,omitempty" mapstructure:"object,omitempty" yaml:"object,omitempty"`
 	ReadOnly *bool       `json:"readOnly,omitempty" mapstructure:"readOnly,omitempty" yaml:"read_only,omitempty"`
+
+	// ReadOnlyNamespaces marks the listed namespaces as read-only, causing
+	// mutating management API requests against them to be rejected while the
+	// rest of the instance remains writable - e.g. freezing production
+	// during a change window without freezing staging.
+	ReadOnlyNamespaces []string `json:"readOnlyNamespaces,omitempty" mapstructure:"read_only_namespaces" yaml:"read_only_namespaces,omitempty"`
+
+	// DefaultNamespace overrides the key and display name of the namespace
+	// bootstrapped on a fresh database, for organizations with their own
+	// naming standards.
+	DefaultNamespace DefaultNamespaceConfig `json:"defaultNamespace,omitempty" mapstructure:"default_namespace" yaml:"default_namespace,omitempty"`
+
+	// SnapshotCache, when enabled, elects a single replica to build the
+	// declarative storage snapshot (git or object) and publish it to a
+	// shared cache, so every replica serves that published snapshot
+	// instead of independently polling the upstream source. Only
+	// applicable to git and object storage.
+	SnapshotCache SnapshotCacheConfig `json:"snapshotCache,omitempty" mapstructure:"snapshot_cache" yaml:"snapshot_cache,omitempty"`
+
+	// SnapshotErrorPolicy controls what happens when a declarative storage
+	// (git, object, or local) snapshot rebuild fails. Only applicable to
+	// those storage types.
+	SnapshotErrorPolicy SnapshotErrorPolicyConfig `json:"snapshotErrorPolicy,omitempty" mapstructure:"snapshot_error_policy" yaml:"snapshot_error_policy,omitempty"`
+}
+
+// SnapshotCacheBackend identifies the shared store a published snapshot is
+// distributed through.
+type SnapshotCacheBackend string
+
+const (
+	SnapshotCacheRedis = SnapshotCacheBackend("redis")
+)
+
+// SnapshotCacheConfig configures distributing a single, shared declarative
+// storage snapshot across replicas, rather than each replica independently
+// cloning/fetching the upstream source on every poll.
+type SnapshotCacheConfig struct {
+	Enabled bool                 `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	Backend SnapshotCacheBackend `json:"backend,omitempty" mapstructure:"backend" yaml:"backend,omitempty"`
+	Redis   RedisCacheConfig     `json:"redis,omitempty" mapstructure:"redis" yaml:"redis,omitempty"`
+
+	// Key namespaces the published snapshot within the shared backend,
+	// allowing multiple Flipt deployments to share one Redis instance.
+	Key string `json:"key,omitempty" mapstructure:"key" yaml:"key,omitempty"`
+}
+
+func (s SnapshotCacheConfig) validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	switch s.Backend {
+	case SnapshotCacheRedis:
+	default:
+		return fmt.Errorf("unknown snapshot cache backend: %q", s.Backend)
+	}
+
+	return nil
+}
+
+// SnapshotErrorMode identifies how Flipt should behave once a declarative
+// storage snapshot rebuild fails.
+type SnapshotErrorMode string
+
+const (
+	// SnapshotErrorModeFailOpen keeps serving the last known-good snapshot,
+	// optionally bounded by MaxStaleness. This is the default, preserving
+	// Flipt's historical behavior.
+	SnapshotErrorModeFailOpen = SnapshotErrorMode("fail_open")
+
+	// SnapshotErrorModeFailClosed stops serving as soon as a rebuild fails,
+	// so evaluation and management requests fail immediately instead of
+	// silently drifting from the source of truth.
+	SnapshotErrorModeFailClosed = SnapshotErrorMode("fail_closed")
+)
+
+// SnapshotErrorPolicyConfig controls what happens when a declarative
+// storage (git, object, or local) snapshot rebuild fails: whether Flipt
+// keeps serving the last known-good snapshot indefinitely, serves it only
+// for a bounded staleness window, or starts failing immediately.
+type SnapshotErrorPolicyConfig struct {
+	Mode SnapshotErrorMode `json:"mode,omitempty" mapstructure:"mode" yaml:"mode,omitempty"`
+
+	// MaxStaleness bounds how long the last known-good snapshot continues
+	// to be served after a failed rebuild while Mode is fail_open. Once a
+	// failure has persisted longer than MaxStaleness, Flipt behaves as
+	// though Mode were fail_closed until a rebuild succeeds again. Zero
+	// (the default) means unbounded: the last known-good snapshot is
+	// served indefinitely, regardless of how long rebuilds keep failing.
+	MaxStaleness time.Duration `json:"maxStaleness,omitempty" mapstructure:"max_staleness" yaml:"max_staleness,omitempty"`
+}
+
+func (s SnapshotErrorPolicyConfig) validate() error {
+	switch s.Mode {
+	case SnapshotErrorModeFailOpen, SnapshotErrorModeFailClosed:
+	default:
+		return fmt.Errorf("unknown snapshot error policy mode: %q", s.Mode)
+	}
+
+	if s.MaxStaleness < 0 {
+		return errors.New("storage.snapshot_error_policy.max_staleness must not be negative")
+	}
+
+	return nil
+}
+
+// DefaultNamespaceConfig overrides the key and name Flipt assigns to the
+// default namespace it seeds into a fresh database.
+type DefaultNamespaceConfig struct {
+	Key  string `json:"key,omitempty" mapstructure:"key" yaml:"key,omitempty"`
+	Name string `json:"name,omitempty" mapstructure:"name" yaml:"name,omitempty"`
+}
+
+// NamespaceReadOnly reports whether key has been marked read-only via
+// ReadOnlyNamespaces. An empty key refers to the default namespace.
+func (c StorageConfig) NamespaceReadOnly(key string) bool {
+	if key == "" {
+		key = "default"
+	}
+
+	for _, ns := range c.ReadOnlyNamespaces {
+		if ns == key {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (c *StorageConfig) setDefaults(v *viper.Viper) error {
+	v.SetDefault("storage.default_namespace.key", "default")
+	v.SetDefault("storage.default_namespace.name", "Default")
+	v.SetDefault("storage.snapshot_cache.backend", SnapshotCacheRedis)
+	v.SetDefault("storage.snapshot_cache.key", "flipt/snapshot")
+	v.SetDefault("storage.snapshot_cache.redis.host", "localhost")
+	v.SetDefault("storage.snapshot_cache.redis.port", 6379)
+	v.SetDefault("storage.snapshot_error_policy.mode", SnapshotErrorModeFailOpen)
+
 	switch v.GetString("storage.type") {
 	case string(LocalStorageType):
 		v.SetDefault("storage.local.path", ".")
 	case string(GitStorageType):
 		v.SetDefault("storage.git.ref", "main")
 		v.SetDefault("storage.git.poll_interval", "30s")
+		v.SetDefault("storage.git.write_back.commit_message_template", "chore: update Flipt state via API")
+		v.SetDefault("storage.git.write_back.author_name", "Flipt")
+		v.SetDefault("storage.git.write_back.author_email", "dev@flipt.io")
+		v.SetDefault("storage.git.write_back.pull_request.branch_template", "flipt/{{.Timestamp}}")
 	case string(ObjectStorageType):
 		// keep this as a case statement in anticipation of
 		// more object types in the future
@@ -60,7 +200,7 @@ func (c *StorageConfig) setDefaults(v *viper.Viper) error {
 func (c *StorageConfig) validate() error {
 	switch c.Type {
 	case GitStorageType:
-		if c.Git.Ref == "" {
+		if c.Git.Ref == "" && len(c.Git.Refs) == 0 {
 			return errors.New("git ref must be specified")
 		}
 		if c.Git.Repository == "" {
@@ -71,6 +211,14 @@ func (c *StorageConfig) validate() error {
 			return err
 		}
 
+		if err := c.Git.Webhook.validate(); err != nil {
+			return err
+		}
+
+		if err := c.Git.WriteBack.PullRequest.validate(); err != nil {
+			return err
+		}
+
 	case LocalStorageType:
 
 		if c.Local.Path == "" {
@@ -85,13 +233,31 @@ func (c *StorageConfig) validate() error {
 		if err := c.Object.validate(); err != nil {
 			return err
 		}
+
+		if err := c.Object.Webhook.validate(); err != nil {
+			return err
+		}
 	}
 
-	// setting read only mode is only supported with database storage
-	if c.ReadOnly != nil && !*c.ReadOnly && c.Type != DatabaseStorageType {
+	// setting read only mode is only supported with database storage, or git
+	// storage configured with write-back enabled.
+	gitWriteBack := c.Type == GitStorageType && c.Git.WriteBack.Enabled
+	if c.ReadOnly != nil && !*c.ReadOnly && c.Type != DatabaseStorageType && !gitWriteBack {
 		return errors.New("setting read only mode is only supported with database storage")
 	}
 
+	if err := c.SnapshotCache.validate(); err != nil {
+		return err
+	}
+
+	if c.SnapshotCache.Enabled && c.Type != GitStorageType && c.Type != ObjectStorageType {
+		return errors.New("snapshot cache is only supported with git or object storage")
+	}
+
+	if err := c.SnapshotErrorPolicy.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -102,16 +268,143 @@ type Local struct {
 
 // Git contains configuration for referencing a git repository.
 type Git struct {
-	Repository     string         `json:"repository,omitempty" mapstructure:"repository" yaml:"repository,omitempty"`
-	Ref            string         `json:"ref,omitempty" mapstructure:"ref" yaml:"ref,omitempty"`
-	PollInterval   time.Duration  `json:"pollInterval,omitempty" mapstructure:"poll_interval" yaml:"poll_interval,omitempty"`
-	Authentication Authentication `json:"-" mapstructure:"authentication,omitempty" yaml:"-"`
+	Repository     string            `json:"repository,omitempty" mapstructure:"repository" yaml:"repository,omitempty"`
+	Ref            string            `json:"ref,omitempty" mapstructure:"ref" yaml:"ref,omitempty"`
+	PollInterval   time.Duration     `json:"pollInterval,omitempty" mapstructure:"poll_interval" yaml:"poll_interval,omitempty"`
+	Authentication Authentication    `json:"-" mapstructure:"authentication,omitempty" yaml:"-"`
+	Webhook        Webhook           `json:"webhook,omitempty" mapstructure:"webhook,omitempty" yaml:"webhook,omitempty"`
+	Refs           map[string]string `json:"refs,omitempty" mapstructure:"refs,omitempty" yaml:"refs,omitempty"`
+
+	// CloneDepth limits how much history is fetched for the tracked ref(s).
+	// A value of zero (the default) performs a full clone.
+	CloneDepth uint32 `json:"cloneDepth,omitempty" mapstructure:"clone_depth" yaml:"clone_depth,omitempty"`
+
+	// SingleBranch restricts clones and fetches to only the tracked ref,
+	// instead of transferring every branch on the remote.
+	SingleBranch bool `json:"singleBranch,omitempty" mapstructure:"single_branch" yaml:"single_branch,omitempty"`
+
+	// CloneCachePath, when set, keeps the git clone on disk at this path so
+	// it can be reused across restarts, instead of re-cloning into memory
+	// every time Flipt starts up.
+	CloneCachePath string `json:"-" mapstructure:"clone_cache_path" yaml:"-"`
+
+	// WriteBack, when enabled, allows mutations made through the API to be
+	// committed back to the repository, turning git storage from read-only
+	// into a two-way GitOps workflow.
+	WriteBack WriteBack `json:"writeBack,omitempty" mapstructure:"write_back,omitempty" yaml:"write_back,omitempty"`
+}
+
+// WriteBack configures how Flipt commits API-driven mutations back to a
+// git storage backend.
+type WriteBack struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+
+	// Branch to commit (and push) changes to. Defaults to the tracked ref.
+	Branch string `json:"branch,omitempty" mapstructure:"branch" yaml:"branch,omitempty"`
+
+	// CommitMessageTemplate is a Go text/template string used to build the
+	// commit message for each write-back commit.
+	CommitMessageTemplate string `json:"commitMessageTemplate,omitempty" mapstructure:"commit_message_template" yaml:"commit_message_template,omitempty"`
+
+	AuthorName  string `json:"authorName,omitempty" mapstructure:"author_name" yaml:"author_name,omitempty"`
+	AuthorEmail string `json:"authorEmail,omitempty" mapstructure:"author_email" yaml:"author_email,omitempty"`
+
+	// PullRequest, when enabled, pushes write-back changes to a new branch
+	// and opens a pull (or merge) request against Branch, instead of
+	// committing directly to it.
+	PullRequest PullRequest `json:"pullRequest,omitempty" mapstructure:"pull_request,omitempty" yaml:"pull_request,omitempty"`
+}
+
+// PullRequest configures opening a pull request via a provider API on top
+// of a git write-back commit, so flag edits go through review instead of
+// landing directly on the tracked branch.
+type PullRequest struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+
+	Provider PullRequestProvider `json:"provider,omitempty" mapstructure:"provider" yaml:"provider,omitempty"`
+
+	// Token used to authenticate against the provider's API. This is
+	// distinct from the git Authentication used to push commits, as it may
+	// require broader (e.g. pull-request) scopes.
+	Token string `json:"-" mapstructure:"token" yaml:"-"`
+
+	// BranchTemplate is a Go text/template string used to name the branch
+	// pushed for each pull request.
+	BranchTemplate string `json:"branchTemplate,omitempty" mapstructure:"branch_template" yaml:"branch_template,omitempty"`
 }
 
+// PullRequestProvider identifies the API used to open a pull (or merge)
+// request once a write-back commit has been pushed to a new branch.
+type PullRequestProvider string
+
+const (
+	PullRequestProviderGithub = PullRequestProvider("github")
+	PullRequestProviderGitlab = PullRequestProvider("gitlab")
+)
+
 // Object contains configuration of readonly object storage.
 type Object struct {
-	Type ObjectSubStorageType `json:"type,omitempty" mapstructure:"type" yaml:"type,omitempty"`
-	S3   *S3                  `json:"s3,omitempty" mapstructure:"s3,omitempty" yaml:"s3,omitempty"`
+	Type    ObjectSubStorageType `json:"type,omitempty" mapstructure:"type" yaml:"type,omitempty"`
+	S3      *S3                  `json:"s3,omitempty" mapstructure:"s3,omitempty" yaml:"s3,omitempty"`
+	Webhook Webhook              `json:"webhook,omitempty" mapstructure:"webhook,omitempty" yaml:"webhook,omitempty"`
+}
+
+// WebhookProvider identifies the shape of signature verification a storage
+// webhook endpoint should apply to incoming refresh requests.
+type WebhookProvider string
+
+const (
+	// WebhookProviderNone performs no signature verification, relying solely
+	// on the shared secret being kept out of untrusted hands.
+	WebhookProviderNone   = WebhookProvider("")
+	WebhookProviderGithub = WebhookProvider("github")
+	WebhookProviderGitlab = WebhookProvider("gitlab")
+)
+
+// Webhook enables and configures the authenticated storage refresh endpoint
+// (`/internal/v1/storage/refresh`) for a declarative storage backend. Once
+// enabled, an incoming request forces an immediate re-sync of the
+// declarative snapshot instead of waiting on the configured poll interval.
+type Webhook struct {
+	Enabled  bool            `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	Provider WebhookProvider `json:"provider,omitempty" mapstructure:"provider" yaml:"provider,omitempty"`
+	Secret   string          `json:"-" mapstructure:"secret" yaml:"-"`
+}
+
+func (p PullRequest) validate() error {
+	if !p.Enabled {
+		return nil
+	}
+
+	switch p.Provider {
+	case PullRequestProviderGithub, PullRequestProviderGitlab:
+	default:
+		return fmt.Errorf("unknown pull request provider: %q", p.Provider)
+	}
+
+	if p.Token == "" {
+		return errors.New("pull request token must be specified")
+	}
+
+	return nil
+}
+
+func (w Webhook) validate() error {
+	if !w.Enabled {
+		return nil
+	}
+
+	switch w.Provider {
+	case WebhookProviderNone, WebhookProviderGithub, WebhookProviderGitlab:
+	default:
+		return fmt.Errorf("unknown storage webhook provider: %q", w.Provider)
+	}
+
+	if w.Secret == "" {
+		return errors.New("storage webhook secret must be specified")
+	}
+
+	return nil
 }
 
 // validate is only called if storage.type == "object"
@@ -121,6 +414,10 @@ func (o *Object) validate() error {
 		if o.S3 == nil || o.S3.Bucket == "" {
 			return errors.New("s3 bucket must be specified")
 		}
+
+		if err := o.S3.validate(); err != nil {
+			return err
+		}
 	default:
 		return errors.New("object storage type must be specified")
 	}
@@ -134,6 +431,49 @@ type S3 struct {
 	Prefix       string        `json:"prefix,omitempty" mapstructure:"prefix" yaml:"prefix,omitempty"`
 	Region       string        `json:"region,omitempty" mapstructure:"region" yaml:"region,omitempty"`
 	PollInterval time.Duration `json:"pollInterval,omitempty" mapstructure:"poll_interval" yaml:"poll_interval,omitempty"`
+
+	// RoleARN, if set, is assumed via STS before talking to S3, enabling
+	// cross-account bucket access. RoleSessionName and RoleExternalID are
+	// passed through to the AssumeRole call when set.
+	RoleARN         string `json:"roleArn,omitempty" mapstructure:"role_arn" yaml:"role_arn,omitempty"`
+	RoleSessionName string `json:"roleSessionName,omitempty" mapstructure:"role_session_name" yaml:"role_session_name,omitempty"`
+	RoleExternalID  string `json:"-" mapstructure:"role_external_id" yaml:"-"`
+
+	// WebIdentityTokenFile enables assuming RoleARN via a Kubernetes
+	// projected service account token (IRSA) instead of the default AWS SDK
+	// credential chain.
+	WebIdentityTokenFile string `json:"webIdentityTokenFile,omitempty" mapstructure:"web_identity_token_file" yaml:"web_identity_token_file,omitempty"`
+
+	// AccessKeyID and SecretAccessKey configure explicit static credentials.
+	// Both must be set together; SessionToken is optional and only valid
+	// alongside temporary credentials.
+	AccessKeyID     string `json:"-" mapstructure:"access_key_id" yaml:"-"`
+	SecretAccessKey string `json:"-" mapstructure:"secret_access_key" yaml:"-"`
+	SessionToken    string `json:"-" mapstructure:"session_token" yaml:"-"`
+
+	// CAPath is the path on disk to a PEM encoded certificate authority
+	// bundle to trust in addition to the system roots, for use with
+	// self-hosted or S3-compatible endpoints presenting a certificate
+	// signed by a private CA.
+	CAPath string `json:"caPath,omitempty" mapstructure:"ca_path" yaml:"ca_path,omitempty"`
+
+	// PathStyle forces path-style bucket addressing (e.g.
+	// https://endpoint/bucket/key) instead of the default virtual-hosted
+	// style (https://bucket.endpoint/key). This is required by most
+	// S3-compatible object stores such as MinIO.
+	PathStyle bool `json:"pathStyle,omitempty" mapstructure:"path_style" yaml:"path_style,omitempty"`
+}
+
+func (s S3) validate() error {
+	if (s.AccessKeyID == "") != (s.SecretAccessKey == "") {
+		return errors.New("s3 access_key_id and secret_access_key must be provided together")
+	}
+
+	if s.WebIdentityTokenFile != "" && s.RoleARN == "" {
+		return errors.New("s3 role_arn must be specified when web_identity_token_file is set")
+	}
+
+	return nil
 }
 
 // Authentication holds structures for various types of auth we support.
@@ -2,27 +2,75 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/spf13/viper"
 )
 
 // cheers up the unparam linter
 var _ defaulter = (*TracingConfig)(nil)
+var _ validator = (*TracingConfig)(nil)
 
 // TracingConfig contains fields, which configure tracing telemetry
 // output destinations.
 type TracingConfig struct {
 	Enabled  bool                `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
 	Exporter TracingExporter     `json:"exporter,omitempty" mapstructure:"exporter" yaml:"exporter,omitempty"`
+	Sampling SamplingConfig      `json:"sampling,omitempty" mapstructure:"sampling" yaml:"sampling,omitempty"`
 	Jaeger   JaegerTracingConfig `json:"jaeger,omitempty" mapstructure:"jaeger" yaml:"jaeger,omitempty"`
 	Zipkin   ZipkinTracingConfig `json:"zipkin,omitempty" mapstructure:"zipkin" yaml:"zipkin,omitempty"`
 	OTLP     OTLPTracingConfig   `json:"otlp,omitempty" mapstructure:"otlp" yaml:"otlp,omitempty"`
 }
 
+// SamplingConfig controls what proportion of traces are recorded and
+// exported, so tracing cost can be controlled independently of whether
+// it's enabled at all.
+type SamplingConfig struct {
+	// Type selects the sampling strategy: "always" records every trace,
+	// "ratio" records a fixed proportion of root traces, and "parentbased"
+	// honors the sampling decision of the incoming W3C traceparent (if any),
+	// falling back to "ratio" for traces with no parent.
+	Type SamplingType `json:"type,omitempty" mapstructure:"type" yaml:"type,omitempty"`
+	// Ratio is the proportion of root traces sampled, between 0 and 1.
+	// Only used when Type is "ratio" or "parentbased".
+	Ratio float64 `json:"ratio,omitempty" mapstructure:"ratio" yaml:"ratio,omitempty"`
+}
+
+func (c *TracingConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Sampling.Type {
+	case SamplingAlways:
+	case SamplingRatio, SamplingParentBased:
+		if c.Sampling.Ratio < 0 || c.Sampling.Ratio > 1 {
+			return errFieldWrap("tracing.sampling.ratio", fmt.Errorf("must be between 0 and 1"))
+		}
+	default:
+		return errFieldWrap("tracing.sampling.type", fmt.Errorf("unsupported sampling type: %q", c.Sampling.Type))
+	}
+
+	return nil
+}
+
+// SamplingType represents the supported trace sampling strategies.
+type SamplingType string
+
+const (
+	SamplingAlways      SamplingType = "always"
+	SamplingRatio       SamplingType = "ratio"
+	SamplingParentBased SamplingType = "parentbased"
+)
+
 func (c *TracingConfig) setDefaults(v *viper.Viper) error {
 	v.SetDefault("tracing", map[string]any{
 		"enabled":  false,
 		"exporter": TracingJaeger,
+		"sampling": map[string]any{
+			"type":  SamplingAlways,
+			"ratio": 1.0,
+		},
 		"jaeger": map[string]any{
 			"enabled": false, // deprecated (see below)
 			"host":    "localhost",
@@ -104,14 +152,28 @@ var (
 // JaegerTracingConfig contains fields, which configure
 // Jaeger span and tracing output destination.
 type JaegerTracingConfig struct {
+	// Host and Port configure the Jaeger agent (UDP) endpoint. This is the
+	// default mode, and is ignored once Endpoint is set.
 	Host string `json:"host,omitempty" mapstructure:"host" yaml:"host,omitempty"`
 	Port int    `json:"port,omitempty" mapstructure:"port" yaml:"port,omitempty"`
+	// Endpoint configures the Jaeger collector (HTTP) endpoint instead of the
+	// agent, e.g. "http://localhost:14268/api/traces". Username/Password, if
+	// set, are sent as HTTP basic auth.
+	Endpoint string `json:"endpoint,omitempty" mapstructure:"endpoint" yaml:"endpoint,omitempty"`
+	Username string `json:"username,omitempty" mapstructure:"username" yaml:"username,omitempty"`
+	Password string `json:"-" mapstructure:"password" yaml:"-"`
 }
 
 // ZipkinTracingConfig contains fields, which configure
 // Zipkin span and tracing output destination.
 type ZipkinTracingConfig struct {
 	Endpoint string `json:"endpoint,omitempty" mapstructure:"endpoint" yaml:"endpoint,omitempty"`
+	// Username and Password, if set, are sent as HTTP basic auth on every
+	// export request to Endpoint.
+	Username string `json:"username,omitempty" mapstructure:"username" yaml:"username,omitempty"`
+	Password string `json:"-" mapstructure:"password" yaml:"-"`
+	// Headers are sent as additional HTTP headers on every export request.
+	Headers map[string]string `json:"headers,omitempty" mapstructure:"headers" yaml:"headers,omitempty"`
 }
 
 // OTLPTracingConfig contains fields, which configure
@@ -1,6 +1,12 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/viper"
+)
 
 type UITheme string
 
@@ -11,18 +17,59 @@ const (
 )
 
 // cheers up the unparam linter
-var _ defaulter = (*UIConfig)(nil)
+var (
+	_ defaulter = (*UIConfig)(nil)
+	_ validator = (*UIConfig)(nil)
+)
 
 // UIConfig contains fields, which control the behaviour
 // of Flipt's user interface.
 type UIConfig struct {
 	DefaultTheme UITheme `json:"defaultTheme" mapstructure:"default_theme" yaml:"default_theme"`
+
+	// Enabled controls whether Flipt serves its embedded UI at all. When
+	// false, Flipt mounts only its API and no root handler, suiting a
+	// deployment that serves the UI from elsewhere (or doesn't serve it).
+	Enabled bool `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	// BasePath mounts the UI (and its asset references) under a path
+	// prefix, rather than at the root, for ingresses that route Flipt
+	// behind a path other than "/".
+	BasePath string `json:"basePath,omitempty" mapstructure:"base_path" yaml:"base_path,omitempty"`
+	// AssetsURL, when set, points the UI's script/stylesheet references at
+	// an externally hosted origin (e.g. a CDN) instead of Flipt's own
+	// embedded filesystem. Flipt still serves the HTML shell itself.
+	AssetsURL string `json:"assetsUrl,omitempty" mapstructure:"assets_url" yaml:"assets_url,omitempty"`
 }
 
 func (c *UIConfig) setDefaults(v *viper.Viper) error {
 	v.SetDefault("ui", map[string]any{
 		"default_theme": SystemUITheme,
+		"enabled":       true,
+		"base_path":     "/",
 	})
 
 	return nil
 }
+
+func (c *UIConfig) validate() error {
+	if !strings.HasPrefix(c.BasePath, "/") {
+		return fmt.Errorf("ui.base_path: must start with \"/\"")
+	}
+
+	if c.BasePath != "/" && strings.HasSuffix(c.BasePath, "/") {
+		return fmt.Errorf("ui.base_path: must not end with a trailing \"/\"")
+	}
+
+	if c.AssetsURL != "" {
+		u, err := url.Parse(c.AssetsURL)
+		if err != nil {
+			return errFieldWrap("ui.assets_url", err)
+		}
+
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("ui.assets_url: must be an absolute URL")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Package crypto provides application-level encryption at rest for
+// sensitive values before they are persisted to a storage backend.
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+)
+
+// Encryptor encrypts and decrypts opaque byte payloads.
+type Encryptor interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// AESGCM is an Encryptor backed by AES-GCM with a 256 bit key. Each call to
+// Encrypt generates a fresh random nonce, which is prepended to the
+// returned ciphertext so it can be recovered on Decrypt.
+type AESGCM struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCM constructs an AESGCM Encryptor from a 32 byte (256 bit) key.
+func NewAESGCM(key []byte) (*AESGCM, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("constructing aes cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("constructing gcm: %w", err)
+	}
+
+	return &AESGCM{aead: aead}, nil
+}
+
+// Encrypt seals plaintext, returning nonce||ciphertext.
+func (a *AESGCM) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, a.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+
+	return a.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt opens a payload previously produced by Encrypt.
+func (a *AESGCM) Decrypt(ciphertext []byte) ([]byte, error) {
+	nonceSize := a.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
+
+	plaintext, err := a.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting: %w", err)
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,43 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAESGCM_EncryptDecrypt(t *testing.T) {
+	key := make([]byte, 32)
+	enc, err := NewAESGCM(key)
+	require.NoError(t, err)
+
+	plaintext := []byte("super secret value")
+
+	ciphertext, err := enc.Encrypt(plaintext)
+	require.NoError(t, err)
+	assert.NotEqual(t, plaintext, ciphertext)
+
+	got, err := enc.Decrypt(ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, got)
+}
+
+func TestAESGCM_DecryptTampered(t *testing.T) {
+	key := make([]byte, 32)
+	enc, err := NewAESGCM(key)
+	require.NoError(t, err)
+
+	ciphertext, err := enc.Encrypt([]byte("super secret value"))
+	require.NoError(t, err)
+
+	ciphertext[len(ciphertext)-1] ^= 0xFF
+
+	_, err = enc.Decrypt(ciphertext)
+	assert.Error(t, err)
+}
+
+func TestNewAESGCM_InvalidKeySize(t *testing.T) {
+	_, err := NewAESGCM(make([]byte, 10))
+	assert.Error(t, err)
+}
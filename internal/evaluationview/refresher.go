@@ -0,0 +1,80 @@
+// Package evaluationview maintains the evaluation_rules_view materialized
+// table, a denormalized snapshot of every flag's evaluation rules kept in
+// sync by a background refresher so it can eventually serve the hot
+// evaluation read path from a single indexed lookup instead of re-joining
+// rules/segments/constraints/distributions on every call.
+package evaluationview
+
+import (
+	"context"
+	"time"
+
+	"go.flipt.io/flipt/internal/storage"
+	"go.uber.org/zap"
+)
+
+// Refresher periodically rebuilds the evaluation_rules_view table from the
+// authoritative rules/segments/constraints/distributions tables.
+type Refresher struct {
+	logger   *zap.Logger
+	store    storage.EvaluationViewRefresher
+	interval time.Duration
+
+	done   chan struct{}
+	cancel func()
+}
+
+// NewRefresher constructs a Refresher which rebuilds the view every
+// interval once Run is called.
+func NewRefresher(logger *zap.Logger, store storage.EvaluationViewRefresher, interval time.Duration) *Refresher {
+	return &Refresher{
+		logger:   logger,
+		store:    store,
+		interval: interval,
+		cancel:   func() {},
+		done:     make(chan struct{}),
+	}
+}
+
+// Run starts a background goroutine that rebuilds the view immediately,
+// then again every configured interval, until ctx is cancelled or Shutdown
+// is called. It returns immediately; the refresh loop runs in the
+// background.
+func (r *Refresher) Run(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		r.logger.Debug("starting evaluation rules view refresher")
+		if err := r.store.RefreshEvaluationRulesView(ctx); err != nil {
+			r.logger.Warn("refreshing evaluation rules view", zap.Error(err))
+		}
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.store.RefreshEvaluationRulesView(ctx); err != nil {
+					r.logger.Warn("refreshing evaluation rules view", zap.Error(err))
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown signals the refresh loop to stop and waits for it to finish.
+func (r *Refresher) Shutdown(ctx context.Context) error {
+	r.cancel()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-r.done:
+		return nil
+	}
+}
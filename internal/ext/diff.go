@@ -0,0 +1,224 @@
+package ext
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"go.flipt.io/flipt/rpc/flipt"
+	"gopkg.in/yaml.v2"
+)
+
+// DiffType identifies the kind of change a ResourceDiff represents.
+type DiffType string
+
+const (
+	DiffTypeCreate DiffType = "create"
+	DiffTypeUpdate DiffType = "update"
+	DiffTypeDelete DiffType = "delete"
+)
+
+// ResourceDiff describes a single create/update/delete change that
+// importing a document would make to a flag or segment in a namespace.
+type ResourceDiff struct {
+	Type      DiffType
+	Resource  string
+	Namespace string
+	Key       string
+}
+
+func (d ResourceDiff) String() string {
+	var sign string
+
+	switch d.Type {
+	case DiffTypeCreate:
+		sign = "+"
+	case DiffTypeUpdate:
+		sign = "~"
+	case DiffTypeDelete:
+		sign = "-"
+	}
+
+	return fmt.Sprintf("%s %s %s/%s", sign, d.Resource, d.Namespace, d.Key)
+}
+
+// Differ compares the flags and segments described in an import document
+// against the current state of their namespaces (read through a Lister),
+// without creating, updating or deleting anything. It backs
+// `flipt import --dry-run`, so an import can be reviewed before it's applied.
+type Differ struct {
+	store Lister
+}
+
+// NewDiffer constructs a Differ which reads current state from store.
+func NewDiffer(store Lister) *Differ {
+	return &Differ{store: store}
+}
+
+// Diff decodes the documents in r and returns the changes each one would
+// make to its namespace, were it imported.
+func (d *Differ) Diff(ctx context.Context, r io.Reader) ([]ResourceDiff, error) {
+	var diffs []ResourceDiff
+
+	dec := yaml.NewDecoder(r)
+
+	for {
+		doc := new(Document)
+		if err := dec.Decode(doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("unmarshalling document: %w", err)
+		}
+
+		flagDiffs, err := d.diffFlags(ctx, doc.Namespace, doc.Flags)
+		if err != nil {
+			return nil, err
+		}
+
+		diffs = append(diffs, flagDiffs...)
+
+		segmentDiffs, err := d.diffSegments(ctx, doc.Namespace, doc.Segments)
+		if err != nil {
+			return nil, err
+		}
+
+		diffs = append(diffs, segmentDiffs...)
+	}
+
+	return diffs, nil
+}
+
+func (d *Differ) diffFlags(ctx context.Context, namespace string, flags []*Flag) ([]ResourceDiff, error) {
+	current, err := d.currentFlags(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []ResourceDiff
+
+	seen := make(map[string]struct{}, len(flags))
+
+	for _, f := range flags {
+		if f == nil {
+			continue
+		}
+
+		seen[f.Key] = struct{}{}
+
+		existing, ok := current[f.Key]
+		if !ok {
+			diffs = append(diffs, ResourceDiff{Type: DiffTypeCreate, Resource: "flag", Namespace: namespace, Key: f.Key})
+		} else if !reflect.DeepEqual(existing, f) {
+			diffs = append(diffs, ResourceDiff{Type: DiffTypeUpdate, Resource: "flag", Namespace: namespace, Key: f.Key})
+		}
+	}
+
+	for key := range current {
+		if _, ok := seen[key]; !ok {
+			diffs = append(diffs, ResourceDiff{Type: DiffTypeDelete, Resource: "flag", Namespace: namespace, Key: key})
+		}
+	}
+
+	return diffs, nil
+}
+
+func (d *Differ) currentFlags(ctx context.Context, namespace string) (map[string]*Flag, error) {
+	current := make(map[string]*Flag)
+
+	var (
+		remaining = true
+		nextPage  string
+	)
+
+	for remaining {
+		resp, err := d.store.ListFlags(ctx, &flipt.ListFlagRequest{
+			NamespaceKey: namespace,
+			PageToken:    nextPage,
+			Limit:        defaultBatchSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getting flags: %w", err)
+		}
+
+		nextPage = resp.NextPageToken
+		remaining = nextPage != ""
+
+		for _, f := range resp.Flags {
+			flag, err := buildFlag(ctx, d.store, namespace, f)
+			if err != nil {
+				return nil, err
+			}
+
+			current[flag.Key] = flag
+		}
+	}
+
+	return current, nil
+}
+
+func (d *Differ) diffSegments(ctx context.Context, namespace string, segments []*Segment) ([]ResourceDiff, error) {
+	current, err := d.currentSegments(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []ResourceDiff
+
+	seen := make(map[string]struct{}, len(segments))
+
+	for _, s := range segments {
+		if s == nil {
+			continue
+		}
+
+		seen[s.Key] = struct{}{}
+
+		existing, ok := current[s.Key]
+		if !ok {
+			diffs = append(diffs, ResourceDiff{Type: DiffTypeCreate, Resource: "segment", Namespace: namespace, Key: s.Key})
+		} else if !reflect.DeepEqual(existing, s) {
+			diffs = append(diffs, ResourceDiff{Type: DiffTypeUpdate, Resource: "segment", Namespace: namespace, Key: s.Key})
+		}
+	}
+
+	for key := range current {
+		if _, ok := seen[key]; !ok {
+			diffs = append(diffs, ResourceDiff{Type: DiffTypeDelete, Resource: "segment", Namespace: namespace, Key: key})
+		}
+	}
+
+	return diffs, nil
+}
+
+func (d *Differ) currentSegments(ctx context.Context, namespace string) (map[string]*Segment, error) {
+	current := make(map[string]*Segment)
+
+	var (
+		remaining = true
+		nextPage  string
+	)
+
+	for remaining {
+		resp, err := d.store.ListSegments(ctx, &flipt.ListSegmentRequest{
+			NamespaceKey: namespace,
+			PageToken:    nextPage,
+			Limit:        defaultBatchSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getting segments: %w", err)
+		}
+
+		nextPage = resp.NextPageToken
+		remaining = nextPage != ""
+
+		for _, s := range resp.Segments {
+			segment := buildSegment(s)
+			current[segment.Key] = segment
+		}
+	}
+
+	return current, nil
+}
@@ -0,0 +1,91 @@
+package ext
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+func TestDiffer_Diff(t *testing.T) {
+	lister := mockLister{
+		nsToFlags: map[string][]*flipt.Flag{
+			"default": {
+				{
+					Key:         "flag1",
+					Name:        "flag1",
+					Type:        flipt.FlagType_BOOLEAN_FLAG_TYPE,
+					Description: "unchanged",
+					Enabled:     true,
+				},
+				{
+					Key:         "flag2",
+					Name:        "flag2",
+					Type:        flipt.FlagType_BOOLEAN_FLAG_TYPE,
+					Description: "will be updated",
+					Enabled:     false,
+				},
+				{
+					Key:         "flag3",
+					Name:        "flag3",
+					Type:        flipt.FlagType_BOOLEAN_FLAG_TYPE,
+					Description: "will be deleted",
+					Enabled:     true,
+				},
+			},
+		},
+		nsToSegments: map[string][]*flipt.Segment{
+			"default": {
+				{
+					Key:         "segment1",
+					Name:        "segment1",
+					Description: "unchanged",
+					MatchType:   flipt.MatchType_ANY_MATCH_TYPE,
+				},
+			},
+		},
+	}
+
+	doc := `namespace: default
+flags:
+  - key: flag1
+    name: flag1
+    type: BOOLEAN_FLAG_TYPE
+    description: unchanged
+    enabled: true
+  - key: flag2
+    name: flag2
+    type: BOOLEAN_FLAG_TYPE
+    description: will be updated
+    enabled: true
+  - key: flag4
+    name: flag4
+    type: BOOLEAN_FLAG_TYPE
+    description: will be created
+    enabled: false
+segments:
+  - key: segment1
+    name: segment1
+    description: unchanged
+    match_type: ANY_MATCH_TYPE
+`
+
+	diffs, err := NewDiffer(lister).Diff(context.Background(), strings.NewReader(doc))
+	require.NoError(t, err)
+
+	got := make([]string, len(diffs))
+	for i, d := range diffs {
+		got[i] = d.String()
+	}
+	sort.Strings(got)
+
+	assert.Equal(t, []string{
+		"+ flag default/flag4",
+		"- flag default/flag3",
+		"~ flag default/flag2",
+	}, got)
+}
@@ -33,88 +33,179 @@ type Lister interface {
 	ListRollouts(context.Context, *flipt.ListRolloutRequest) (*flipt.RolloutList, error)
 }
 
+// Encoding identifies the wire format an Exporter writes documents in.
+type Encoding uint8
+
+const (
+	EncodingYAML Encoding = iota
+	EncodingJSON
+)
+
 type Exporter struct {
 	store         Lister
 	batchSize     int32
 	namespaces    []string
 	allNamespaces bool
+	encoding      Encoding
+	flagKeys      map[string]struct{}
+}
+
+type ExportOpt func(*Exporter)
+
+// WithEncoding configures the format Export writes documents in. It
+// defaults to EncodingYAML.
+func WithEncoding(encoding Encoding) ExportOpt {
+	return func(e *Exporter) {
+		e.encoding = encoding
+	}
 }
 
-func NewExporter(store Lister, namespaces string, allNamespaces bool) *Exporter {
+// WithFlagKeys restricts the exported document to only the flags matching
+// one of the provided keys, so that partial state can be exported for
+// promotion between environments. Segments are always exported in full,
+// since filtered flags may still depend on them. An empty keys slice
+// leaves the exporter exporting every flag.
+func WithFlagKeys(keys []string) ExportOpt {
+	return func(e *Exporter) {
+		if len(keys) == 0 {
+			return
+		}
+
+		e.flagKeys = make(map[string]struct{}, len(keys))
+		for _, k := range keys {
+			if k == "" {
+				continue
+			}
+			e.flagKeys[k] = struct{}{}
+		}
+	}
+}
+
+func NewExporter(store Lister, namespaces string, allNamespaces bool, opts ...ExportOpt) *Exporter {
 	ns := strings.Split(namespaces, ",")
 
-	return &Exporter{
+	e := &Exporter{
 		store:         store,
 		batchSize:     defaultBatchSize,
 		namespaces:    ns,
 		allNamespaces: allNamespaces,
 	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// docEncoder is satisfied by both yaml.Encoder and our jsonEncoder wrapper,
+// allowing Export to encode a stream of documents regardless of format.
+type docEncoder interface {
+	Encode(v interface{}) error
+	Close() error
 }
 
+// jsonEncoder adapts json.Encoder to docEncoder. Successive calls to Encode
+// produce a stream of newline-delimited JSON documents.
+type jsonEncoder struct {
+	enc *json.Encoder
+}
+
+func (j jsonEncoder) Encode(v interface{}) error { return j.enc.Encode(v) }
+
+func (jsonEncoder) Close() error { return nil }
+
 // We currently only do minor bumps and print out just major.minor
 func versionString(v semver.Version) string {
 	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
 }
 
-func (e *Exporter) Export(ctx context.Context, w io.Writer) error {
+// Namespaces resolves the set of namespace keys this Exporter will export,
+// fetching the full list from the store when allNamespaces was requested.
+func (e *Exporter) Namespaces(ctx context.Context) ([]string, error) {
+	if !e.allNamespaces {
+		return e.namespaces, nil
+	}
+
 	var (
-		enc       = yaml.NewEncoder(w)
-		batchSize = e.batchSize
+		remaining = true
+		nextPage  string
 	)
 
-	defer enc.Close()
+	namespaces := make([]string, 0)
 
-	var namespaces = e.namespaces
+	for batch := int32(0); remaining; batch++ {
+		resp, err := e.store.ListNamespaces(ctx, &flipt.ListNamespaceRequest{
+			PageToken: nextPage,
+			Limit:     e.batchSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getting namespaces: %w", err)
+		}
 
-	// If allNamespaces is "true", then retrieve all the namespaces, and store them in a string slice.
-	if e.allNamespaces {
-		var (
-			remaining = true
-			nextPage  string
-		)
+		nextPage = resp.NextPageToken
+		remaining = nextPage != ""
 
-		intermediateNamespaces := make([]string, 0)
+		for _, ns := range resp.Namespaces {
+			namespaces = append(namespaces, ns.Key)
+		}
+	}
 
-		for batch := int32(0); remaining; batch++ {
-			resp, err := e.store.ListNamespaces(ctx, &flipt.ListNamespaceRequest{
-				PageToken: nextPage,
-				Limit:     batchSize,
-			})
-			if err != nil {
-				return fmt.Errorf("getting namespaces: %w", err)
-			}
+	return namespaces, nil
+}
 
-			nextPage := resp.NextPageToken
-			remaining = nextPage != ""
+// Export writes the namespaces selected by the Exporter as a stream of
+// documents, one per batch of flags or segments, so that a namespace's
+// worth of resources is never fully materialized in memory at once.
+func (e *Exporter) Export(ctx context.Context, w io.Writer) error {
+	var (
+		enc          docEncoder = yaml.NewEncoder(w)
+		batchSize               = e.batchSize
+		wroteVersion            = false
+	)
 
-			for _, ns := range resp.Namespaces {
-				intermediateNamespaces = append(intermediateNamespaces, ns.Key)
-			}
-		}
+	if e.encoding == EncodingJSON {
+		enc = jsonEncoder{enc: json.NewEncoder(w)}
+	}
+
+	defer enc.Close()
 
-		namespaces = intermediateNamespaces
+	namespaces, err := e.Namespaces(ctx)
+	if err != nil {
+		return err
 	}
 
-	for i := 0; i < len(namespaces); i++ {
-		doc := new(Document)
-		// Only provide the version to the first document in the YAML
-		// file.
-		if i == 0 {
+	// emit writes doc as its own document in the stream, stamping the
+	// version onto only the very first document actually written.
+	emit := func(doc *Document) error {
+		if !wroteVersion {
 			doc.Version = versionString(latestVersion)
+			wroteVersion = true
+		}
+
+		// The YAML encoder does the stream separation by default, so no
+		// need to write the "---" separator manually.
+		if err := enc.Encode(doc); err != nil {
+			return fmt.Errorf("marshaling document: %w", err)
 		}
-		doc.Namespace = namespaces[i]
 
+		return nil
+	}
+
+	for _, namespace := range namespaces {
 		var (
 			remaining = true
 			nextPage  string
 		)
 
-		// export flags/variants in batches
-		for batch := int32(0); remaining; batch++ {
+		// export flags/variants a batch at a time, so at most batchSize
+		// flags (with their nested variants/rules/rollouts) are held in
+		// memory, rather than the namespace's entire flag set.
+		for remaining {
 			resp, err := e.store.ListFlags(
 				ctx,
 				&flipt.ListFlagRequest{
-					NamespaceKey: namespaces[i],
+					NamespaceKey: namespace,
 					PageToken:    nextPage,
 					Limit:        batchSize,
 				},
@@ -123,134 +214,43 @@ func (e *Exporter) Export(ctx context.Context, w io.Writer) error {
 				return fmt.Errorf("getting flags: %w", err)
 			}
 
-			flags := resp.Flags
 			nextPage = resp.NextPageToken
 			remaining = nextPage != ""
 
-			for _, f := range flags {
-				flag := &Flag{
-					Key:         f.Key,
-					Name:        f.Name,
-					Type:        f.Type.String(),
-					Description: f.Description,
-					Enabled:     f.Enabled,
-				}
-
-				// map variant id => variant key
-				variantKeys := make(map[string]string)
-
-				for _, v := range f.Variants {
-					var attachment interface{}
-
-					if v.Attachment != "" {
-						if err := json.Unmarshal([]byte(v.Attachment), &attachment); err != nil {
-							return fmt.Errorf("unmarshaling variant attachment: %w", err)
-						}
-					}
-
-					flag.Variants = append(flag.Variants, &Variant{
-						Key:         v.Key,
-						Name:        v.Name,
-						Description: v.Description,
-						Attachment:  attachment,
-					})
-
-					variantKeys[v.Id] = v.Key
-				}
-
-				// export rules for flag
-				resp, err := e.store.ListRules(
-					ctx,
-					&flipt.ListRuleRequest{
-						NamespaceKey: namespaces[i],
-						FlagKey:      flag.Key,
-					},
-				)
-				if err != nil {
-					return fmt.Errorf("getting rules for flag %q: %w", flag.Key, err)
-				}
-
-				rules := resp.Rules
-				for _, r := range rules {
-					rule := &Rule{}
-
-					switch {
-					case r.SegmentKey != "":
-						rule.Segment = &SegmentEmbed{
-							IsSegment: SegmentKey(r.SegmentKey),
-						}
-					case len(r.SegmentKeys) > 0:
-						rule.Segment = &SegmentEmbed{
-							IsSegment: &Segments{
-								Keys:            r.SegmentKeys,
-								SegmentOperator: r.SegmentOperator.String(),
-							},
-						}
-					default:
-						return fmt.Errorf("wrong format for rule segments")
-					}
-
-					for _, d := range r.Distributions {
-						rule.Distributions = append(rule.Distributions, &Distribution{
-							VariantKey: variantKeys[d.VariantId],
-							Rollout:    d.Rollout,
-						})
+			var batchFlags []*Flag
+			for _, f := range resp.Flags {
+				if e.flagKeys != nil {
+					if _, ok := e.flagKeys[f.Key]; !ok {
+						continue
 					}
-
-					flag.Rules = append(flag.Rules, rule)
 				}
 
-				rollouts, err := e.store.ListRollouts(ctx, &flipt.ListRolloutRequest{
-					NamespaceKey: namespaces[i],
-					FlagKey:      flag.Key,
-				})
+				flag, err := buildFlag(ctx, e.store, namespace, f)
 				if err != nil {
-					return fmt.Errorf("getting rollout rules for flag %q: %w", flag.Key, err)
+					return err
 				}
 
-				for _, r := range rollouts.Rules {
-					rollout := Rollout{
-						Description: r.Description,
-					}
-
-					switch rule := r.Rule.(type) {
-					case *flipt.Rollout_Segment:
-						rollout.Segment = &SegmentRule{
-							Value: rule.Segment.Value,
-						}
-
-						if rule.Segment.SegmentKey != "" {
-							rollout.Segment.Key = rule.Segment.SegmentKey
-						} else if len(rule.Segment.SegmentKeys) > 0 {
-							rollout.Segment.Keys = rule.Segment.SegmentKeys
-						}
-
-						if rule.Segment.SegmentOperator == flipt.SegmentOperator_AND_SEGMENT_OPERATOR {
-							rollout.Segment.Operator = rule.Segment.SegmentOperator.String()
-						}
-					case *flipt.Rollout_Threshold:
-						rollout.Threshold = &ThresholdRule{
-							Percentage: rule.Threshold.Percentage,
-							Value:      rule.Threshold.Value,
-						}
-					}
+				batchFlags = append(batchFlags, flag)
+			}
 
-					flag.Rollouts = append(flag.Rollouts, &rollout)
-				}
+			if len(batchFlags) == 0 {
+				continue
+			}
 
-				doc.Flags = append(doc.Flags, flag)
+			if err := emit(&Document{Namespace: namespace, Flags: batchFlags}); err != nil {
+				return err
 			}
 		}
 
 		remaining = true
 		nextPage = ""
 
-		// export segments/constraints in batches
-		for batch := int32(0); remaining; batch++ {
+		// export segments/constraints a batch at a time.
+		for remaining {
 			resp, err := e.store.ListSegments(
 				ctx,
 				&flipt.ListSegmentRequest{
-					NamespaceKey: namespaces[i],
+					NamespaceKey: namespace,
 					PageToken:    nextPage,
 					Limit:        batchSize,
 				},
@@ -259,38 +259,160 @@ func (e *Exporter) Export(ctx context.Context, w io.Writer) error {
 				return fmt.Errorf("getting segments: %w", err)
 			}
 
-			segments := resp.Segments
 			nextPage = resp.NextPageToken
 			remaining = nextPage != ""
 
-			for _, s := range segments {
-				segment := &Segment{
-					Key:         s.Key,
-					Name:        s.Name,
-					Description: s.Description,
-					MatchType:   s.MatchType.String(),
-				}
+			var batchSegments []*Segment
+			for _, s := range resp.Segments {
+				batchSegments = append(batchSegments, buildSegment(s))
+			}
 
-				for _, c := range s.Constraints {
-					segment.Constraints = append(segment.Constraints, &Constraint{
-						Type:        c.Type.String(),
-						Property:    c.Property,
-						Operator:    c.Operator,
-						Value:       c.Value,
-						Description: c.Description,
-					})
-				}
+			if len(batchSegments) == 0 {
+				continue
+			}
 
-				doc.Segments = append(doc.Segments, segment)
+			if err := emit(&Document{Namespace: namespace, Segments: batchSegments}); err != nil {
+				return err
 			}
 		}
+	}
 
-		// The YAML encoder does the stream separation by default, so no need to write to the file the
-		// "---" separator manually.
-		if err := enc.Encode(doc); err != nil {
-			return fmt.Errorf("marshaling document: %w", err)
+	return nil
+}
+
+// buildFlag converts f, along with its rules and rollouts (fetched from
+// store), into the document representation used for both export and diff.
+func buildFlag(ctx context.Context, store Lister, namespace string, f *flipt.Flag) (*Flag, error) {
+	flag := &Flag{
+		Key:         f.Key,
+		Name:        f.Name,
+		Type:        f.Type.String(),
+		Description: f.Description,
+		Enabled:     f.Enabled,
+	}
+
+	// map variant id => variant key
+	variantKeys := make(map[string]string)
+
+	for _, v := range f.Variants {
+		var attachment interface{}
+
+		if v.Attachment != "" {
+			if err := json.Unmarshal([]byte(v.Attachment), &attachment); err != nil {
+				return nil, fmt.Errorf("unmarshaling variant attachment: %w", err)
+			}
 		}
+
+		flag.Variants = append(flag.Variants, &Variant{
+			Key:         v.Key,
+			Name:        v.Name,
+			Description: v.Description,
+			Attachment:  attachment,
+		})
+
+		variantKeys[v.Id] = v.Key
 	}
 
-	return nil
+	resp, err := store.ListRules(
+		ctx,
+		&flipt.ListRuleRequest{
+			NamespaceKey: namespace,
+			FlagKey:      flag.Key,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("getting rules for flag %q: %w", flag.Key, err)
+	}
+
+	for _, r := range resp.Rules {
+		rule := &Rule{}
+
+		switch {
+		case r.SegmentKey != "":
+			rule.Segment = &SegmentEmbed{
+				IsSegment: SegmentKey(r.SegmentKey),
+			}
+		case len(r.SegmentKeys) > 0:
+			rule.Segment = &SegmentEmbed{
+				IsSegment: &Segments{
+					Keys:            r.SegmentKeys,
+					SegmentOperator: r.SegmentOperator.String(),
+				},
+			}
+		default:
+			return nil, fmt.Errorf("wrong format for rule segments")
+		}
+
+		for _, d := range r.Distributions {
+			rule.Distributions = append(rule.Distributions, &Distribution{
+				VariantKey: variantKeys[d.VariantId],
+				Rollout:    d.Rollout,
+			})
+		}
+
+		flag.Rules = append(flag.Rules, rule)
+	}
+
+	rollouts, err := store.ListRollouts(ctx, &flipt.ListRolloutRequest{
+		NamespaceKey: namespace,
+		FlagKey:      flag.Key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting rollout rules for flag %q: %w", flag.Key, err)
+	}
+
+	for _, r := range rollouts.Rules {
+		rollout := Rollout{
+			Description: r.Description,
+		}
+
+		switch rule := r.Rule.(type) {
+		case *flipt.Rollout_Segment:
+			rollout.Segment = &SegmentRule{
+				Value: rule.Segment.Value,
+			}
+
+			if rule.Segment.SegmentKey != "" {
+				rollout.Segment.Key = rule.Segment.SegmentKey
+			} else if len(rule.Segment.SegmentKeys) > 0 {
+				rollout.Segment.Keys = rule.Segment.SegmentKeys
+			}
+
+			if rule.Segment.SegmentOperator == flipt.SegmentOperator_AND_SEGMENT_OPERATOR {
+				rollout.Segment.Operator = rule.Segment.SegmentOperator.String()
+			}
+		case *flipt.Rollout_Threshold:
+			rollout.Threshold = &ThresholdRule{
+				Percentage: rule.Threshold.Percentage,
+				Value:      rule.Threshold.Value,
+			}
+		}
+
+		flag.Rollouts = append(flag.Rollouts, &rollout)
+	}
+
+	return flag, nil
+}
+
+// buildSegment converts s, along with its constraints, into the document
+// representation used for both export and diff.
+func buildSegment(s *flipt.Segment) *Segment {
+	segment := &Segment{
+		Key:         s.Key,
+		Name:        s.Name,
+		Description: s.Description,
+		MatchType:   s.MatchType.String(),
+	}
+
+	for _, c := range s.Constraints {
+		segment.Constraints = append(segment.Constraints, &Constraint{
+			Type:        c.Type.String(),
+			Property:    c.Property,
+			Operator:    c.Operator,
+			Value:       c.Value,
+			Description: c.Description,
+		})
+	}
+
+	return segment
 }
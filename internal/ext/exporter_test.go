@@ -3,13 +3,39 @@ package ext
 import (
 	"bytes"
 	"context"
+	"errors"
+	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.flipt.io/flipt/rpc/flipt"
+	"gopkg.in/yaml.v2"
 )
 
+// decodeDocuments decodes every document in a (possibly multi-document)
+// YAML stream, so streamed exports can be compared document-by-document.
+func decodeDocuments(t *testing.T, s string) []*Document {
+	t.Helper()
+
+	var docs []*Document
+
+	dec := yaml.NewDecoder(strings.NewReader(s))
+	for {
+		doc := new(Document)
+		if err := dec.Decode(doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			t.Fatalf("decoding document: %v", err)
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs
+}
+
 type mockLister struct {
 	namespaces []*flipt.Namespace
 
@@ -72,6 +98,7 @@ func TestExport(t *testing.T) {
 		path          string
 		namespaces    string
 		allNamespaces bool
+		flagKeys      []string
 	}{
 		{
 			name: "single default namespace",
@@ -212,6 +239,146 @@ func TestExport(t *testing.T) {
 			namespaces:    "default",
 			allNamespaces: false,
 		},
+		{
+			name: "flag key filter",
+			lister: mockLister{
+				nsToFlags: map[string][]*flipt.Flag{
+					"default": {
+						{
+							Key:         "flag1",
+							Name:        "flag1",
+							Type:        flipt.FlagType_VARIANT_FLAG_TYPE,
+							Description: "description",
+							Enabled:     true,
+							Variants: []*flipt.Variant{
+								{
+									Id:   "1",
+									Key:  "variant1",
+									Name: "variant1",
+									Attachment: `{
+										"pi": 3.141,
+										"happy": true,
+										"name": "Niels",
+										"nothing": null,
+										"answer": {
+										  "everything": 42
+										},
+										"list": [1, 0, 2],
+										"object": {
+										  "currency": "USD",
+										  "value": 42.99
+										}
+									  }`,
+								},
+								{
+									Id:  "2",
+									Key: "foo",
+								},
+							},
+						},
+						{
+							Key:         "flag2",
+							Name:        "flag2",
+							Type:        flipt.FlagType_BOOLEAN_FLAG_TYPE,
+							Description: "a boolean flag",
+							Enabled:     false,
+						},
+					},
+				},
+				nsToSegments: map[string][]*flipt.Segment{
+					"default": {
+						{
+							Key:         "segment1",
+							Name:        "segment1",
+							Description: "description",
+							MatchType:   flipt.MatchType_ANY_MATCH_TYPE,
+							Constraints: []*flipt.Constraint{
+								{
+									Id:          "1",
+									Type:        flipt.ComparisonType_STRING_COMPARISON_TYPE,
+									Property:    "foo",
+									Operator:    "eq",
+									Value:       "baz",
+									Description: "desc",
+								},
+								{
+									Id:          "2",
+									Type:        flipt.ComparisonType_STRING_COMPARISON_TYPE,
+									Property:    "fizz",
+									Operator:    "neq",
+									Value:       "buzz",
+									Description: "desc",
+								},
+							},
+						},
+						{
+							Key:         "segment2",
+							Name:        "segment2",
+							Description: "description",
+							MatchType:   flipt.MatchType_ANY_MATCH_TYPE,
+						},
+					},
+				},
+				nsToRules: map[string][]*flipt.Rule{
+					"default": {
+						{
+							Id:         "1",
+							SegmentKey: "segment1",
+							Rank:       1,
+							Distributions: []*flipt.Distribution{
+								{
+									Id:        "1",
+									VariantId: "1",
+									RuleId:    "1",
+									Rollout:   100,
+								},
+							},
+						},
+						{
+							Id:              "2",
+							SegmentKeys:     []string{"segment1", "segment2"},
+							SegmentOperator: flipt.SegmentOperator_AND_SEGMENT_OPERATOR,
+							Rank:            2,
+						},
+					},
+				},
+
+				nsToRollouts: map[string][]*flipt.Rollout{
+					"default": {
+						{
+							Id:          "1",
+							FlagKey:     "flag2",
+							Type:        flipt.RolloutType_SEGMENT_ROLLOUT_TYPE,
+							Description: "enabled for internal users",
+							Rank:        int32(1),
+							Rule: &flipt.Rollout_Segment{
+								Segment: &flipt.RolloutSegment{
+									SegmentKey: "internal_users",
+									Value:      true,
+								},
+							},
+						},
+						{
+							Id:          "2",
+							FlagKey:     "flag2",
+							Type:        flipt.RolloutType_THRESHOLD_ROLLOUT_TYPE,
+							Description: "enabled for 50%",
+							Rank:        int32(2),
+							Rule: &flipt.Rollout_Threshold{
+								Threshold: &flipt.RolloutThreshold{
+									Percentage: float32(50.0),
+									Value:      true,
+								},
+							},
+						},
+					},
+				},
+			},
+			path:          "testdata/export_flag_key_filter.yml",
+			namespaces:    "default",
+			allNamespaces: false,
+			flagKeys:      []string{"flag2"},
+		},
 		{
 			name: "multiple namespaces",
 			lister: mockLister{
@@ -749,7 +916,7 @@ func TestExport(t *testing.T) {
 
 	for _, tc := range tests {
 		var (
-			exporter = NewExporter(tc.lister, tc.namespaces, tc.allNamespaces)
+			exporter = NewExporter(tc.lister, tc.namespaces, tc.allNamespaces, WithFlagKeys(tc.flagKeys))
 			b        = new(bytes.Buffer)
 		)
 
@@ -759,6 +926,6 @@ func TestExport(t *testing.T) {
 		in, err := os.ReadFile(tc.path)
 		assert.NoError(t, err)
 
-		assert.YAMLEq(t, string(in), b.String())
+		assert.Equal(t, decodeDocuments(t, string(in)), decodeDocuments(t, b.String()), tc.name)
 	}
 }
@@ -0,0 +1,489 @@
+// Package flagd exports Flipt's evaluation state in flagd's JSON flag
+// configuration schema (https://flagd.dev/reference/flag-definitions/), so
+// the same flag definitions can be consumed by flagd-only environments at
+// the edge.
+//
+// flagd's targeting model is JsonLogic evaluated against an evaluation
+// context, which only loosely maps onto Flipt's segment/distribution model.
+// This exporter supports the common cases: segment-matched rules/rollouts
+// (translated into JsonLogic comparisons over the segment's constraints)
+// and percentage-based distributions/rollouts (translated into flagd's
+// "fractional" custom operator). Constraints of type DATETIME are compared
+// as plain strings, since JsonLogic has no native date type. A boolean
+// flag's rollout rules are assumed to end in at most one percentage
+// (threshold) rule, since flagd has no equivalent of Flipt's
+// fallthrough-to-next-rule semantics for a threshold split; any threshold
+// rollout that isn't last is exported as if it were the final rule.
+package flagd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+const (
+	defaultBatchSize = 25
+
+	stateEnabled  = "ENABLED"
+	stateDisabled = "DISABLED"
+)
+
+// Document is the root of a flagd flag configuration file.
+type Document struct {
+	Schema string           `json:"$schema,omitempty"`
+	Flags  map[string]*Flag `json:"flags"`
+}
+
+// Flag is a single flagd flag definition.
+type Flag struct {
+	State          string                 `json:"state"`
+	DefaultVariant string                 `json:"defaultVariant"`
+	Variants       map[string]interface{} `json:"variants"`
+	Targeting      json.RawMessage        `json:"targeting,omitempty"`
+}
+
+// Exporter renders the flags of one or more namespaces as a single flagd
+// flag configuration document.
+type Exporter struct {
+	store         ext.Lister
+	batchSize     int32
+	namespaces    []string
+	allNamespaces bool
+}
+
+// NewExporter constructs an Exporter reading from store, either across the
+// comma-delimited namespaces or, when allNamespaces is true, across every
+// namespace the store has.
+func NewExporter(store ext.Lister, namespaces string, allNamespaces bool) *Exporter {
+	return &Exporter{
+		store:         store,
+		batchSize:     defaultBatchSize,
+		namespaces:    strings.Split(namespaces, ","),
+		allNamespaces: allNamespaces,
+	}
+}
+
+// Export writes the flagd flag configuration document for the Exporter's
+// namespaces to w. When more than one namespace is exported, flag keys
+// belonging to namespaces other than the default are prefixed with
+// "<namespace>." to avoid key collisions, since flagd has no namespace
+// concept of its own.
+func (e *Exporter) Export(ctx context.Context, w io.Writer) error {
+	namespaces, err := e.namespaceKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	doc := &Document{
+		Schema: "https://flagd.dev/schema/v0/flags.json",
+		Flags:  make(map[string]*Flag),
+	}
+
+	for _, namespace := range namespaces {
+		segments, err := e.listSegments(ctx, namespace)
+		if err != nil {
+			return fmt.Errorf("listing segments for namespace %q: %w", namespace, err)
+		}
+
+		flags, err := e.listFlags(ctx, namespace)
+		if err != nil {
+			return fmt.Errorf("listing flags for namespace %q: %w", namespace, err)
+		}
+
+		for _, f := range flags {
+			flagdFlag, err := e.buildFlag(ctx, namespace, f, segments)
+			if err != nil {
+				return fmt.Errorf("converting flag %q: %w", f.Key, err)
+			}
+
+			key := f.Key
+			if namespace != flipt.DefaultNamespace {
+				key = namespace + "." + f.Key
+			}
+
+			doc.Flags[key] = flagdFlag
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// namespaceKeys resolves the set of namespace keys to export, fetching the
+// full list from the store when allNamespaces was requested.
+func (e *Exporter) namespaceKeys(ctx context.Context) ([]string, error) {
+	if !e.allNamespaces {
+		return e.namespaces, nil
+	}
+
+	var (
+		namespaces []string
+		remaining  = true
+		nextPage   string
+	)
+
+	for remaining {
+		resp, err := e.store.ListNamespaces(ctx, &flipt.ListNamespaceRequest{
+			PageToken: nextPage,
+			Limit:     e.batchSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getting namespaces: %w", err)
+		}
+
+		nextPage = resp.NextPageToken
+		remaining = nextPage != ""
+
+		for _, ns := range resp.Namespaces {
+			namespaces = append(namespaces, ns.Key)
+		}
+	}
+
+	return namespaces, nil
+}
+
+func (e *Exporter) listFlags(ctx context.Context, namespace string) ([]*flipt.Flag, error) {
+	var (
+		flags     []*flipt.Flag
+		remaining = true
+		nextPage  string
+	)
+
+	for remaining {
+		resp, err := e.store.ListFlags(ctx, &flipt.ListFlagRequest{
+			NamespaceKey: namespace,
+			PageToken:    nextPage,
+			Limit:        e.batchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		nextPage = resp.NextPageToken
+		remaining = nextPage != ""
+		flags = append(flags, resp.Flags...)
+	}
+
+	return flags, nil
+}
+
+// listSegments fetches every segment in namespace, keyed by segment key, so
+// that rules/rollouts referencing a segment can be resolved into targeting
+// conditions.
+func (e *Exporter) listSegments(ctx context.Context, namespace string) (map[string]*flipt.Segment, error) {
+	segments := make(map[string]*flipt.Segment)
+
+	var (
+		remaining = true
+		nextPage  string
+	)
+
+	for remaining {
+		resp, err := e.store.ListSegments(ctx, &flipt.ListSegmentRequest{
+			NamespaceKey: namespace,
+			PageToken:    nextPage,
+			Limit:        e.batchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		nextPage = resp.NextPageToken
+		remaining = nextPage != ""
+
+		for _, s := range resp.Segments {
+			segments[s.Key] = s
+		}
+	}
+
+	return segments, nil
+}
+
+// buildFlag converts f, along with its rules/rollouts, into a flagd Flag.
+func (e *Exporter) buildFlag(ctx context.Context, namespace string, f *flipt.Flag, segments map[string]*flipt.Segment) (*Flag, error) {
+	state := stateDisabled
+	if f.Enabled {
+		state = stateEnabled
+	}
+
+	if f.Type == flipt.FlagType_BOOLEAN_FLAG_TYPE {
+		return e.buildBooleanFlag(ctx, namespace, f, state, segments)
+	}
+
+	return e.buildVariantFlag(ctx, namespace, f, state, segments)
+}
+
+func (e *Exporter) buildBooleanFlag(ctx context.Context, namespace string, f *flipt.Flag, state string, segments map[string]*flipt.Segment) (*Flag, error) {
+	flag := &Flag{
+		State:          state,
+		DefaultVariant: strconv.FormatBool(f.Enabled),
+		Variants: map[string]interface{}{
+			"true":  true,
+			"false": false,
+		},
+	}
+
+	resp, err := e.store.ListRollouts(ctx, &flipt.ListRolloutRequest{
+		NamespaceKey: namespace,
+		FlagKey:      f.Key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting rollouts: %w", err)
+	}
+
+	targeting, err := buildRolloutTargeting(resp.Rules, segments)
+	if err != nil {
+		return nil, err
+	}
+
+	flag.Targeting = targeting
+
+	return flag, nil
+}
+
+func (e *Exporter) buildVariantFlag(ctx context.Context, namespace string, f *flipt.Flag, state string, segments map[string]*flipt.Segment) (*Flag, error) {
+	flag := &Flag{
+		State:    state,
+		Variants: make(map[string]interface{}),
+	}
+
+	variantKeys := make(map[string]string, len(f.Variants))
+
+	for i, v := range f.Variants {
+		if i == 0 {
+			flag.DefaultVariant = v.Key
+		}
+
+		var attachment interface{} = v.Key
+
+		if v.Attachment != "" {
+			if err := json.Unmarshal([]byte(v.Attachment), &attachment); err != nil {
+				return nil, fmt.Errorf("unmarshaling variant attachment: %w", err)
+			}
+		}
+
+		flag.Variants[v.Key] = attachment
+		variantKeys[v.Id] = v.Key
+	}
+
+	resp, err := e.store.ListRules(ctx, &flipt.ListRuleRequest{
+		NamespaceKey: namespace,
+		FlagKey:      f.Key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting rules: %w", err)
+	}
+
+	targeting, err := buildRuleTargeting(resp.Rules, segments, variantKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	flag.Targeting = targeting
+
+	return flag, nil
+}
+
+// buildRuleTargeting converts a variant flag's (segment, distributions)
+// rules into a chain of JsonLogic "if" expressions, evaluated in the same
+// priority order the rules are returned in, falling through to the flag's
+// defaultVariant when no rule matches.
+func buildRuleTargeting(rules []*flipt.Rule, segments map[string]*flipt.Segment, variantKeys map[string]string) (json.RawMessage, error) {
+	var expr interface{}
+
+	for i := len(rules) - 1; i >= 0; i-- {
+		r := rules[i]
+
+		cond, err := buildSegmentCondition(r.SegmentKey, r.SegmentKeys, r.SegmentOperator, segments)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Id, err)
+		}
+
+		outcome := buildDistributionOutcome(r.Distributions, variantKeys)
+
+		expr = map[string]interface{}{"if": []interface{}{cond, outcome, expr}}
+	}
+
+	if expr == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(expr)
+}
+
+// buildRolloutTargeting is the boolean-flag equivalent of buildRuleTargeting,
+// converting Flipt's ordered segment/threshold rollout rules into a chain of
+// JsonLogic "if" expressions.
+func buildRolloutTargeting(rules []*flipt.Rollout, segments map[string]*flipt.Segment) (json.RawMessage, error) {
+	var expr interface{}
+
+	for i := len(rules) - 1; i >= 0; i-- {
+		r := rules[i]
+
+		var outcome interface{}
+
+		switch rule := r.Rule.(type) {
+		case *flipt.Rollout_Segment:
+			cond, err := buildSegmentCondition(rule.Segment.SegmentKey, rule.Segment.SegmentKeys, rule.Segment.SegmentOperator, segments)
+			if err != nil {
+				return nil, fmt.Errorf("rollout %q: %w", r.Id, err)
+			}
+
+			expr = map[string]interface{}{"if": []interface{}{cond, strconv.FormatBool(rule.Segment.Value), expr}}
+			continue
+		case *flipt.Rollout_Threshold:
+			outcome = map[string]interface{}{
+				"fractional": []interface{}{
+					[]interface{}{strconv.FormatBool(rule.Threshold.Value), rule.Threshold.Percentage},
+					[]interface{}{strconv.FormatBool(!rule.Threshold.Value), 100 - rule.Threshold.Percentage},
+				},
+			}
+		default:
+			continue
+		}
+
+		expr = outcome
+	}
+
+	if expr == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(expr)
+}
+
+// buildDistributionOutcome converts a rule's variant distributions into the
+// targeting value returned when the rule's segment matches: the variant key
+// directly when there's a single, fully-rolled-out distribution, otherwise
+// flagd's "fractional" operator split across the distributions' variants.
+func buildDistributionOutcome(distributions []*flipt.Distribution, variantKeys map[string]string) interface{} {
+	if len(distributions) == 1 && distributions[0].Rollout >= 100 {
+		return variantKeys[distributions[0].VariantId]
+	}
+
+	buckets := make([]interface{}, 0, len(distributions))
+	for _, d := range distributions {
+		buckets = append(buckets, []interface{}{variantKeys[d.VariantId], d.Rollout})
+	}
+
+	return map[string]interface{}{"fractional": buckets}
+}
+
+// buildSegmentCondition converts a rule/rollout's referenced segment(s) into
+// a JsonLogic condition evaluated against the evaluation context, combining
+// multiple segments with operator (AND/OR) the way Flipt does.
+func buildSegmentCondition(segmentKey string, segmentKeys []string, operator flipt.SegmentOperator, segments map[string]*flipt.Segment) (interface{}, error) {
+	keys := segmentKeys
+	if segmentKey != "" {
+		keys = []string{segmentKey}
+	}
+
+	conditions := make([]interface{}, 0, len(keys))
+
+	for _, key := range keys {
+		segment, ok := segments[key]
+		if !ok {
+			return nil, fmt.Errorf("segment %q not found", key)
+		}
+
+		conditions = append(conditions, buildConstraintsCondition(segment))
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+
+	op := "or"
+	if operator == flipt.SegmentOperator_AND_SEGMENT_OPERATOR {
+		op = "and"
+	}
+
+	return map[string]interface{}{op: conditions}, nil
+}
+
+// buildConstraintsCondition combines segment's constraints using its match
+// type: every constraint must hold for MatchType_ALL, any one for
+// MatchType_ANY.
+func buildConstraintsCondition(segment *flipt.Segment) interface{} {
+	conditions := make([]interface{}, 0, len(segment.Constraints))
+	for _, c := range segment.Constraints {
+		conditions = append(conditions, buildConstraintCondition(c))
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+
+	op := "and"
+	if segment.MatchType == flipt.MatchType_ANY_MATCH_TYPE {
+		op = "or"
+	}
+
+	return map[string]interface{}{op: conditions}
+}
+
+// buildConstraintCondition converts a single segment constraint into a
+// JsonLogic condition over the constraint's property, using flagd's
+// starts_with/ends_with custom operators for prefix/suffix matching.
+func buildConstraintCondition(c *flipt.Constraint) interface{} {
+	v := map[string]interface{}{"var": c.Property}
+
+	switch c.Operator {
+	case flipt.OpEQ:
+		return map[string]interface{}{"==": []interface{}{v, constraintValue(c)}}
+	case flipt.OpNEQ:
+		return map[string]interface{}{"!=": []interface{}{v, constraintValue(c)}}
+	case flipt.OpLT:
+		return map[string]interface{}{"<": []interface{}{v, constraintValue(c)}}
+	case flipt.OpLTE:
+		return map[string]interface{}{"<=": []interface{}{v, constraintValue(c)}}
+	case flipt.OpGT:
+		return map[string]interface{}{">": []interface{}{v, constraintValue(c)}}
+	case flipt.OpGTE:
+		return map[string]interface{}{">=": []interface{}{v, constraintValue(c)}}
+	case flipt.OpEmpty:
+		return map[string]interface{}{"==": []interface{}{v, ""}}
+	case flipt.OpNotEmpty:
+		return map[string]interface{}{"!=": []interface{}{v, ""}}
+	case flipt.OpTrue:
+		return map[string]interface{}{"==": []interface{}{v, true}}
+	case flipt.OpFalse:
+		return map[string]interface{}{"==": []interface{}{v, false}}
+	case flipt.OpPresent:
+		return map[string]interface{}{"!==": []interface{}{v, nil}}
+	case flipt.OpNotPresent:
+		return map[string]interface{}{"===": []interface{}{v, nil}}
+	case flipt.OpPrefix:
+		return map[string]interface{}{"starts_with": []interface{}{v, c.Value}}
+	case flipt.OpSuffix:
+		return map[string]interface{}{"ends_with": []interface{}{v, c.Value}}
+	default:
+		return map[string]interface{}{"==": []interface{}{v, constraintValue(c)}}
+	}
+}
+
+// constraintValue coerces a constraint's string value to the Go type that
+// matches its declared comparison type, so the resulting JsonLogic
+// comparison is evaluated numerically/boolean rather than as a string.
+func constraintValue(c *flipt.Constraint) interface{} {
+	switch c.Type {
+	case flipt.ComparisonType_NUMBER_COMPARISON_TYPE:
+		if f, err := strconv.ParseFloat(c.Value, 64); err == nil {
+			return f
+		}
+	case flipt.ComparisonType_BOOLEAN_COMPARISON_TYPE:
+		if b, err := strconv.ParseBool(c.Value); err == nil {
+			return b
+		}
+	}
+
+	return c.Value
+}
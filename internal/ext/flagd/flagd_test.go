@@ -0,0 +1,111 @@
+package flagd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+type mockLister struct {
+	flags    []*flipt.Flag
+	segments []*flipt.Segment
+	rules    []*flipt.Rule
+	rollouts []*flipt.Rollout
+}
+
+func (m mockLister) ListNamespaces(context.Context, *flipt.ListNamespaceRequest) (*flipt.NamespaceList, error) {
+	return &flipt.NamespaceList{Namespaces: []*flipt.Namespace{{Key: flipt.DefaultNamespace}}}, nil
+}
+
+func (m mockLister) ListFlags(context.Context, *flipt.ListFlagRequest) (*flipt.FlagList, error) {
+	return &flipt.FlagList{Flags: m.flags}, nil
+}
+
+func (m mockLister) ListSegments(context.Context, *flipt.ListSegmentRequest) (*flipt.SegmentList, error) {
+	return &flipt.SegmentList{Segments: m.segments}, nil
+}
+
+func (m mockLister) ListRules(_ context.Context, req *flipt.ListRuleRequest) (*flipt.RuleList, error) {
+	if req.FlagKey != "variantFlag" {
+		return &flipt.RuleList{}, nil
+	}
+	return &flipt.RuleList{Rules: m.rules}, nil
+}
+
+func (m mockLister) ListRollouts(_ context.Context, req *flipt.ListRolloutRequest) (*flipt.RolloutList, error) {
+	if req.FlagKey != "boolFlag" {
+		return &flipt.RolloutList{}, nil
+	}
+	return &flipt.RolloutList{Rules: m.rollouts}, nil
+}
+
+func TestExport(t *testing.T) {
+	lister := mockLister{
+		flags: []*flipt.Flag{
+			{
+				Key:     "variantFlag",
+				Type:    flipt.FlagType_VARIANT_FLAG_TYPE,
+				Enabled: true,
+				Variants: []*flipt.Variant{
+					{Id: "1", Key: "variantA"},
+					{Id: "2", Key: "variantB"},
+				},
+			},
+			{
+				Key:     "boolFlag",
+				Type:    flipt.FlagType_BOOLEAN_FLAG_TYPE,
+				Enabled: true,
+			},
+		},
+		segments: []*flipt.Segment{
+			{
+				Key:       "segment1",
+				MatchType: flipt.MatchType_ALL_MATCH_TYPE,
+				Constraints: []*flipt.Constraint{
+					{Property: "tier", Operator: flipt.OpEQ, Value: "gold"},
+				},
+			},
+		},
+		rules: []*flipt.Rule{
+			{
+				Id:         "rule1",
+				SegmentKey: "segment1",
+				Distributions: []*flipt.Distribution{
+					{VariantId: "1", Rollout: 100},
+				},
+			},
+		},
+		rollouts: []*flipt.Rollout{
+			{
+				Id: "rollout1",
+				Rule: &flipt.Rollout_Threshold{
+					Threshold: &flipt.RolloutThreshold{Percentage: 50, Value: true},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, NewExporter(lister, flipt.DefaultNamespace, false).Export(context.Background(), &buf))
+
+	var doc Document
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	require.Contains(t, doc.Flags, "variantFlag")
+	variantFlag := doc.Flags["variantFlag"]
+	assert.Equal(t, stateEnabled, variantFlag.State)
+	assert.Equal(t, "variantA", variantFlag.DefaultVariant)
+	assert.Equal(t, map[string]interface{}{"variantA": "variantA", "variantB": "variantB"}, variantFlag.Variants)
+	assert.JSONEq(t, `{"if":[{"==":[{"var":"tier"},"gold"]},"variantA",null]}`, string(variantFlag.Targeting))
+
+	require.Contains(t, doc.Flags, "boolFlag")
+	boolFlag := doc.Flags["boolFlag"]
+	assert.Equal(t, stateEnabled, boolFlag.State)
+	assert.Equal(t, "true", boolFlag.DefaultVariant)
+	assert.JSONEq(t, `{"fractional":[["true",50],["false",50]]}`, string(boolFlag.Targeting))
+}
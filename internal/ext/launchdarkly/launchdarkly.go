@@ -0,0 +1,503 @@
+// Package launchdarkly converts a LaunchDarkly flags/segments export into
+// Flipt's declarative document format, for use by `flipt import --from
+// launchdarkly`.
+//
+// LaunchDarkly's targeting model (explicit per-variation user lists,
+// AND/OR combinations of attribute clauses, percentage rollouts across
+// arbitrarily many variations) doesn't map one-to-one onto Flipt's
+// segment/rule model, so the conversion is best-effort: constructs that
+// can't be faithfully represented are skipped and recorded in the
+// returned Report, rather than silently dropped or approximated
+// incorrectly.
+package launchdarkly
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+// export is the subset of LaunchDarkly's flags/segments export format that
+// Convert understands.
+type export struct {
+	Flags    map[string]ldFlag    `json:"flags"`
+	Segments map[string]ldSegment `json:"segments"`
+}
+
+type ldFlag struct {
+	Key          string               `json:"key"`
+	Name         string               `json:"name"`
+	Description  string               `json:"description"`
+	Kind         string               `json:"kind"`
+	Variations   []ldVariation        `json:"variations"`
+	Environments map[string]ldFlagEnv `json:"environments"`
+}
+
+type ldVariation struct {
+	Value interface{} `json:"value"`
+	Name  string      `json:"name"`
+}
+
+type ldFlagEnv struct {
+	On          bool          `json:"on"`
+	Fallthrough ldFallthrough `json:"fallthrough"`
+	Targets     []ldTarget    `json:"targets"`
+	Rules       []ldRule      `json:"rules"`
+}
+
+type ldFallthrough struct {
+	Variation *int `json:"variation"`
+}
+
+type ldTarget struct {
+	Variation int      `json:"variation"`
+	Values    []string `json:"values"`
+}
+
+type ldRule struct {
+	Clauses   []ldClause `json:"clauses"`
+	Variation *int       `json:"variation"`
+	Rollout   *ldRollout `json:"rollout"`
+}
+
+type ldClause struct {
+	Attribute string   `json:"attribute"`
+	Op        string   `json:"op"`
+	Values    []string `json:"values"`
+	Negate    bool     `json:"negate"`
+}
+
+type ldRollout struct {
+	Variations []ldRolloutVariation `json:"variations"`
+}
+
+type ldRolloutVariation struct {
+	Variation int `json:"variation"`
+	Weight    int `json:"weight"`
+}
+
+type ldSegment struct {
+	Key          string                  `json:"key"`
+	Name         string                  `json:"name"`
+	Description  string                  `json:"description"`
+	Environments map[string]ldSegmentEnv `json:"environments"`
+}
+
+type ldSegmentEnv struct {
+	Included []string `json:"included"`
+	Excluded []string `json:"excluded"`
+	Rules    []ldRule `json:"rules"`
+}
+
+// Convert reads a LaunchDarkly flags/segments export from r and converts
+// it into a Flipt document for the default namespace, using the named
+// LaunchDarkly environment as the source of on/off state, targets and
+// rules. It returns a Report describing every flag and segment
+// considered, including anything that couldn't be converted faithfully.
+func Convert(r io.Reader, environment string) (*ext.Document, *Report, error) {
+	var exp export
+	if err := json.NewDecoder(r).Decode(&exp); err != nil {
+		return nil, nil, fmt.Errorf("decoding launchdarkly export: %w", err)
+	}
+
+	var (
+		report = new(Report)
+		doc    = &ext.Document{}
+	)
+
+	knownSegments := make(map[string]struct{}, len(exp.Segments))
+	for key := range exp.Segments {
+		knownSegments[key] = struct{}{}
+	}
+
+	for _, key := range sortedSegmentKeys(exp.Segments) {
+		s := exp.Segments[key]
+
+		env, ok := s.Environments[environment]
+		if !ok {
+			report.skip(fmt.Sprintf("segment %q", s.Key), fmt.Sprintf("has no %q environment", environment))
+			continue
+		}
+
+		segment := &ext.Segment{
+			Key:         s.Key,
+			Name:        s.Name,
+			Description: s.Description,
+			MatchType:   flipt.MatchType_ANY_MATCH_TYPE.String(),
+		}
+
+		for _, key := range env.Included {
+			segment.Constraints = append(segment.Constraints, targetConstraint(key))
+		}
+
+		for _, rule := range env.Rules {
+			constraints, ok := convertClauses(rule.Clauses)
+			if !ok {
+				report.skip(fmt.Sprintf("segment %q rule", s.Key), "clauses could not be translated to Flipt constraints")
+				continue
+			}
+
+			segment.Constraints = append(segment.Constraints, constraints...)
+		}
+
+		if len(env.Excluded) > 0 {
+			report.skip(fmt.Sprintf("segment %q excluded list", s.Key), "Flipt segments have no equivalent to LaunchDarkly's excluded user list")
+		}
+
+		doc.Segments = append(doc.Segments, segment)
+		report.convert(fmt.Sprintf("segment %q", s.Key))
+	}
+
+	for _, key := range sortedFlagKeys(exp.Flags) {
+		f := exp.Flags[key]
+
+		env, ok := f.Environments[environment]
+		if !ok {
+			report.skip(fmt.Sprintf("flag %q", f.Key), fmt.Sprintf("has no %q environment", environment))
+			continue
+		}
+
+		flag, ok := convertFlag(doc, f, env, knownSegments, report)
+		if !ok {
+			continue
+		}
+
+		doc.Flags = append(doc.Flags, flag)
+		report.convert(fmt.Sprintf("flag %q", f.Key))
+	}
+
+	return doc, report, nil
+}
+
+// convertFlag converts f into a Flipt flag. Targets and rules that need
+// an ad hoc segment of their own (rather than referencing one already
+// present in the export) have that segment appended directly to doc.
+func convertFlag(doc *ext.Document, f ldFlag, env ldFlagEnv, knownSegments map[string]struct{}, report *Report) (*ext.Flag, bool) {
+	boolean := f.Kind == "boolean"
+
+	flag := &ext.Flag{
+		Key:         f.Key,
+		Name:        f.Name,
+		Description: f.Description,
+		Enabled:     env.On,
+	}
+
+	if boolean {
+		flag.Type = flipt.FlagType_BOOLEAN_FLAG_TYPE.String()
+	} else {
+		flag.Type = flipt.FlagType_VARIANT_FLAG_TYPE.String()
+
+		for i, v := range f.Variations {
+			flag.Variants = append(flag.Variants, &ext.Variant{
+				Key:        variationKey(i, v),
+				Name:       v.Name,
+				Attachment: v.Value,
+			})
+		}
+	}
+
+	if env.Fallthrough.Variation != nil {
+		report.skip(fmt.Sprintf("flag %q fallthrough", f.Key), "Flipt has no equivalent to LaunchDarkly's default (fallthrough) variation for non-matching requests")
+	}
+
+	for _, target := range env.Targets {
+		if len(target.Values) == 0 {
+			continue
+		}
+
+		segment := &ext.Segment{
+			Key:       fmt.Sprintf("%s-target-%d", f.Key, target.Variation),
+			Name:      fmt.Sprintf("%s target %d", f.Key, target.Variation),
+			MatchType: flipt.MatchType_ANY_MATCH_TYPE.String(),
+		}
+
+		for _, key := range target.Values {
+			segment.Constraints = append(segment.Constraints, targetConstraint(key))
+		}
+
+		if err := appendRule(flag, boolean, segment, f.Variations, target.Variation, nil); err != nil {
+			report.skip(fmt.Sprintf("flag %q target %d", f.Key, target.Variation), err.Error())
+			continue
+		}
+
+		doc.Segments = append(doc.Segments, segment)
+	}
+
+	for i, rule := range env.Rules {
+		var segment *ext.Segment
+
+		if n, ok := segmentMatch(rule.Clauses); ok {
+			if _, known := knownSegments[n]; !known {
+				report.skip(fmt.Sprintf("flag %q rule %d", f.Key, i), fmt.Sprintf("references unknown segment %q", n))
+				continue
+			}
+
+			segment = &ext.Segment{Key: n}
+		} else {
+			constraints, ok := convertClauses(rule.Clauses)
+			if !ok {
+				report.skip(fmt.Sprintf("flag %q rule %d", f.Key, i), "clauses could not be translated to Flipt constraints")
+				continue
+			}
+
+			segment = &ext.Segment{
+				Key:         fmt.Sprintf("%s-rule-%d", f.Key, i),
+				Name:        fmt.Sprintf("%s rule %d", f.Key, i),
+				MatchType:   flipt.MatchType_ALL_MATCH_TYPE.String(),
+				Constraints: constraints,
+			}
+		}
+
+		variation := 0
+		if rule.Variation != nil {
+			variation = *rule.Variation
+		}
+
+		if err := appendRule(flag, boolean, segment, f.Variations, variation, rule.Rollout); err != nil {
+			report.skip(fmt.Sprintf("flag %q rule %d", f.Key, i), err.Error())
+			continue
+		}
+
+		// Rules that built their own ad hoc segment (rather than
+		// referencing an existing one) need that segment added to the
+		// namespace so the rule it's used in is valid.
+		if segment.Name != "" {
+			doc.Segments = append(doc.Segments, segment)
+		}
+	}
+
+	return flag, true
+}
+
+// appendRule adds a rule (for variant flags) or rollout (for boolean
+// flags) targeting segment to flag, selecting either a single variation
+// or, if rollout is set, a percentage split across variations.
+func appendRule(flag *ext.Flag, boolean bool, segment *ext.Segment, variations []ldVariation, variation int, rollout *ldRollout) error {
+	if boolean {
+		value, err := booleanVariationValue(variations, variation)
+		if err != nil {
+			return err
+		}
+
+		r := &ext.Rollout{
+			Description: fmt.Sprintf("migrated from LaunchDarkly segment %q", segment.Key),
+		}
+
+		if rollout != nil {
+			pct, ok := booleanRolloutPercentage(variations, rollout)
+			if !ok {
+				return fmt.Errorf("boolean rollout percentages could not be translated")
+			}
+
+			r.Threshold = &ext.ThresholdRule{Percentage: pct, Value: true}
+		} else {
+			r.Segment = &ext.SegmentRule{Key: segment.Key, Value: value}
+		}
+
+		flag.Rollouts = append(flag.Rollouts, r)
+
+		return nil
+	}
+
+	rule := &ext.Rule{
+		Segment: &ext.SegmentEmbed{IsSegment: ext.SegmentKey(segment.Key)},
+	}
+
+	if rollout != nil {
+		for _, rv := range rollout.Variations {
+			rule.Distributions = append(rule.Distributions, &ext.Distribution{
+				VariantKey: variationKeyByIndex(variations, rv.Variation),
+				Rollout:    float32(rv.Weight) / 1000,
+			})
+		}
+	} else {
+		rule.Distributions = append(rule.Distributions, &ext.Distribution{
+			VariantKey: variationKeyByIndex(variations, variation),
+			Rollout:    100,
+		})
+	}
+
+	flag.Rules = append(flag.Rules, rule)
+
+	return nil
+}
+
+// booleanVariationValue resolves variation to its boolean value, for
+// boolean-kind flags where LaunchDarkly's two variations are true/false.
+func booleanVariationValue(variations []ldVariation, variation int) (bool, error) {
+	if variation < 0 || variation >= len(variations) {
+		return false, fmt.Errorf("variation index %d out of range", variation)
+	}
+
+	v, ok := variations[variation].Value.(bool)
+	if !ok {
+		return false, fmt.Errorf("variation %d is not a boolean value", variation)
+	}
+
+	return v, nil
+}
+
+// booleanRolloutPercentage resolves a LaunchDarkly percentage rollout for
+// a boolean flag into the single "percentage enabled" value Flipt's
+// threshold rollout expects.
+func booleanRolloutPercentage(variations []ldVariation, rollout *ldRollout) (float32, bool) {
+	for _, rv := range rollout.Variations {
+		value, err := booleanVariationValue(variations, rv.Variation)
+		if err != nil {
+			continue
+		}
+
+		if value {
+			return float32(rv.Weight) / 1000, true
+		}
+	}
+
+	return 0, false
+}
+
+func variationKeyByIndex(variations []ldVariation, index int) string {
+	if index < 0 || index >= len(variations) {
+		return fmt.Sprintf("variation-%d", index)
+	}
+
+	return variationKey(index, variations[index])
+}
+
+func variationKey(index int, v ldVariation) string {
+	if v.Name != "" {
+		return v.Name
+	}
+
+	if s, ok := v.Value.(string); ok && s != "" {
+		return s
+	}
+
+	return fmt.Sprintf("variation-%d", index)
+}
+
+// targetConstraint builds a constraint matching a single explicit
+// LaunchDarkly target key (the equivalent of Flipt's entity/targeting
+// key attribute).
+func targetConstraint(key string) *ext.Constraint {
+	return &ext.Constraint{
+		Type:     flipt.ComparisonType_STRING_COMPARISON_TYPE.String(),
+		Property: "targetingKey",
+		Operator: flipt.OpEQ,
+		Value:    key,
+	}
+}
+
+// segmentMatch reports whether clauses is a single "segmentMatch" clause,
+// in which case it can be translated directly into a reference to the
+// already-converted Flipt segment with the same key, rather than a
+// synthetic one.
+func segmentMatch(clauses []ldClause) (string, bool) {
+	if len(clauses) != 1 || clauses[0].Op != "segmentMatch" || clauses[0].Negate {
+		return "", false
+	}
+
+	values := clauses[0].Values
+	if len(values) != 1 {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+// convertClauses translates a list of AND-ed LaunchDarkly clauses into
+// Flipt constraints. A single clause may itself carry several OR-ed
+// values (e.g. "country in [US, CA]"); Flipt's flat per-segment
+// match-type can express either an AND across constraints or an OR, but
+// not both at once, so a mix of a multi-value clause alongside other
+// clauses can't be represented and is rejected.
+func convertClauses(clauses []ldClause) ([]*ext.Constraint, bool) {
+	if len(clauses) == 0 {
+		return nil, false
+	}
+
+	if len(clauses) > 1 {
+		for _, c := range clauses {
+			if len(c.Values) > 1 {
+				return nil, false
+			}
+		}
+	}
+
+	var constraints []*ext.Constraint
+
+	for _, c := range clauses {
+		operator, ok := clauseOperator(c.Op, c.Negate)
+		if !ok {
+			return nil, false
+		}
+
+		if len(c.Values) == 0 {
+			return nil, false
+		}
+
+		for _, v := range c.Values {
+			constraints = append(constraints, &ext.Constraint{
+				Type:     flipt.ComparisonType_STRING_COMPARISON_TYPE.String(),
+				Property: c.Attribute,
+				Operator: operator,
+				Value:    v,
+			})
+		}
+	}
+
+	return constraints, true
+}
+
+// clauseOperator maps a LaunchDarkly clause operator to its closest
+// Flipt constraint operator equivalent. Only operators with a direct,
+// unambiguous equivalent are supported.
+func clauseOperator(op string, negate bool) (string, bool) {
+	switch op {
+	case "in":
+		if negate {
+			return flipt.OpNEQ, true
+		}
+
+		return flipt.OpEQ, true
+	case "startsWith":
+		if negate {
+			return "", false
+		}
+
+		return flipt.OpPrefix, true
+	case "endsWith":
+		if negate {
+			return "", false
+		}
+
+		return flipt.OpSuffix, true
+	default:
+		return "", false
+	}
+}
+
+func sortedFlagKeys(m map[string]ldFlag) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedSegmentKeys(m map[string]ldSegment) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,148 @@
+package launchdarkly
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/ext"
+)
+
+func TestConvert(t *testing.T) {
+	in := `{
+		"flags": {
+			"boolFlag": {
+				"key": "boolFlag",
+				"name": "Bool Flag",
+				"description": "a boolean flag",
+				"kind": "boolean",
+				"variations": [{"value": true}, {"value": false}],
+				"environments": {
+					"production": {
+						"on": true,
+						"fallthrough": {"variation": 1},
+						"targets": [{"variation": 0, "values": ["user1", "user2"]}],
+						"rules": [
+							{
+								"clauses": [{"attribute": "country", "op": "in", "values": ["US", "CA"], "negate": false}],
+								"variation": 0
+							},
+							{
+								"clauses": [{"attribute": "segmentMatch", "op": "segmentMatch", "values": ["beta_users"], "negate": false}],
+								"rollout": {"variations": [{"variation": 0, "weight": 50000}, {"variation": 1, "weight": 50000}]}
+							},
+							{
+								"clauses": [
+									{"attribute": "country", "op": "in", "values": ["US"], "negate": false},
+									{"attribute": "plan", "op": "in", "values": ["gold"], "negate": false}
+								],
+								"variation": 0
+							},
+							{
+								"clauses": [{"attribute": "country", "op": "contains", "values": ["US"], "negate": false}],
+								"variation": 0
+							}
+						]
+					}
+				}
+			},
+			"multiFlag": {
+				"key": "multiFlag",
+				"name": "Multi Flag",
+				"kind": "multivariate",
+				"variations": [{"value": "red", "name": "red"}, {"value": "blue", "name": "blue"}],
+				"environments": {
+					"production": {
+						"on": true,
+						"rules": [
+							{
+								"clauses": [{"attribute": "country", "op": "in", "values": ["US", "CA"], "negate": false}],
+								"rollout": {"variations": [{"variation": 0, "weight": 600000}, {"variation": 1, "weight": 400000}]}
+							}
+						]
+					}
+				}
+			},
+			"noEnvFlag": {
+				"key": "noEnvFlag",
+				"name": "No Env Flag",
+				"kind": "boolean",
+				"variations": [{"value": true}, {"value": false}],
+				"environments": {}
+			}
+		},
+		"segments": {
+			"beta_users": {
+				"key": "beta_users",
+				"name": "Beta Users",
+				"description": "opted-in beta users",
+				"environments": {
+					"production": {
+						"included": ["user3"],
+						"rules": [
+							{"clauses": [{"attribute": "plan", "op": "in", "values": ["gold", "platinum"], "negate": false}]}
+						]
+					}
+				}
+			}
+		}
+	}`
+
+	doc, report, err := Convert(strings.NewReader(in), "production")
+	require.NoError(t, err)
+
+	flagsByKey := make(map[string]*ext.Flag, len(doc.Flags))
+	for _, f := range doc.Flags {
+		flagsByKey[f.Key] = f
+	}
+
+	// noEnvFlag has no "production" environment, so it's skipped entirely.
+	assert.Len(t, doc.Flags, 2)
+	require.Contains(t, flagsByKey, "boolFlag")
+	require.Contains(t, flagsByKey, "multiFlag")
+
+	boolFlag := flagsByKey["boolFlag"]
+	assert.Equal(t, "BOOLEAN_FLAG_TYPE", boolFlag.Type)
+	assert.True(t, boolFlag.Enabled)
+	// 1 target + 1 "in" rule + 1 segmentMatch rule + 1 multi-clause AND
+	// rule = 4 rollouts; the rule using the unsupported "contains"
+	// operator is reported and skipped.
+	assert.Len(t, boolFlag.Rollouts, 4)
+
+	multiFlag := flagsByKey["multiFlag"]
+	assert.Equal(t, "VARIANT_FLAG_TYPE", multiFlag.Type)
+	require.Len(t, multiFlag.Rules, 1)
+	require.Len(t, multiFlag.Rules[0].Distributions, 2)
+
+	segmentsByKey := make(map[string]*ext.Segment, len(doc.Segments))
+	for _, s := range doc.Segments {
+		segmentsByKey[s.Key] = s
+	}
+
+	require.Contains(t, segmentsByKey, "beta_users")
+	betaUsers := segmentsByKey["beta_users"]
+	// 1 included user + 2 values from the "in" rule clause.
+	assert.Len(t, betaUsers.Constraints, 3)
+
+	var reasons []string
+	for _, n := range report.Skipped {
+		reasons = append(reasons, n.Resource+": "+n.Reason)
+	}
+
+	assert.Contains(t, reasons, `flag "boolFlag" fallthrough: Flipt has no equivalent to LaunchDarkly's default (fallthrough) variation for non-matching requests`)
+	assert.Contains(t, reasons, `flag "noEnvFlag": has no "production" environment`)
+
+	foundUnsupportedClause := false
+	for _, r := range reasons {
+		if strings.Contains(r, "boolFlag") && strings.Contains(r, "clauses could not be translated") {
+			foundUnsupportedClause = true
+		}
+	}
+	assert.True(t, foundUnsupportedClause, "expected a skipped note for boolFlag's unsupported rule clauses, got: %v", reasons)
+}
+
+func TestConvert_invalidJSON(t *testing.T) {
+	_, _, err := Convert(strings.NewReader("not json"), "production")
+	require.Error(t, err)
+}
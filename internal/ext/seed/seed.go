@@ -0,0 +1,171 @@
+// Package seed generates synthetic Flipt resources for load testing and
+// demo environments, in the same declarative document shape produced by
+// export and consumed by import, so the existing Importer can apply
+// generated data without a separate creation path.
+package seed
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+// Options configures how much data Generate produces and, optionally,
+// makes that generation reproducible.
+type Options struct {
+	Namespaces int
+	Flags      int
+	Segments   int
+	Rules      int
+
+	// Seed makes generation deterministic: the same Options (including
+	// Seed) always produce an identical document. A nil Seed generates
+	// different data on every call.
+	Seed *int64
+}
+
+// Generate builds namespaces/flags/segments/rules/distributions according
+// to opts, as a slice of documents (one per namespace) ready to be
+// imported via ext.Importer.
+func Generate(opts Options) []*ext.Document {
+	seed := time.Now().UnixNano()
+	if opts.Seed != nil {
+		seed = *opts.Seed
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	docs := make([]*ext.Document, 0, opts.Namespaces)
+
+	for ns := 0; ns < opts.Namespaces; ns++ {
+		docs = append(docs, generateNamespace(rng, ns, opts))
+	}
+
+	return docs
+}
+
+func generateNamespace(rng *rand.Rand, index int, opts Options) *ext.Document {
+	namespace := fmt.Sprintf("seed-namespace-%d", index)
+
+	doc := &ext.Document{Namespace: namespace}
+
+	for i := 0; i < opts.Segments; i++ {
+		doc.Segments = append(doc.Segments, generateSegment(rng, i))
+	}
+
+	for i := 0; i < opts.Flags; i++ {
+		doc.Flags = append(doc.Flags, generateFlag(rng, i, opts.Rules, doc.Segments))
+	}
+
+	return doc
+}
+
+func generateSegment(rng *rand.Rand, index int) *ext.Segment {
+	return &ext.Segment{
+		Key:         fmt.Sprintf("seed-segment-%d", index),
+		Name:        fmt.Sprintf("Seed Segment %d", index),
+		Description: "generated by flipt seed",
+		MatchType:   flipt.MatchType_ALL_MATCH_TYPE.String(),
+		Constraints: []*ext.Constraint{
+			{
+				Type:     flipt.ComparisonType_STRING_COMPARISON_TYPE.String(),
+				Property: "seed_property",
+				Operator: flipt.OpEQ,
+				Value:    fmt.Sprintf("seed-value-%d", rng.Intn(100)),
+			},
+		},
+	}
+}
+
+// generateFlag builds a flag, alternating between boolean and variant
+// flags by index so both kinds of downstream data (rollouts vs.
+// rules/distributions) get exercised. If segments is non-empty, rules
+// are gated behind a randomly chosen segment; otherwise they apply to
+// everyone.
+func generateFlag(rng *rand.Rand, index, ruleCount int, segments []*ext.Segment) *ext.Flag {
+	key := fmt.Sprintf("seed-flag-%d", index)
+
+	flag := &ext.Flag{
+		Key:         key,
+		Name:        fmt.Sprintf("Seed Flag %d", index),
+		Description: "generated by flipt seed",
+		Enabled:     true,
+	}
+
+	if index%2 == 0 {
+		flag.Type = flipt.FlagType_BOOLEAN_FLAG_TYPE.String()
+
+		for i := 0; i < ruleCount; i++ {
+			flag.Rollouts = append(flag.Rollouts, &ext.Rollout{
+				Description: fmt.Sprintf("generated rollout %d", i),
+				Threshold: &ext.ThresholdRule{
+					Percentage: float32(rng.Intn(10000)) / 100,
+					Value:      true,
+				},
+			})
+		}
+
+		return flag
+	}
+
+	flag.Type = flipt.FlagType_VARIANT_FLAG_TYPE.String()
+
+	variantCount := 2
+	for i := 0; i < variantCount; i++ {
+		flag.Variants = append(flag.Variants, &ext.Variant{
+			Key:  fmt.Sprintf("%s-variant-%d", key, i),
+			Name: fmt.Sprintf("Variant %d", i),
+		})
+	}
+
+	for i := 0; i < ruleCount; i++ {
+		rule := &ext.Rule{
+			Distributions: generateDistributions(rng, key, variantCount),
+		}
+
+		if len(segments) > 0 {
+			segment := segments[rng.Intn(len(segments))]
+			rule.Segment = &ext.SegmentEmbed{IsSegment: ext.SegmentKey(segment.Key)}
+		}
+
+		flag.Rules = append(flag.Rules, rule)
+	}
+
+	return flag
+}
+
+// generateDistributions splits 100% across variantCount variants using
+// random weights, so every generated rule exercises a realistic,
+// non-uniform distribution.
+func generateDistributions(rng *rand.Rand, flagKey string, variantCount int) []*ext.Distribution {
+	weights := make([]int, variantCount)
+
+	total := 0
+	for i := range weights {
+		weights[i] = rng.Intn(100) + 1
+		total += weights[i]
+	}
+
+	distributions := make([]*ext.Distribution, variantCount)
+
+	remaining := float32(100)
+	for i := 0; i < variantCount; i++ {
+		distributions[i] = &ext.Distribution{
+			VariantKey: fmt.Sprintf("%s-variant-%d", flagKey, i),
+		}
+
+		if i == variantCount-1 {
+			distributions[i].Rollout = remaining
+			continue
+		}
+
+		rollout := float32(weights[i]) / float32(total) * 100
+		distributions[i].Rollout = rollout
+		remaining -= rollout
+	}
+
+	return distributions
+}
@@ -0,0 +1,65 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	seed := int64(42)
+
+	opts := Options{
+		Namespaces: 2,
+		Flags:      4,
+		Segments:   2,
+		Rules:      2,
+		Seed:       &seed,
+	}
+
+	docs := Generate(opts)
+	require.Len(t, docs, 2)
+
+	for _, doc := range docs {
+		require.Len(t, doc.Flags, 4)
+		require.Len(t, doc.Segments, 2)
+
+		for i, f := range doc.Flags {
+			if i%2 == 0 {
+				assert.Equal(t, "BOOLEAN_FLAG_TYPE", f.Type)
+				assert.Len(t, f.Rollouts, 2)
+			} else {
+				assert.Equal(t, "VARIANT_FLAG_TYPE", f.Type)
+				require.Len(t, f.Rules, 2)
+
+				for _, r := range f.Rules {
+					var total float32
+					for _, d := range r.Distributions {
+						total += d.Rollout
+					}
+					assert.InDelta(t, 100, total, 0.01)
+				}
+			}
+		}
+	}
+}
+
+func TestGenerate_deterministic(t *testing.T) {
+	seed := int64(7)
+	opts := Options{Namespaces: 1, Flags: 3, Segments: 1, Rules: 1, Seed: &seed}
+
+	first := Generate(opts)
+	second := Generate(opts)
+
+	assert.Equal(t, first, second)
+}
+
+func TestGenerate_noSeedVaries(t *testing.T) {
+	opts := Options{Namespaces: 1, Flags: 1, Segments: 1, Rules: 3}
+
+	first := Generate(opts)
+	second := Generate(opts)
+
+	assert.NotEqual(t, first, second)
+}
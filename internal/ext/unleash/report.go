@@ -0,0 +1,44 @@
+package unleash
+
+import (
+	"fmt"
+	"io"
+)
+
+// Note records what happened to a single Unleash resource during
+// conversion.
+type Note struct {
+	Resource string
+	Reason   string
+}
+
+// Report summarizes the result of a Convert call: every resource that
+// was converted, and every resource (or part of a resource) that could
+// not be and was left out of the resulting document.
+type Report struct {
+	Converted []Note
+	Skipped   []Note
+}
+
+func (r *Report) convert(resource string) {
+	r.Converted = append(r.Converted, Note{Resource: resource})
+}
+
+func (r *Report) skip(resource, reason string) {
+	r.Skipped = append(r.Skipped, Note{Resource: resource, Reason: reason})
+}
+
+// Fprint writes a human readable summary of r to w.
+func (r *Report) Fprint(w io.Writer) {
+	fmt.Fprintf(w, "converted %d resource(s)\n", len(r.Converted))
+
+	if len(r.Skipped) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "skipped %d resource(s):\n", len(r.Skipped))
+
+	for _, n := range r.Skipped {
+		fmt.Fprintf(w, "  - %s: %s\n", n.Resource, n.Reason)
+	}
+}
@@ -0,0 +1,368 @@
+// Package unleash converts an Unleash feature export into Flipt's
+// declarative document format, for use by `flipt import --from unleash`.
+//
+// Unleash models targeting as a list of strategies per environment, each
+// with its own parameters and constraints, and gradual rollouts as a
+// percentage parameter on a strategy. Flipt models targeting as
+// segments/rules instead, so the conversion is best-effort: constructs
+// that can't be faithfully represented are skipped and recorded in the
+// returned Report, rather than silently dropped or approximated
+// incorrectly.
+package unleash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.flipt.io/flipt/internal/ext"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+// export is the subset of Unleash's feature export format that Convert
+// understands.
+type export struct {
+	Features            []feature            `json:"features"`
+	FeatureStrategies   []featureStrategy    `json:"featureStrategies"`
+	FeatureEnvironments []featureEnvironment `json:"featureEnvironments"`
+}
+
+type feature struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Variants    []variant `json:"variants"`
+}
+
+type variant struct {
+	Name    string      `json:"name"`
+	Weight  int         `json:"weight"`
+	Payload interface{} `json:"payload"`
+}
+
+type featureEnvironment struct {
+	FeatureName string `json:"featureName"`
+	Environment string `json:"environment"`
+	Enabled     bool   `json:"enabled"`
+}
+
+type featureStrategy struct {
+	FeatureName  string            `json:"featureName"`
+	Environment  string            `json:"environment"`
+	StrategyName string            `json:"strategyName"`
+	Parameters   map[string]string `json:"parameters"`
+	Constraints  []constraint      `json:"constraints"`
+}
+
+type constraint struct {
+	ContextName string   `json:"contextName"`
+	Operator    string   `json:"operator"`
+	Values      []string `json:"values"`
+	Value       string   `json:"value"`
+	Inverted    bool     `json:"inverted"`
+}
+
+// Convert reads an Unleash feature export from r and converts it into a
+// Flipt document for the default namespace, using the named Unleash
+// environment as the source of enabled state and strategies. It returns
+// a Report describing every feature considered, including anything that
+// couldn't be converted faithfully.
+func Convert(r io.Reader, environment string) (*ext.Document, *Report, error) {
+	var exp export
+	if err := json.NewDecoder(r).Decode(&exp); err != nil {
+		return nil, nil, fmt.Errorf("decoding unleash export: %w", err)
+	}
+
+	var (
+		report = new(Report)
+		doc    = &ext.Document{}
+	)
+
+	enabled := make(map[string]bool, len(exp.FeatureEnvironments))
+	for _, fe := range exp.FeatureEnvironments {
+		if fe.Environment == environment {
+			enabled[fe.FeatureName] = fe.Enabled
+		}
+	}
+
+	strategiesByFeature := make(map[string][]featureStrategy)
+	for _, s := range exp.FeatureStrategies {
+		if s.Environment != environment {
+			continue
+		}
+
+		strategiesByFeature[s.FeatureName] = append(strategiesByFeature[s.FeatureName], s)
+	}
+
+	for _, f := range sortedFeatures(exp.Features) {
+		on, ok := enabled[f.Name]
+		if !ok {
+			report.skip(fmt.Sprintf("feature %q", f.Name), fmt.Sprintf("has no %q environment", environment))
+			continue
+		}
+
+		flag := &ext.Flag{
+			Key:         f.Name,
+			Name:        f.Name,
+			Description: f.Description,
+			Enabled:     on,
+		}
+
+		multivariate := len(f.Variants) > 0
+
+		if multivariate {
+			flag.Type = flipt.FlagType_VARIANT_FLAG_TYPE.String()
+
+			for _, v := range f.Variants {
+				flag.Variants = append(flag.Variants, &ext.Variant{
+					Key:        v.Name,
+					Name:       v.Name,
+					Attachment: v.Payload,
+				})
+			}
+		} else {
+			flag.Type = flipt.FlagType_BOOLEAN_FLAG_TYPE.String()
+		}
+
+		for i, s := range strategiesByFeature[f.Name] {
+			segment, ok := convertStrategy(f.Name, i, s, report)
+			if !ok {
+				continue
+			}
+
+			if segment != nil {
+				doc.Segments = append(doc.Segments, segment)
+			}
+
+			if err := appendRule(flag, multivariate, segment, f.Variants, s); err != nil {
+				report.skip(fmt.Sprintf("feature %q strategy %d (%s)", f.Name, i, s.StrategyName), err.Error())
+				continue
+			}
+		}
+
+		doc.Flags = append(doc.Flags, flag)
+		report.convert(fmt.Sprintf("feature %q", f.Name))
+	}
+
+	return doc, report, nil
+}
+
+// convertStrategy builds the segment a strategy's constraints (and, for
+// userWithId/remoteAddress strategies, explicit targets) describe. A nil
+// segment with ok=true means the strategy applies to everyone (e.g. the
+// "default" strategy with no constraints).
+func convertStrategy(featureName string, index int, s featureStrategy, report *Report) (*ext.Segment, bool) {
+	var constraints []*ext.Constraint
+
+	for _, c := range s.Constraints {
+		converted, ok := convertConstraint(c)
+		if !ok {
+			report.skip(fmt.Sprintf("feature %q strategy %d (%s) constraint on %q", featureName, index, s.StrategyName, c.ContextName), fmt.Sprintf("operator %q is not supported", c.Operator))
+			return nil, false
+		}
+
+		constraints = append(constraints, converted...)
+	}
+
+	switch s.StrategyName {
+	case "userWithId":
+		for _, id := range splitList(s.Parameters["userIds"]) {
+			constraints = append(constraints, &ext.Constraint{
+				Type:     flipt.ComparisonType_STRING_COMPARISON_TYPE.String(),
+				Property: "userId",
+				Operator: flipt.OpEQ,
+				Value:    id,
+			})
+		}
+	case "remoteAddress":
+		for _, ip := range splitList(s.Parameters["IPs"]) {
+			constraints = append(constraints, &ext.Constraint{
+				Type:     flipt.ComparisonType_STRING_COMPARISON_TYPE.String(),
+				Property: "remoteAddress",
+				Operator: flipt.OpEQ,
+				Value:    ip,
+			})
+		}
+	}
+
+	if len(constraints) == 0 {
+		return nil, true
+	}
+
+	return &ext.Segment{
+		Key:         fmt.Sprintf("%s-strategy-%d", featureName, index),
+		Name:        fmt.Sprintf("%s strategy %d (%s)", featureName, index, s.StrategyName),
+		MatchType:   flipt.MatchType_ANY_MATCH_TYPE.String(),
+		Constraints: constraints,
+	}, true
+}
+
+// convertConstraint maps a single Unleash constraint to one or more Flipt
+// constraints (a constraint with multiple values expands to one Flipt
+// constraint per value, combined under the strategy's ANY_MATCH_TYPE
+// segment).
+func convertConstraint(c constraint) ([]*ext.Constraint, bool) {
+	var (
+		operator string
+		cmpType  = flipt.ComparisonType_STRING_COMPARISON_TYPE
+	)
+
+	switch c.Operator {
+	case "IN":
+		operator = flipt.OpEQ
+	case "NOT_IN":
+		operator = flipt.OpNEQ
+	case "STR_STARTS_WITH":
+		operator = flipt.OpPrefix
+	case "STR_ENDS_WITH":
+		operator = flipt.OpSuffix
+	case "NUM_EQ":
+		operator = flipt.OpEQ
+		cmpType = flipt.ComparisonType_NUMBER_COMPARISON_TYPE
+	default:
+		return nil, false
+	}
+
+	if c.Inverted && (c.Operator == "STR_STARTS_WITH" || c.Operator == "STR_ENDS_WITH") {
+		return nil, false
+	}
+
+	values := c.Values
+	if len(values) == 0 && c.Value != "" {
+		values = []string{c.Value}
+	}
+
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	constraints := make([]*ext.Constraint, 0, len(values))
+	for _, v := range values {
+		constraints = append(constraints, &ext.Constraint{
+			Type:     cmpType.String(),
+			Property: c.ContextName,
+			Operator: operator,
+			Value:    v,
+		})
+	}
+
+	return constraints, true
+}
+
+// appendRule adds a rule (for multivariate flags) or rollout (for
+// boolean flags) gated by segment to flag, using the strategy's gradual
+// rollout percentage when present.
+func appendRule(flag *ext.Flag, multivariate bool, segment *ext.Segment, variants []variant, s featureStrategy) error {
+	pct, hasRollout, err := rolloutPercentage(s)
+	if err != nil {
+		return err
+	}
+
+	if multivariate {
+		rule := &ext.Rule{}
+
+		if segment != nil {
+			rule.Segment = &ext.SegmentEmbed{IsSegment: ext.SegmentKey(segment.Key)}
+		}
+
+		total := float32(0)
+		if hasRollout {
+			total = pct
+		} else {
+			total = 100
+		}
+
+		weightSum := 0
+		for _, v := range variants {
+			weightSum += v.Weight
+		}
+
+		if weightSum == 0 {
+			return fmt.Errorf("variants have no weights to distribute across")
+		}
+
+		for _, v := range variants {
+			rule.Distributions = append(rule.Distributions, &ext.Distribution{
+				VariantKey: v.Name,
+				Rollout:    total * float32(v.Weight) / float32(weightSum),
+			})
+		}
+
+		flag.Rules = append(flag.Rules, rule)
+
+		return nil
+	}
+
+	rollout := &ext.Rollout{
+		Description: fmt.Sprintf("migrated from Unleash strategy %q", s.StrategyName),
+	}
+
+	switch {
+	case hasRollout:
+		rollout.Threshold = &ext.ThresholdRule{Percentage: pct, Value: true}
+	case segment != nil:
+		rollout.Segment = &ext.SegmentRule{Key: segment.Key, Value: true}
+	default:
+		rollout.Threshold = &ext.ThresholdRule{Percentage: 100, Value: true}
+	}
+
+	flag.Rollouts = append(flag.Rollouts, rollout)
+
+	return nil
+}
+
+// rolloutPercentage extracts a gradual-rollout percentage from a
+// strategy's parameters, for the strategy names Unleash uses to express
+// percentage-based rollouts.
+func rolloutPercentage(s featureStrategy) (float32, bool, error) {
+	switch s.StrategyName {
+	case "flexibleRollout":
+		return parsePercentage(s.Parameters["rollout"])
+	case "gradualRolloutUserId", "gradualRolloutSessionId", "gradualRolloutRandom":
+		return parsePercentage(s.Parameters["percentage"])
+	default:
+		return 0, false, nil
+	}
+}
+
+func parsePercentage(raw string) (float32, bool, error) {
+	if raw == "" {
+		return 0, false, nil
+	}
+
+	v, err := strconv.ParseFloat(raw, 32)
+	if err != nil {
+		return 0, false, fmt.Errorf("parsing rollout percentage %q: %w", raw, err)
+	}
+
+	return float32(v), true, nil
+}
+
+func splitList(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+
+	var out []string
+	for _, v := range strings.Split(raw, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+func sortedFeatures(features []feature) []feature {
+	sorted := make([]feature, len(features))
+	copy(sorted, features)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
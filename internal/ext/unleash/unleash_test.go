@@ -0,0 +1,121 @@
+package unleash
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/ext"
+)
+
+func TestConvert(t *testing.T) {
+	in := `{
+		"features": [
+			{
+				"name": "booleanFeature",
+				"description": "a boolean feature"
+			},
+			{
+				"name": "multivariateFeature",
+				"description": "a multivariate feature",
+				"variants": [
+					{"name": "red", "weight": 600},
+					{"name": "blue", "weight": 400}
+				]
+			},
+			{
+				"name": "noEnvFeature"
+			}
+		],
+		"featureEnvironments": [
+			{"featureName": "booleanFeature", "environment": "production", "enabled": true},
+			{"featureName": "multivariateFeature", "environment": "production", "enabled": true}
+		],
+		"featureStrategies": [
+			{
+				"featureName": "booleanFeature",
+				"environment": "production",
+				"strategyName": "flexibleRollout",
+				"parameters": {"rollout": "25", "stickiness": "default"},
+				"constraints": [
+					{"contextName": "country", "operator": "IN", "values": ["US", "CA"]}
+				]
+			},
+			{
+				"featureName": "booleanFeature",
+				"environment": "production",
+				"strategyName": "userWithId",
+				"parameters": {"userIds": "user1,user2"}
+			},
+			{
+				"featureName": "booleanFeature",
+				"environment": "production",
+				"strategyName": "default",
+				"constraints": [
+					{"contextName": "country", "operator": "STR_CONTAINS", "values": ["US"]}
+				]
+			},
+			{
+				"featureName": "multivariateFeature",
+				"environment": "production",
+				"strategyName": "flexibleRollout",
+				"parameters": {"rollout": "50"},
+				"constraints": [
+					{"contextName": "country", "operator": "IN", "values": ["US"]}
+				]
+			}
+		]
+	}`
+
+	doc, report, err := Convert(strings.NewReader(in), "production")
+	require.NoError(t, err)
+
+	flagsByKey := make(map[string]*ext.Flag, len(doc.Flags))
+	for _, f := range doc.Flags {
+		flagsByKey[f.Key] = f
+	}
+
+	// noEnvFeature has no "production" environment, so it's skipped entirely.
+	assert.Len(t, doc.Flags, 2)
+	require.Contains(t, flagsByKey, "booleanFeature")
+	require.Contains(t, flagsByKey, "multivariateFeature")
+
+	booleanFeature := flagsByKey["booleanFeature"]
+	assert.Equal(t, "BOOLEAN_FLAG_TYPE", booleanFeature.Type)
+	assert.True(t, booleanFeature.Enabled)
+	// flexibleRollout + userWithId succeed; the "default" strategy's
+	// unsupported STR_CONTAINS constraint is reported and skipped.
+	assert.Len(t, booleanFeature.Rollouts, 2)
+
+	multivariateFeature := flagsByKey["multivariateFeature"]
+	assert.Equal(t, "VARIANT_FLAG_TYPE", multivariateFeature.Type)
+	require.Len(t, multivariateFeature.Rules, 1)
+	require.Len(t, multivariateFeature.Rules[0].Distributions, 2)
+
+	var total float32
+	for _, d := range multivariateFeature.Rules[0].Distributions {
+		total += d.Rollout
+	}
+	assert.InDelta(t, 50, total, 0.01)
+
+	var reasons []string
+	for _, n := range report.Skipped {
+		reasons = append(reasons, n.Resource+": "+n.Reason)
+	}
+
+	assert.Contains(t, reasons, `feature "noEnvFeature": has no "production" environment`)
+
+	foundUnsupportedConstraint := false
+	for _, r := range reasons {
+		if strings.Contains(r, "STR_CONTAINS") || strings.Contains(r, "is not supported") {
+			foundUnsupportedConstraint = true
+		}
+	}
+	assert.True(t, foundUnsupportedConstraint, "expected a skipped note for the unsupported STR_CONTAINS constraint, got: %v", reasons)
+}
+
+func TestConvert_invalidJSON(t *testing.T) {
+	_, _, err := Convert(strings.NewReader("not json"), "production")
+	require.Error(t, err)
+}
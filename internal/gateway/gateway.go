@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.flipt.io/flipt/internal/server/requestid"
 	"go.flipt.io/flipt/rpc/flipt"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -33,6 +34,25 @@ func NewGatewayServeMux(logger *zap.Logger, opts ...runtime.ServeMuxOption) *run
 					DiscardUnknown: true,
 				},
 			}),
+			// clients can set Accept: application/vnd.flipt.attachment+json to
+			// receive variant attachments as nested JSON objects rather than
+			// serialized strings, avoiding a second decode step in every SDK.
+			runtime.WithMarshalerOption(flipt.StructuredAttachmentMIME, flipt.NewStructuredAttachmentMarshaler(flipt.NewV1toV2MarshallerAdapter(logger))),
+			// honor and echo back a caller-supplied X-Request-Id for end-to-end correlation
+			runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+				if key == requestid.Header {
+					return requestid.MetadataKey, true
+				}
+
+				return runtime.DefaultHeaderMatcher(key)
+			}),
+			runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+				if key == requestid.MetadataKey {
+					return requestid.Header, true
+				}
+
+				return runtime.DefaultHeaderMatcher(key)
+			}),
 		}
 
 	})
@@ -0,0 +1,315 @@
+package ramp
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/server/audit"
+	"go.flipt.io/flipt/internal/storage"
+	"go.flipt.io/flipt/internal/storage/oplock"
+	flipt "go.flipt.io/flipt/rpc/flipt"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// minRetryInterval bounds how soon a plan retries acquiring its lock after
+// an error from the lock service itself.
+const minRetryInterval = 5 * time.Minute
+
+// Plan is a single ramp schedule, ramping a threshold rollout through a
+// sequence of Steps.
+type Plan struct {
+	NamespaceKey string
+	FlagKey      string
+	RolloutId    string
+	Steps        []Step
+}
+
+// Step is one point in a Plan's schedule: hold the rollout at Percentage
+// for Duration before advancing to the next step.
+type Step struct {
+	Percentage float32
+	Duration   time.Duration
+}
+
+// NewPlans converts the configured ramp schedules into Plans.
+func NewPlans(cfg config.RolloutConfig) []Plan {
+	plans := make([]Plan, 0, len(cfg.Plans))
+	for _, p := range cfg.Plans {
+		plan := Plan{
+			NamespaceKey: p.NamespaceKey,
+			FlagKey:      p.FlagKey,
+			RolloutId:    p.RolloutId,
+		}
+
+		for _, s := range p.Steps {
+			plan.Steps = append(plan.Steps, Step{Percentage: s.Percentage, Duration: s.Duration})
+		}
+
+		plans = append(plans, plan)
+	}
+
+	return plans
+}
+
+// controlState is the operator-controlled state of a running plan.
+type controlState int
+
+const (
+	stateRunning controlState = iota
+	statePaused
+	stateAborted
+)
+
+// planState tracks the live, mutable state of a single running Plan.
+type planState struct {
+	mu    sync.Mutex
+	state controlState
+	step  int
+}
+
+// Service executes a set of ramp Plans in the background, advancing each
+// rollout's threshold percentage through its configured Steps. Only one
+// Flipt replica advances a given plan at a time, coordinated via lock, so
+// that horizontally scaled deployments do not race to apply the same step.
+type Service struct {
+	logger *zap.Logger
+	lock   oplock.Service
+	store  storage.RolloutStore
+	plans  []Plan
+
+	enableAuditLogging bool
+
+	errgroup errgroup.Group
+	cancel   func()
+
+	mu     sync.Mutex
+	states map[string]*planState
+}
+
+// NewService constructs a new ramp Service.
+func NewService(logger *zap.Logger, lock oplock.Service, store storage.RolloutStore, plans []Plan, enableAuditLogging bool) *Service {
+	return &Service{
+		logger:             logger,
+		lock:               lock,
+		store:              store,
+		plans:              plans,
+		enableAuditLogging: enableAuditLogging,
+		cancel:             func() {},
+		states:             make(map[string]*planState),
+	}
+}
+
+// Run starts one background goroutine per configured Plan.
+func (s *Service) Run(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, plan := range s.plans {
+		plan := plan
+		logger := s.logger.With(
+			zap.String("namespace", plan.NamespaceKey),
+			zap.String("flag", plan.FlagKey),
+			zap.String("rollout", plan.RolloutId),
+		)
+
+		state := s.stateFor(plan.RolloutId)
+
+		s.errgroup.Go(func() error {
+			s.runPlan(ctx, logger, plan, state)
+			return nil
+		})
+	}
+}
+
+// Shutdown signals for the ramp goroutines to cancel and waits for them to finish.
+func (s *Service) Shutdown(ctx context.Context) error {
+	s.cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- s.errgroup.Wait() }()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-done:
+		return err
+	}
+}
+
+// Pause halts the named plan after its current step completes, until Resume
+// is called. It is a no-op if the plan is not known.
+func (s *Service) Pause(rolloutID string) {
+	s.stateFor(rolloutID).transition(statePaused)
+}
+
+// Resume continues a previously Paused plan.
+func (s *Service) Resume(rolloutID string) {
+	s.stateFor(rolloutID).transition(stateRunning)
+}
+
+// Abort stops the named plan permanently; it will not advance any further steps.
+func (s *Service) Abort(rolloutID string) {
+	s.stateFor(rolloutID).transition(stateAborted)
+}
+
+func (s *Service) stateFor(rolloutID string) *planState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.states[rolloutID]
+	if !ok {
+		st = &planState{}
+		s.states[rolloutID] = st
+	}
+
+	return st
+}
+
+func (st *planState) transition(to controlState) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.state = to
+}
+
+func (st *planState) get() (controlState, int) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.state, st.step
+}
+
+func (st *planState) setStep(step int) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.step = step
+}
+
+// runPlan advances plan through its Steps until they are exhausted, ctx is
+// cancelled, or the plan is aborted. Whenever this replica holds the lease
+// for the plan's operation it applies the current step, then waits out its
+// Duration (or until another replica's lease for the same step expires)
+// before moving on to the next step.
+func (s *Service) runPlan(ctx context.Context, logger *zap.Logger, plan Plan, state *planState) {
+	operation := oplock.Operation(fmt.Sprintf("ramp_%s_%s_%s", plan.NamespaceKey, plan.FlagKey, plan.RolloutId))
+
+	// acquiredUntil carries the lease deadline across steps: whichever
+	// replica is holding it waits it out before any replica (itself
+	// included) attempts to acquire the next step's lease.
+	acquiredUntil := time.Now().UTC()
+
+	for i, step := range plan.Steps {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if !s.awaitUnpaused(ctx, state) {
+			return
+		}
+
+		state.setStep(i)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Until(acquiredUntil)):
+			}
+
+			if st, _ := state.get(); st == stateAborted {
+				logger.Info("ramp plan aborted", zap.Int("step", i))
+				return
+			}
+
+			acquired, entry, err := s.lock.TryAcquire(ctx, operation, step.Duration)
+			if err != nil {
+				logger.Warn("attempting to acquire ramp lock", zap.Error(err))
+				acquiredUntil = time.Now().UTC().Add(minRetryInterval)
+				continue
+			}
+
+			acquiredUntil = entry.AcquiredUntil
+
+			if !acquired {
+				break
+			}
+
+			if err := s.applyStep(ctx, plan, i, step); err != nil {
+				logger.Error("applying ramp step", zap.Int("step", i), zap.Error(err))
+			} else {
+				logger.Info("ramp step applied", zap.Int("step", i), zap.Float32("percentage", step.Percentage))
+			}
+
+			break
+		}
+	}
+}
+
+// awaitUnpaused blocks while the plan is paused, returning false if ctx is
+// cancelled or the plan is aborted while waiting.
+func (s *Service) awaitUnpaused(ctx context.Context, state *planState) bool {
+	for {
+		st, _ := state.get()
+		switch st {
+		case stateAborted:
+			return false
+		case statePaused:
+			select {
+			case <-ctx.Done():
+				return false
+			case <-time.After(time.Second):
+			}
+		default:
+			return true
+		}
+	}
+}
+
+func (s *Service) applyStep(ctx context.Context, plan Plan, index int, step Step) error {
+	rollout, err := s.store.GetRollout(ctx, plan.NamespaceKey, plan.RolloutId)
+	if err != nil {
+		return fmt.Errorf("fetching rollout: %w", err)
+	}
+
+	threshold := rollout.GetThreshold()
+	if threshold == nil {
+		return fmt.Errorf("rollout %s/%s is not a threshold rollout", plan.NamespaceKey, plan.RolloutId)
+	}
+
+	updated, err := s.store.UpdateRollout(ctx, &flipt.UpdateRolloutRequest{
+		Id:           plan.RolloutId,
+		NamespaceKey: plan.NamespaceKey,
+		FlagKey:      plan.FlagKey,
+		Description:  rollout.Description,
+		Rule: &flipt.UpdateRolloutRequest_Threshold{
+			Threshold: &flipt.RolloutThreshold{
+				Percentage: step.Percentage,
+				Value:      threshold.Value,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("updating rollout: %w", err)
+	}
+
+	if s.enableAuditLogging {
+		s.auditStepApplied(ctx, updated, index)
+	}
+
+	return nil
+}
+
+// auditStepApplied emits a single audit event for a ramp step that this
+// replica just applied. Since this runs in a background goroutine with no
+// gRPC request in flight, we start a span of our own to attach the event to.
+func (s *Service) auditStepApplied(ctx context.Context, rollout *flipt.Rollout, index int) {
+	ctx, span := otel.Tracer("flipt").Start(ctx, "ramp.ApplyStep")
+	defer span.End()
+
+	event := audit.NewEvent(audit.RolloutType, audit.Update, map[string]string{
+		"rollout": "system",
+	}, audit.NewRollout(rollout))
+	event.AddToSpan(ctx)
+}
@@ -0,0 +1,141 @@
+package ramp
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/storage"
+	inmemoplock "go.flipt.io/flipt/internal/storage/oplock/memory"
+	flipt "go.flipt.io/flipt/rpc/flipt"
+	"go.uber.org/zap/zaptest"
+)
+
+type fakeRolloutStore struct {
+	mu       sync.Mutex
+	rollouts map[string]*flipt.Rollout
+	updates  []float32
+}
+
+func newFakeRolloutStore(rollout *flipt.Rollout) *fakeRolloutStore {
+	return &fakeRolloutStore{rollouts: map[string]*flipt.Rollout{rollout.Id: rollout}}
+}
+
+func (f *fakeRolloutStore) GetRollout(_ context.Context, _, id string) (*flipt.Rollout, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rollouts[id], nil
+}
+
+func (f *fakeRolloutStore) ListRollouts(context.Context, string, string, ...storage.QueryOption) (storage.ResultSet[*flipt.Rollout], error) {
+	return storage.ResultSet[*flipt.Rollout]{}, nil
+}
+
+func (f *fakeRolloutStore) CountRollouts(context.Context, string, string) (uint64, error) {
+	return 0, nil
+}
+
+func (f *fakeRolloutStore) CreateRollout(context.Context, *flipt.CreateRolloutRequest) (*flipt.Rollout, error) {
+	return nil, nil
+}
+
+func (f *fakeRolloutStore) UpdateRollout(_ context.Context, r *flipt.UpdateRolloutRequest) (*flipt.Rollout, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rollout := f.rollouts[r.Id]
+	threshold := r.GetThreshold()
+	rollout.Rule = &flipt.Rollout_Threshold{Threshold: threshold}
+	f.updates = append(f.updates, threshold.Percentage)
+
+	return rollout, nil
+}
+
+func (f *fakeRolloutStore) DeleteRollout(context.Context, *flipt.DeleteRolloutRequest) error {
+	return nil
+}
+
+func (f *fakeRolloutStore) OrderRollouts(context.Context, *flipt.OrderRolloutsRequest) error {
+	return nil
+}
+
+func (f *fakeRolloutStore) appliedPercentages() []float32 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]float32(nil), f.updates...)
+}
+
+func TestService_RunPlan(t *testing.T) {
+	store := newFakeRolloutStore(&flipt.Rollout{
+		Id:           "rollout1",
+		NamespaceKey: "default",
+		FlagKey:      "flag1",
+		Rule:         &flipt.Rollout_Threshold{Threshold: &flipt.RolloutThreshold{Percentage: 5, Value: true}},
+	})
+
+	plan := Plan{
+		NamespaceKey: "default",
+		FlagKey:      "flag1",
+		RolloutId:    "rollout1",
+		Steps: []Step{
+			{Percentage: 5, Duration: 50 * time.Millisecond},
+			{Percentage: 25, Duration: 50 * time.Millisecond},
+			{Percentage: 100, Duration: 50 * time.Millisecond},
+		},
+	}
+
+	svc := NewService(zaptest.NewLogger(t), inmemoplock.New(), store, []Plan{plan}, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	svc.Run(ctx)
+
+	// give the plan time to step through all three steps before shutting down.
+	time.Sleep(500 * time.Millisecond)
+
+	require.NoError(t, svc.Shutdown(context.Background()))
+
+	assert.Equal(t, []float32{5, 25, 100}, store.appliedPercentages())
+}
+
+func TestService_Abort(t *testing.T) {
+	store := newFakeRolloutStore(&flipt.Rollout{
+		Id:           "rollout1",
+		NamespaceKey: "default",
+		FlagKey:      "flag1",
+		Rule:         &flipt.Rollout_Threshold{Threshold: &flipt.RolloutThreshold{Percentage: 5, Value: true}},
+	})
+
+	plan := Plan{
+		NamespaceKey: "default",
+		FlagKey:      "flag1",
+		RolloutId:    "rollout1",
+		Steps: []Step{
+			{Percentage: 5, Duration: 50 * time.Millisecond},
+			{Percentage: 25, Duration: time.Hour},
+			{Percentage: 100, Duration: time.Hour},
+		},
+	}
+
+	svc := NewService(zaptest.NewLogger(t), inmemoplock.New(), store, []Plan{plan}, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	svc.Run(ctx)
+
+	// the first step applies immediately; abort well before its 50ms lease
+	// elapses so the second step is never attempted.
+	time.Sleep(10 * time.Millisecond)
+	svc.Abort("rollout1")
+
+	time.Sleep(100 * time.Millisecond)
+
+	require.NoError(t, svc.Shutdown(context.Background()))
+
+	assert.Equal(t, []float32{5}, store.appliedPercentages())
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 	"go.opentelemetry.io/otel/attribute"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -32,19 +33,21 @@ type Type string
 type Action string
 
 const (
-	ConstraintType   Type = "constraint"
-	DistributionType Type = "distribution"
-	FlagType         Type = "flag"
-	NamespaceType    Type = "namespace"
-	RolloutType      Type = "rollout"
-	RuleType         Type = "rule"
-	SegmentType      Type = "segment"
-	TokenType        Type = "token"
-	VariantType      Type = "variant"
+	AuthenticationType Type = "authentication"
+	ConstraintType     Type = "constraint"
+	DistributionType   Type = "distribution"
+	FlagType           Type = "flag"
+	NamespaceType      Type = "namespace"
+	RolloutType        Type = "rollout"
+	RuleType           Type = "rule"
+	SegmentType        Type = "segment"
+	TokenType          Type = "token"
+	VariantType        Type = "variant"
 
 	Create Action = "created"
 	Delete Action = "deleted"
 	Update Action = "updated"
+	Fail   Action = "failed"
 )
 
 // Event holds information that represents an audit internally.
@@ -271,3 +274,31 @@ func NewEvent(eventType Type, action Action, actor map[string]string, payload in
 		Timestamp: time.Now().Format(time.RFC3339),
 	}
 }
+
+// CloudEvent wraps an Event in a CloudEvents 1.0 compliant envelope, so that
+// downstream event routers and knative consumers can ingest audit events
+// without an adapter.
+type CloudEvent struct {
+	SpecVersion     string `json:"specversion"`
+	ID              string `json:"id"`
+	Source          string `json:"source"`
+	Type            string `json:"type"`
+	Time            string `json:"time"`
+	DataContentType string `json:"datacontenttype"`
+	Data            Event  `json:"data"`
+}
+
+// NewCloudEvent wraps e in a CloudEvents 1.0 envelope. source and typePrefix
+// populate the CloudEvents "source" and "type" attributes, with typePrefix
+// joined to the event's noun and verb, e.g. "io.flipt.event.flag.created".
+func NewCloudEvent(e Event, source, typePrefix string) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              uuid.New().String(),
+		Source:          source,
+		Type:            fmt.Sprintf("%s.%s.%s", typePrefix, e.Type, e.Action),
+		Time:            e.Timestamp,
+		DataContentType: "application/json",
+		Data:            e,
+	}
+}
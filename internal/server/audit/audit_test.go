@@ -108,3 +108,21 @@ func TestGRPCMethodToAction(t *testing.T) {
 	a = GRPCMethodToAction("NoMethodMatched")
 	assert.Equal(t, "", string(a))
 }
+
+func TestNewCloudEvent(t *testing.T) {
+	e := Event{
+		Type:      FlagType,
+		Action:    Create,
+		Timestamp: "2023-01-01T00:00:00Z",
+	}
+
+	ce := NewCloudEvent(e, "flipt", "io.flipt.event")
+
+	assert.Equal(t, "1.0", ce.SpecVersion)
+	assert.Equal(t, "flipt", ce.Source)
+	assert.Equal(t, "io.flipt.event.flag.created", ce.Type)
+	assert.Equal(t, e.Timestamp, ce.Time)
+	assert.Equal(t, "application/json", ce.DataContentType)
+	assert.Equal(t, e, ce.Data)
+	assert.NotEmpty(t, ce.ID)
+}
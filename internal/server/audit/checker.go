@@ -12,30 +12,40 @@ type Checker struct {
 	eventActions map[string]struct{}
 }
 
-// NewChecker is the constructor for a Checker.
+// NewChecker is the constructor for a Checker. eventPairs are noun:verb pairs
+// to include, optionally prefixed with "!" to exclude a pair that would
+// otherwise match a wildcard include (e.g. ["*:*", "!token:created"] emits
+// everything except token creation events).
 func NewChecker(eventPairs []string) (*Checker, error) {
 	nouns := map[string][]string{
-		"constraint":   {"constraint"},
-		"distribution": {"distribution"},
-		"flag":         {"flag"},
-		"namespace":    {"namespace"},
-		"rollout":      {"rollout"},
-		"rule":         {"rule"},
-		"segment":      {"segment"},
-		"token":        {"token"},
-		"variant":      {"variant"},
-		"*":            {"constraint", "distribution", "flag", "namespace", "rollout", "rule", "segment", "token", "variant"},
+		"authentication": {"authentication"},
+		"constraint":     {"constraint"},
+		"distribution":   {"distribution"},
+		"flag":           {"flag"},
+		"namespace":      {"namespace"},
+		"rollout":        {"rollout"},
+		"rule":           {"rule"},
+		"segment":        {"segment"},
+		"token":          {"token"},
+		"variant":        {"variant"},
+		"*":              {"authentication", "constraint", "distribution", "flag", "namespace", "rollout", "rule", "segment", "token", "variant"},
 	}
 
 	verbs := map[string][]string{
 		"created": {"created"},
 		"deleted": {"deleted"},
 		"updated": {"updated"},
-		"*":       {"created", "deleted", "updated"},
+		"failed":  {"failed"},
+		"*":       {"created", "deleted", "updated", "failed"},
 	}
 
 	eventActions := make(map[string]struct{})
+	excludeActions := make(map[string]struct{})
+
 	for _, ep := range eventPairs {
+		exclude := strings.HasPrefix(ep, "!")
+		ep = strings.TrimPrefix(ep, "!")
+
 		epSplit := strings.Split(ep, ":")
 		if len(epSplit) < 2 {
 			return nil, fmt.Errorf("invalid event pair: %s", ep)
@@ -51,16 +61,20 @@ func NewChecker(eventPairs []string) (*Checker, error) {
 			return nil, fmt.Errorf("invalid verb: %s", epSplit[1])
 		}
 
+		target := eventActions
+		if exclude {
+			target = excludeActions
+		}
+
 		for _, en := range eventNouns {
 			for _, ev := range eventVerbs {
 				eventPair := fmt.Sprintf("%s:%s", en, ev)
 
-				_, ok := eventActions[eventPair]
-				if ok {
+				if _, ok := target[eventPair]; ok {
 					return nil, fmt.Errorf("repeated event pair: %s", eventPair)
 				}
 
-				eventActions[eventPair] = struct{}{}
+				target[eventPair] = struct{}{}
 			}
 		}
 	}
@@ -69,13 +83,24 @@ func NewChecker(eventPairs []string) (*Checker, error) {
 		return nil, errors.New("no event pairs exist")
 	}
 
+	// excludes are applied after includes so that a wildcard include (e.g.
+	// "*:*") can still have specific pairs (e.g. "!token:created") carved out.
+	for ep := range excludeActions {
+		delete(eventActions, ep)
+	}
+
 	return &Checker{
 		eventActions: eventActions,
 	}, nil
 }
 
 // Check checks if an event pair exists in the Checker data structure for event emission.
+// A nil Checker (e.g. when audit logging is disabled) always returns false.
 func (c *Checker) Check(eventPair string) bool {
+	if c == nil {
+		return false
+	}
+
 	_, ok := c.eventActions[eventPair]
 	return ok
 }
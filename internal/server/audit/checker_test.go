@@ -113,11 +113,48 @@ func TestChecker(t *testing.T) {
 				"variant:updated":      false,
 			},
 		},
+		{
+			name:          "authentication events",
+			eventPairs:    []string{"authentication:*"},
+			expectedError: nil,
+			pairs: map[string]bool{
+				"authentication:created": true,
+				"authentication:deleted": true,
+				"authentication:updated": true,
+				"authentication:failed":  true,
+				"flag:created":           false,
+				"token:created":          false,
+			},
+		},
 		{
 			name:          "error repeating event pairs",
 			eventPairs:    []string{"*:created", "flag:created"},
 			expectedError: fmt.Errorf("repeated event pair: %s", "flag:created"),
 		},
+		{
+			name:          "exclude carves out of wildcard include",
+			eventPairs:    []string{"*:*", "!token:created"},
+			expectedError: nil,
+			pairs: map[string]bool{
+				"token:created": false,
+				"token:deleted": true,
+				"token:updated": true,
+				"flag:created":  true,
+				"flag:deleted":  true,
+				"flag:updated":  true,
+			},
+		},
+		{
+			name:          "exclude entire noun",
+			eventPairs:    []string{"*:*", "!token:*"},
+			expectedError: nil,
+			pairs: map[string]bool{
+				"token:created": false,
+				"token:deleted": false,
+				"token:updated": false,
+				"flag:created":  true,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
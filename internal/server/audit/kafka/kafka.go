@@ -0,0 +1,118 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+
+	segmentkafka "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/server/audit"
+	"go.uber.org/zap"
+)
+
+const sinkType = "kafka"
+
+// Writer is the subset of *segmentkafka.Writer that the Sink depends on.
+type Writer interface {
+	WriteMessages(ctx context.Context, msgs ...segmentkafka.Message) error
+	Close() error
+}
+
+// Sink is a structure in charge of producing Audits onto a configured Kafka topic.
+type Sink struct {
+	logger *zap.Logger
+	writer Writer
+
+	cloudEvents config.CloudEventsConfig
+}
+
+// NewSink is the constructor for a Sink.
+func NewSink(logger *zap.Logger, cfg config.KafkaSinkConfig, cloudEvents config.CloudEventsConfig) (audit.Sink, error) {
+	transport := &segmentkafka.Transport{}
+
+	if cfg.RequireTLS {
+		transport.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	if cfg.Authentication.Mechanism != "" {
+		mechanism, err := saslMechanism(cfg.Authentication)
+		if err != nil {
+			return nil, err
+		}
+
+		transport.SASL = mechanism
+	}
+
+	return &Sink{
+		logger: logger,
+		writer: &segmentkafka.Writer{
+			Addr:         segmentkafka.TCP(cfg.Brokers...),
+			Topic:        cfg.Topic,
+			Balancer:     &segmentkafka.LeastBytes{},
+			BatchSize:    cfg.BatchSize,
+			BatchTimeout: cfg.BatchTimeout,
+			Transport:    transport,
+		},
+		cloudEvents: cloudEvents,
+	}, nil
+}
+
+func saslMechanism(cfg config.KafkaAuthenticationConfig) (sasl.Mechanism, error) {
+	switch cfg.Mechanism {
+	case "plain":
+		return plain.Mechanism{Username: cfg.Username, Password: cfg.Password}, nil
+	case "scram-sha-256":
+		return scram.Mechanism(scram.SHA256, cfg.Username, cfg.Password)
+	case "scram-sha-512":
+		return scram.Mechanism(scram.SHA512, cfg.Username, cfg.Password)
+	default:
+		return nil, fmt.Errorf("unsupported kafka sasl mechanism: %q", cfg.Mechanism)
+	}
+}
+
+// SendAudits marshals each event to JSON and produces it as a batch onto the configured topic.
+func (s *Sink) SendAudits(ctx context.Context, events []audit.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	msgs := make([]segmentkafka.Message, 0, len(events))
+	for _, e := range events {
+		var (
+			body []byte
+			err  error
+		)
+
+		if s.cloudEvents.Enabled {
+			body, err = json.Marshal(audit.NewCloudEvent(e, s.cloudEvents.Source, s.cloudEvents.TypePrefix))
+		} else {
+			body, err = json.Marshal(e)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, segmentkafka.Message{Value: body})
+	}
+
+	if err := s.writer.WriteMessages(ctx, msgs...); err != nil {
+		s.logger.Error("failed to produce audit events to kafka", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *Sink) Close() error {
+	return s.writer.Close()
+}
+
+func (s *Sink) String() string {
+	return sinkType
+}
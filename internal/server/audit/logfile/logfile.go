@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"sync"
 
 	"github.com/hashicorp/go-multierror"
+	"go.flipt.io/flipt/internal/config"
 	"go.flipt.io/flipt/internal/server/audit"
 	"go.uber.org/zap"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 const sinkType = "logfile"
@@ -17,21 +20,39 @@ const sinkType = "logfile"
 // Sink is the structure in charge of sending Audits to a specified file location.
 type Sink struct {
 	logger *zap.Logger
-	file   *os.File
+	file   io.WriteCloser
+	name   string
 	mtx    sync.Mutex
 	enc    *json.Encoder
 }
 
-// NewSink is the constructor for a Sink.
-func NewSink(logger *zap.Logger, path string) (audit.Sink, error) {
-	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
-	if err != nil {
-		return nil, fmt.Errorf("opening log file: %w", err)
+// NewSink is the constructor for a Sink. If any of the rotation settings (MaxSize, MaxAge,
+// MaxBackups, Compress) are configured, writes go through a lumberjack.Logger which rotates
+// the file in place once it grows too large or old; otherwise the file is appended to directly.
+func NewSink(logger *zap.Logger, cfg config.LogFileSinkConfig) (audit.Sink, error) {
+	var file io.WriteCloser
+
+	if cfg.MaxSize > 0 || cfg.MaxAge > 0 || cfg.MaxBackups > 0 || cfg.Compress {
+		file = &lumberjack.Logger{
+			Filename:   cfg.File,
+			MaxSize:    cfg.MaxSize,
+			MaxAge:     cfg.MaxAge,
+			MaxBackups: cfg.MaxBackups,
+			Compress:   cfg.Compress,
+		}
+	} else {
+		f, err := os.OpenFile(cfg.File, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+		if err != nil {
+			return nil, fmt.Errorf("opening log file: %w", err)
+		}
+
+		file = f
 	}
 
 	return &Sink{
 		logger: logger,
 		file:   file,
+		name:   cfg.File,
 		enc:    json.NewEncoder(file),
 	}, nil
 }
@@ -44,7 +65,7 @@ func (l *Sink) SendAudits(ctx context.Context, events []audit.Event) error {
 	for _, e := range events {
 		err := l.enc.Encode(e)
 		if err != nil {
-			l.logger.Error("failed to write audit event to file", zap.String("file", l.file.Name()), zap.Error(err))
+			l.logger.Error("failed to write audit event to file", zap.String("file", l.name), zap.Error(err))
 			result = multierror.Append(result, err)
 		}
 	}
@@ -0,0 +1,93 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go"
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/server/audit"
+	"go.uber.org/zap"
+)
+
+const sinkType = "nats"
+
+// Conn is the subset of *nats.Conn that the Sink depends on.
+type Conn interface {
+	Publish(subj string, data []byte) error
+	Close()
+}
+
+// Sink publishes audit events as individual NATS messages on a configured
+// subject, so that other internal services - including other Flipt
+// replicas - can react to changes without polling.
+type Sink struct {
+	logger  *zap.Logger
+	conn    Conn
+	subject string
+
+	cloudEvents config.CloudEventsConfig
+}
+
+// NewSink is the constructor for a Sink.
+func NewSink(logger *zap.Logger, cfg config.NATSSinkConfig, cloudEvents config.CloudEventsConfig) (audit.Sink, error) {
+	opts := []nats.Option{
+		nats.Name("flipt"),
+	}
+
+	if cfg.Authentication.Token != "" {
+		opts = append(opts, nats.Token(cfg.Authentication.Token))
+	}
+
+	if cfg.Authentication.Username != "" {
+		opts = append(opts, nats.UserInfo(cfg.Authentication.Username, cfg.Authentication.Password))
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sink{
+		logger:      logger,
+		conn:        conn,
+		subject:     cfg.Subject,
+		cloudEvents: cloudEvents,
+	}, nil
+}
+
+// SendAudits marshals each event to JSON and publishes it on the configured subject.
+func (s *Sink) SendAudits(ctx context.Context, events []audit.Event) error {
+	for _, e := range events {
+		var (
+			body []byte
+			err  error
+		)
+
+		if s.cloudEvents.Enabled {
+			body, err = json.Marshal(audit.NewCloudEvent(e, s.cloudEvents.Source, s.cloudEvents.TypePrefix))
+		} else {
+			body, err = json.Marshal(e)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := s.conn.Publish(s.subject, body); err != nil {
+			s.logger.Error("failed to publish audit event to nats", zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Sink) Close() error {
+	s.conn.Close()
+	return nil
+}
+
+func (s *Sink) String() string {
+	return sinkType
+}
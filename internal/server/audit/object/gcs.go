@@ -0,0 +1,36 @@
+package object
+
+import (
+	"context"
+
+	"cloud.google.com/go/storage"
+	fliptconfig "go.flipt.io/flipt/internal/config"
+)
+
+type gcsWriter struct {
+	client *storage.Client
+	bucket string
+}
+
+// NewGCSWriter constructs a Writer which uploads objects to the configured
+// GCS bucket. Credentials are resolved via Application Default Credentials.
+func NewGCSWriter(ctx context.Context, cfg fliptconfig.ObjectGCSSinkConfig) (Writer, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gcsWriter{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (w *gcsWriter) WriteObject(ctx context.Context, key string, body []byte) error {
+	writer := w.client.Bucket(w.bucket).Object(key).NewWriter(ctx)
+	writer.ContentType = "application/x-ndjson"
+
+	if _, err := writer.Write(body); err != nil {
+		_ = writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,127 @@
+package object
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.flipt.io/flipt/internal/server/audit"
+	"go.uber.org/zap"
+)
+
+const sinkType = "object"
+
+// Writer abstracts writing a single object to a bucket in a cloud object
+// store, so that Sink can be tested without talking to a real S3 or GCS
+// endpoint.
+type Writer interface {
+	WriteObject(ctx context.Context, key string, body []byte) error
+}
+
+// Sink buffers audit events in memory as newline-delimited JSON and
+// periodically flushes the buffer as a single object to a bucket, via
+// Writer. Unlike the other sinks, flushing does not happen on every call to
+// SendAudits: events accumulate across calls and are only written out on
+// FlushPeriod, so that a bucket isn't flooded with one tiny object per audit
+// buffer flush.
+type Sink struct {
+	logger      *zap.Logger
+	writer      Writer
+	prefix      string
+	flushPeriod time.Duration
+
+	mtx sync.Mutex
+	buf bytes.Buffer
+	enc *json.Encoder
+	n   int
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSink constructs a Sink which writes batches of audit events to writer
+// using keys prefixed with prefix, every flushPeriod.
+func NewSink(logger *zap.Logger, writer Writer, prefix string, flushPeriod time.Duration) audit.Sink {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &Sink{
+		logger:      logger,
+		writer:      writer,
+		prefix:      prefix,
+		flushPeriod: flushPeriod,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+	s.enc = json.NewEncoder(&s.buf)
+
+	go s.run(ctx)
+
+	return s
+}
+
+func (s *Sink) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.flushPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.flush(context.Background()); err != nil {
+				s.logger.Error("failed to flush audit events to object store", zap.Error(err))
+			}
+			return
+		case <-ticker.C:
+			if err := s.flush(ctx); err != nil {
+				s.logger.Error("failed to flush audit events to object store", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *Sink) SendAudits(_ context.Context, events []audit.Event) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, e := range events {
+		if err := s.enc.Encode(e); err != nil {
+			return err
+		}
+
+		s.n++
+	}
+
+	return nil
+}
+
+func (s *Sink) flush(ctx context.Context) error {
+	s.mtx.Lock()
+	if s.n == 0 {
+		s.mtx.Unlock()
+		return nil
+	}
+
+	body := make([]byte, s.buf.Len())
+	copy(body, s.buf.Bytes())
+	s.buf.Reset()
+	s.n = 0
+	s.mtx.Unlock()
+
+	key := fmt.Sprintf("%s%s.jsonl", s.prefix, time.Now().UTC().Format("20060102T150405.000000000"))
+
+	return s.writer.WriteObject(ctx, key, body)
+}
+
+func (s *Sink) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+func (s *Sink) String() string {
+	return sinkType
+}
@@ -0,0 +1,69 @@
+package object
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	fliptconfig "go.flipt.io/flipt/internal/config"
+)
+
+// s3ClientAPI is the subset of the S3 client used by s3Writer, so it can be
+// faked in tests.
+type s3ClientAPI interface {
+	PutObject(context.Context, *s3.PutObjectInput, ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+}
+
+type s3Writer struct {
+	client s3ClientAPI
+	bucket string
+}
+
+// NewS3Writer constructs a Writer which uploads objects to the configured S3
+// bucket.
+func NewS3Writer(ctx context.Context, cfg fliptconfig.ObjectS3SinkConfig) (Writer, error) {
+	s3opts := make([]func(*config.LoadOptions) error, 0)
+	if cfg.Region != "" {
+		s3opts = append(s3opts, config.WithRegion(cfg.Region))
+	}
+
+	if cfg.Endpoint != "" {
+		customResolver := aws.EndpointResolverWithOptionsFunc(func(service, region string, options ...interface{}) (aws.Endpoint, error) {
+			if service == s3.ServiceID {
+				return aws.Endpoint{
+					PartitionID:       "aws",
+					URL:               cfg.Endpoint,
+					HostnameImmutable: true,
+					SigningRegion:     cfg.Region,
+				}, nil
+			}
+			return aws.Endpoint{}, fmt.Errorf("unknown endpoint requested")
+		})
+		s3opts = append(s3opts, config.WithEndpointResolverWithOptions(customResolver))
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(ctx, s3opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Writer{
+		client: s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+			o.UsePathStyle = cfg.Endpoint != ""
+		}),
+		bucket: cfg.Bucket,
+	}, nil
+}
+
+func (w *s3Writer) WriteObject(ctx context.Context, key string, body []byte) error {
+	_, err := w.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(w.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(body),
+	})
+
+	return err
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"go.flipt.io/flipt/internal/storage"
+	storageaudit "go.flipt.io/flipt/internal/storage/audit"
+	rpcaudit "go.flipt.io/flipt/rpc/flipt/audit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var _ rpcaudit.AuditServiceServer = &Server{}
+
+// Server serves the Flipt AuditService gRPC server, which allows a persisted
+// audit event log to be queried.
+type Server struct {
+	logger *zap.Logger
+	store  storageaudit.Store
+
+	rpcaudit.UnimplementedAuditServiceServer
+}
+
+// NewServer constructs a new Server.
+func NewServer(logger *zap.Logger, store storageaudit.Store) *Server {
+	return &Server{
+		logger: logger,
+		store:  store,
+	}
+}
+
+// RegisterGRPC registers the Server as an AuditServiceServer on the provided grpc server.
+func (s *Server) RegisterGRPC(server *grpc.Server) {
+	rpcaudit.RegisterAuditServiceServer(server, s)
+}
+
+// ListAuditEvents produces a page of persisted audit events for the provided filters and pagination parameters.
+func (s *Server) ListAuditEvents(ctx context.Context, r *rpcaudit.ListAuditEventsRequest) (*rpcaudit.ListAuditEventsResponse, error) {
+	req := &storage.ListRequest[storageaudit.ListEventsPredicate]{
+		QueryParams: storage.QueryParams{
+			Limit:     uint64(r.Limit),
+			PageToken: r.PageToken,
+		},
+	}
+
+	if r.Actor != "" {
+		req.Predicate.Actor = &r.Actor
+	}
+
+	if r.Action != "" {
+		req.Predicate.Action = &r.Action
+	}
+
+	if r.Type != "" {
+		req.Predicate.Type = &r.Type
+	}
+
+	if r.NamespaceKey != "" {
+		req.Predicate.NamespaceKey = &r.NamespaceKey
+	}
+
+	if r.Start != nil {
+		start := r.Start.AsTime()
+		req.Predicate.Start = &start
+	}
+
+	if r.End != nil {
+		end := r.End.AsTime()
+		req.Predicate.End = &end
+	}
+
+	results, err := s.store.ListEvents(ctx, req)
+	if err != nil {
+		s.logger.Error("listing audit events", zap.Error(err))
+
+		return nil, fmt.Errorf("listing audit events: %w", err)
+	}
+
+	return &rpcaudit.ListAuditEventsResponse{
+		Events:        results.Results,
+		NextPageToken: results.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/server/audit"
+	"go.uber.org/zap"
+)
+
+const sinkType = "slack"
+
+// Sink posts a formatted notification to a Slack or Mattermost incoming
+// webhook when a flag is toggled or a rollout changes in one of the
+// configured namespaces. Both Slack and Mattermost incoming webhooks accept
+// the same `{"text": "..."}` payload, so a single sink implementation works
+// for either.
+type Sink struct {
+	logger     *zap.Logger
+	webhookURL string
+	namespaces map[string]struct{}
+	retrier    audit.Retrier
+}
+
+// NewSink is the constructor for a Sink.
+func NewSink(logger *zap.Logger, cfg config.SlackSinkConfig) audit.Sink {
+	namespaces := make(map[string]struct{}, len(cfg.Namespaces))
+	for _, n := range cfg.Namespaces {
+		namespaces[n] = struct{}{}
+	}
+
+	return &Sink{
+		logger:     logger,
+		webhookURL: cfg.WebhookURL,
+		namespaces: namespaces,
+		retrier:    audit.NewRetrier(logger, 15*time.Second),
+	}
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// namespacedPayload describes the subset of fields common to the Flag and
+// Rollout audit payloads that are needed to filter by namespace and format a
+// notification.
+type namespacedPayload struct {
+	Key          string `json:"key"`
+	FlagKey      string `json:"flag_key"`
+	NamespaceKey string `json:"namespace_key"`
+	Enabled      bool   `json:"enabled"`
+}
+
+// SendAudits notifies the configured Slack/Mattermost webhook for any flag or
+// rollout event in one of the configured namespaces. Events for other
+// resource types, or namespaces outside the configured list, are ignored.
+func (s *Sink) SendAudits(ctx context.Context, events []audit.Event) error {
+	var result error
+
+	for _, e := range events {
+		if e.Type != audit.FlagType && e.Type != audit.RolloutType {
+			continue
+		}
+
+		b, err := json.Marshal(e.Payload)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		var payload namespacedPayload
+		if err := json.Unmarshal(b, &payload); err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		if len(s.namespaces) > 0 {
+			if _, ok := s.namespaces[payload.NamespaceKey]; !ok {
+				continue
+			}
+		}
+
+		body, err := json.Marshal(slackMessage{Text: formatMessage(e, payload)})
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		if err := s.retrier.RequestRetry(ctx, body, s.createRequest); err != nil {
+			s.logger.Error("failed to send slack notification", zap.Error(err))
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result
+}
+
+func (s *Sink) createRequest(ctx context.Context, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+func formatMessage(e audit.Event, payload namespacedPayload) string {
+	actor := "unknown"
+	if email, ok := e.Metadata.Actor["email"]; ok && email != "" {
+		actor = email
+	}
+
+	switch e.Type {
+	case audit.FlagType:
+		state := "disabled"
+		if payload.Enabled {
+			state = "enabled"
+		}
+
+		return fmt.Sprintf("Flag `%s` was %s in namespace `%s` by %s", payload.Key, state, payload.NamespaceKey, actor)
+	case audit.RolloutType:
+		return fmt.Sprintf("Rollout for flag `%s` was %s in namespace `%s` by %s", payload.FlagKey, e.Action, payload.NamespaceKey, actor)
+	default:
+		return fmt.Sprintf("%s %s in namespace `%s` by %s", payload.Key, e.Action, payload.NamespaceKey, actor)
+	}
+}
+
+func (s *Sink) Close() error {
+	return nil
+}
+
+func (s *Sink) String() string {
+	return sinkType
+}
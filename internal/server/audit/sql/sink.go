@@ -0,0 +1,87 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"go.flipt.io/flipt/internal/server/audit"
+	storageaudit "go.flipt.io/flipt/internal/storage/audit"
+	"go.uber.org/zap"
+)
+
+const sinkType = "sql"
+
+// Sink is a structure in charge of persisting audit events to a SQL database, so they
+// can be queried back out again through the AuditService.ListAuditEvents API.
+type Sink struct {
+	logger *zap.Logger
+	store  storageaudit.Store
+}
+
+// NewSink is the constructor for a Sink. It reuses the same database connection
+// configured for Flipt's primary storage (or, for non-database storage backends, a
+// dedicated connection established the same way authentication does).
+func NewSink(logger *zap.Logger, store storageaudit.Store) audit.Sink {
+	return &Sink{
+		logger: logger,
+		store:  store,
+	}
+}
+
+// SendAudits persists each event to the backing store.
+func (s *Sink) SendAudits(ctx context.Context, events []audit.Event) error {
+	var result error
+
+	for _, e := range events {
+		timestamp, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil {
+			s.logger.Error("failed to parse audit event timestamp", zap.Error(err))
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		if err := s.store.InsertEvent(ctx, &storageaudit.InsertEventRequest{
+			Type:         string(e.Type),
+			Action:       string(e.Action),
+			Actor:        e.Metadata.Actor,
+			NamespaceKey: namespaceKeyFromPayload(e.Payload),
+			Payload:      e.Payload,
+			Timestamp:    timestamp,
+		}); err != nil {
+			s.logger.Error("failed to persist audit event to database", zap.Error(err))
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result
+}
+
+// namespaceKeyFromPayload best-effort extracts a "namespace_key" field from an
+// audit event payload, so events can be filtered by namespace without every
+// payload type needing to implement a common interface.
+func namespaceKeyFromPayload(payload interface{}) string {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return ""
+	}
+
+	var v struct {
+		NamespaceKey string `json:"namespace_key"`
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return ""
+	}
+
+	return v.NamespaceKey
+}
+
+func (s *Sink) Close() error {
+	return nil
+}
+
+func (s *Sink) String() string {
+	return sinkType
+}
@@ -0,0 +1,154 @@
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"go.flipt.io/flipt/internal/server/audit"
+	"go.uber.org/zap"
+)
+
+const sinkType = "sse"
+
+// defaultBacklog bounds how many events are retained per namespace for
+// resume-from-id requests. Older events fall off the back of the buffer.
+const defaultBacklog = 100
+
+// subscriberBuffer bounds how far a slow subscriber can fall behind before
+// new events are dropped for it, rather than blocking SendAudits.
+const subscriberBuffer = 16
+
+// Event is an audit event as delivered to a subscriber, with the
+// monotonically increasing ID a client can resume a stream from.
+type Event struct {
+	ID    int64
+	Event audit.Event
+}
+
+// Hub is an audit.Sink that fans audit events out to live SSE subscribers,
+// keyed by namespace, and retains a short backlog per namespace so a
+// reconnecting client can resume from the last event ID it saw.
+type Hub struct {
+	logger *zap.Logger
+
+	mu          sync.Mutex
+	nextID      int64
+	backlog     map[string][]Event
+	subscribers map[string]map[chan Event]struct{}
+}
+
+// NewHub is the constructor for a Hub.
+func NewHub(logger *zap.Logger) *Hub {
+	return &Hub{
+		logger:      logger,
+		backlog:     make(map[string][]Event),
+		subscribers: make(map[string]map[chan Event]struct{}),
+	}
+}
+
+// SendAudits fans each event out to any subscriber of its namespace and
+// appends it to that namespace's backlog.
+func (h *Hub) SendAudits(_ context.Context, events []audit.Event) error {
+	for _, e := range events {
+		if !isFlagChange(e.Type) {
+			continue
+		}
+
+		namespace := namespaceOf(e)
+
+		h.mu.Lock()
+
+		se := Event{ID: h.nextID, Event: e}
+		h.nextID++
+
+		buf := append(h.backlog[namespace], se)
+		if len(buf) > defaultBacklog {
+			buf = buf[len(buf)-defaultBacklog:]
+		}
+		h.backlog[namespace] = buf
+
+		subs := make([]chan Event, 0, len(h.subscribers[namespace]))
+		for ch := range h.subscribers[namespace] {
+			subs = append(subs, ch)
+		}
+
+		h.mu.Unlock()
+
+		for _, ch := range subs {
+			select {
+			case ch <- se:
+			default:
+				h.logger.Warn("dropping sse event for slow subscriber", zap.String("namespace", namespace))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Subscribe registers a new subscriber for namespace, returning any
+// backlogged events with an ID greater than lastEventID, a channel that
+// delivers subsequent events, and a function to unsubscribe and release the
+// channel.
+func (h *Hub) Subscribe(namespace string, lastEventID int64) (backlog []Event, events <-chan Event, unsubscribe func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, se := range h.backlog[namespace] {
+		if se.ID > lastEventID {
+			backlog = append(backlog, se)
+		}
+	}
+
+	ch := make(chan Event, subscriberBuffer)
+	if h.subscribers[namespace] == nil {
+		h.subscribers[namespace] = make(map[chan Event]struct{})
+	}
+	h.subscribers[namespace][ch] = struct{}{}
+
+	return backlog, ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.subscribers[namespace], ch)
+		close(ch)
+	}
+}
+
+func (h *Hub) Close() error {
+	return nil
+}
+
+func (h *Hub) String() string {
+	return sinkType
+}
+
+func isFlagChange(t audit.Type) bool {
+	switch t {
+	case audit.FlagType, audit.VariantType, audit.SegmentType, audit.ConstraintType, audit.RolloutType, audit.RuleType, audit.DistributionType:
+		return true
+	default:
+		return false
+	}
+}
+
+// namespaceOf extracts the namespace key from an event's payload. All
+// namespaced audit payloads carry a "namespace_key" field, so this is
+// independent of the specific resource type.
+func namespaceOf(e audit.Event) string {
+	b, err := json.Marshal(e.Payload)
+	if err != nil {
+		return ""
+	}
+
+	var payload struct {
+		NamespaceKey string `json:"namespace_key"`
+	}
+
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return ""
+	}
+
+	return payload.NamespaceKey
+}
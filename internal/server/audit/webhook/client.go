@@ -28,6 +28,10 @@ type webhookClient struct {
 
 	maxBackoffDuration time.Duration
 
+	cloudEvents           bool
+	cloudEventsSource     string
+	cloudEventsTypePrefix string
+
 	retryableClient audit.Retrier
 }
 
@@ -72,6 +76,17 @@ func WithMaxBackoffDuration(maxBackoffDuration time.Duration) ClientOption {
 	}
 }
 
+// WithCloudEvents configures the client to wrap each event in a CloudEvents
+// 1.0 envelope, using source and typePrefix to populate its attributes,
+// before sending it to the webhook.
+func WithCloudEvents(source, typePrefix string) ClientOption {
+	return func(h *webhookClient) {
+		h.cloudEvents = true
+		h.cloudEventsSource = source
+		h.cloudEventsTypePrefix = typePrefix
+	}
+}
+
 func (w *webhookClient) createRequest(ctx context.Context, body []byte) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewBuffer(body))
 	if err != nil {
@@ -92,7 +107,17 @@ func (w *webhookClient) createRequest(ctx context.Context, body []byte) (*http.R
 
 // SendAudit will send an audit event to a configured server at a URL.
 func (w *webhookClient) SendAudit(ctx context.Context, e audit.Event) error {
-	body, err := json.Marshal(e)
+	var (
+		body []byte
+		err  error
+	)
+
+	if w.cloudEvents {
+		body, err = json.Marshal(audit.NewCloudEvent(e, w.cloudEventsSource, w.cloudEventsTypePrefix))
+	} else {
+		body, err = json.Marshal(e)
+	}
+
 	if err != nil {
 		return err
 	}
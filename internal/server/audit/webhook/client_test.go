@@ -18,6 +18,15 @@ func (d *dummyRetrier) RequestRetry(_ context.Context, _ []byte, _ audit.Request
 	return nil
 }
 
+type capturingRetrier struct {
+	body []byte
+}
+
+func (c *capturingRetrier) RequestRetry(_ context.Context, body []byte, _ audit.RequestCreator) error {
+	c.body = body
+	return nil
+}
+
 func TestConstructorWebhookClient(t *testing.T) {
 	client := NewWebhookClient(zap.NewNop(), "https://flipt-webhook.io/webhook", "")
 
@@ -46,6 +55,29 @@ func TestWebhookClient(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWebhookClient_cloudEvents(t *testing.T) {
+	retrier := &capturingRetrier{}
+	whclient := &webhookClient{
+		logger:                zap.NewNop(),
+		url:                   "https://flipt-webhook.io/webhook",
+		maxBackoffDuration:    15 * time.Second,
+		retryableClient:       retrier,
+		cloudEvents:           true,
+		cloudEventsSource:     "flipt",
+		cloudEventsTypePrefix: "io.flipt.event",
+	}
+
+	err := whclient.SendAudit(context.TODO(), audit.Event{
+		Type:      audit.FlagType,
+		Action:    audit.Create,
+		Timestamp: "2023-01-01T00:00:00Z",
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, string(retrier.body), `"specversion":"1.0"`)
+	assert.Contains(t, string(retrier.body), `"type":"io.flipt.event.flag.created"`)
+}
+
 func TestWebhookClient_createRequest(t *testing.T) {
 	whclient := &webhookClient{
 		logger:             zap.NewNop(),
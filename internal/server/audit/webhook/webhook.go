@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/hashicorp/go-multierror"
 	"go.flipt.io/flipt/internal/server/audit"
@@ -30,7 +31,14 @@ func (w *Sink) SendAudits(ctx context.Context, events []audit.Event) error {
 	for _, e := range events {
 		err := w.webhookClient.SendAudit(ctx, e)
 		if err != nil {
-			w.logger.Error("failed to send audit to webhook", zap.Error(err))
+			// deadLetter preserves the event that exhausted all its retries so it isn't
+			// silently dropped; operators can grep logs for it and replay the event by hand.
+			deadLetter, marshalErr := json.Marshal(e)
+			if marshalErr != nil {
+				w.logger.Error("failed to send audit to webhook", zap.Error(err))
+			} else {
+				w.logger.Error("failed to send audit to webhook, dropping event", zap.Error(err), zap.String("event", string(deadLetter)))
+			}
 			result = multierror.Append(result, err)
 		}
 	}
@@ -10,7 +10,9 @@ import (
 
 	"go.flipt.io/flipt/errors"
 	"go.flipt.io/flipt/internal/config"
+	serverauth "go.flipt.io/flipt/internal/server/auth"
 	"go.flipt.io/flipt/internal/server/auth/method"
+	"go.flipt.io/flipt/internal/server/audit"
 	storageauth "go.flipt.io/flipt/internal/storage/auth"
 	"go.flipt.io/flipt/rpc/flipt/auth"
 	"go.uber.org/zap"
@@ -20,10 +22,6 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const (
-	githubUserAPI = "https://api.github.com/user"
-)
-
 // OAuth2Client is our abstraction of communication with an OAuth2 Provider.
 type OAuth2Client interface {
 	AuthCodeURL(state string, opts ...oauth2.AuthCodeOption) string
@@ -44,27 +42,61 @@ type Server struct {
 	config       config.AuthenticationConfig
 	oauth2Config OAuth2Client
 
+	enableAuditLogging bool
+
 	auth.UnimplementedAuthenticationMethodGithubServiceServer
 }
 
+// Option is a type which configures a *Server
+type Option func(*Server)
+
+// WithAuditLoggingEnabled sets the option for enabling audit logging for login success/failure.
+func WithAuditLoggingEnabled(enabled bool) Option {
+	return func(s *Server) {
+		s.enableAuditLogging = enabled
+	}
+}
+
 // NewServer constructs a Server.
 func NewServer(
 	logger *zap.Logger,
 	store storageauth.Store,
 	config config.AuthenticationConfig,
+	opts ...Option,
 ) *Server {
-	return &Server{
+	s := &Server{
 		logger: logger,
 		store:  store,
 		config: config,
 		oauth2Config: &oauth2.Config{
 			ClientID:     config.Methods.Github.Method.ClientId,
 			ClientSecret: config.Methods.Github.Method.ClientSecret,
-			Endpoint:     oauth2GitHub.Endpoint,
+			Endpoint:     endpoint(config.Methods.Github.Method.ServerURL),
 			RedirectURL:  callbackURL(config.Methods.Github.Method.RedirectAddress),
 			Scopes:       config.Methods.Github.Method.Scopes,
 		},
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// endpoint builds the OAuth2 endpoint for the configured GitHub (Enterprise) server.
+// It returns the public github.com endpoint unless a non-default serverURL is provided,
+// in which case it derives the Enterprise "login/oauth" endpoints from it.
+func endpoint(serverURL string) oauth2.Endpoint {
+	serverURL = strings.TrimSuffix(serverURL, "/")
+	if serverURL == "" || serverURL == "https://github.com" {
+		return oauth2GitHub.Endpoint
+	}
+
+	return oauth2.Endpoint{
+		AuthURL:  serverURL + "/login/oauth/authorize",
+		TokenURL: serverURL + "/login/oauth/access_token",
+	}
 }
 
 // RegisterGRPC registers the server as an Server on the provided grpc server.
@@ -90,7 +122,24 @@ func (s *Server) AuthorizeURL(ctx context.Context, req *auth.AuthorizeURLRequest
 // Callback is the OAuth callback method for Github authentication. It will take in a Code
 // which is the OAuth grant passed in by the OAuth service, and exchange the grant with an Authentication
 // that includes the user information.
-func (s *Server) Callback(ctx context.Context, r *auth.CallbackRequest) (*auth.CallbackResponse, error) {
+func (s *Server) Callback(ctx context.Context, r *auth.CallbackRequest) (resp *auth.CallbackResponse, err error) {
+	if s.enableAuditLogging {
+		defer func() {
+			actor := serverauth.ActorFromContext(ctx)
+			if err != nil {
+				event := audit.NewEvent(audit.AuthenticationType, audit.Fail, actor, map[string]string{
+					"method": "github",
+					"reason": err.Error(),
+				})
+				event.AddToSpan(ctx)
+				return
+			}
+
+			event := audit.NewEvent(audit.AuthenticationType, audit.Create, actor, resp.Authentication.Metadata)
+			event.AddToSpan(ctx)
+		}()
+	}
+
 	if r.State != "" {
 		if err := method.CallbackValidateState(ctx, r.State); err != nil {
 			return nil, err
@@ -110,7 +159,9 @@ func (s *Server) Callback(ctx context.Context, r *auth.CallbackRequest) (*auth.C
 		Timeout: 5 * time.Second,
 	}
 
-	userReq, err := http.NewRequestWithContext(ctx, "GET", githubUserAPI, nil)
+	apiURL := strings.TrimSuffix(s.config.Methods.Github.Method.ApiURL, "/")
+
+	userReq, err := http.NewRequestWithContext(ctx, "GET", apiURL+"/user", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +183,7 @@ func (s *Server) Callback(ctx context.Context, r *auth.CallbackRequest) (*auth.C
 	}
 
 	var githubUserResponse struct {
+		Login     string `json:"login,omitempty"`
 		Name      string `json:"name,omitempty"`
 		Email     string `json:"email,omitempty"`
 		AvatarURL string `json:"avatar_url,omitempty"`
@@ -141,6 +193,15 @@ func (s *Server) Callback(ctx context.Context, r *auth.CallbackRequest) (*auth.C
 		return nil, err
 	}
 
+	authorized, err := s.authorized(ctx, c, apiURL, token.AccessToken, githubUserResponse.Login)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorized {
+		return nil, errors.ErrUnauthenticatedf("user %q is not a member of an allowed organization or team", githubUserResponse.Login)
+	}
+
 	metadata := map[string]string{
 		storageMetadataGithubEmail:   githubUserResponse.Email,
 		storageMetadataGithubName:    githubUserResponse.Name,
@@ -173,3 +234,71 @@ func (s *Server) Callback(ctx context.Context, r *auth.CallbackRequest) (*auth.C
 		Authentication: a,
 	}, nil
 }
+
+// authorized checks whether login is a member of one of the configured AllowedOrganizations
+// or a member of one of the configured AllowedTeams. If neither list is configured, every
+// authenticated GitHub user is allowed.
+func (s *Server) authorized(ctx context.Context, client *http.Client, apiURL, accessToken, login string) (bool, error) {
+	orgs := s.config.Methods.Github.Method.AllowedOrganizations
+	teams := s.config.Methods.Github.Method.AllowedTeams
+
+	if len(orgs) == 0 && len(teams) == 0 {
+		return true, nil
+	}
+
+	for _, org := range orgs {
+		member, err := s.membership(ctx, client, accessToken, fmt.Sprintf("%s/orgs/%s/members/%s", apiURL, org, login))
+		if err != nil {
+			return false, err
+		}
+
+		if member {
+			return true, nil
+		}
+	}
+
+	for _, team := range teams {
+		org, slug, ok := strings.Cut(team, "/")
+		if !ok {
+			return false, fmt.Errorf("allowed team %q must be in the form \"org/team-slug\"", team)
+		}
+
+		member, err := s.membership(ctx, client, accessToken, fmt.Sprintf("%s/orgs/%s/teams/%s/memberships/%s", apiURL, org, slug, login))
+		if err != nil {
+			return false, err
+		}
+
+		if member {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// membership performs a GET request against url and reports whether the response indicates
+// that the authenticated user is a member (GitHub returns 200/204 for members and 404 otherwise).
+func (s *Server) membership(ctx context.Context, client *http.Client, accessToken, url string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("github membership check response status: %q", resp.Status)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"go.flipt.io/flipt/rpc/flipt/auth"
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/oauth2"
+	oauth2GitHub "golang.org/x/oauth2/github"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
 )
@@ -84,6 +85,8 @@ func TestServer_Github(t *testing.T) {
 						ClientId:        "githubid",
 						RedirectAddress: "test.flipt.io",
 						Scopes:          []string{"user", "email"},
+						ServerURL:       "https://github.com",
+						ApiURL:          "https://api.github.com",
 					},
 				},
 			},
@@ -150,6 +153,88 @@ func TestServer_Github(t *testing.T) {
 	gock.Off()
 }
 
+func TestServer_Github_AllowedOrganizationsAndTeams(t *testing.T) {
+	var (
+		logger = zaptest.NewLogger(t)
+		store  = memory.NewStore()
+	)
+
+	s := &Server{
+		logger: logger,
+		store:  store,
+		config: config.AuthenticationConfig{
+			Methods: config.AuthenticationMethods{
+				Github: config.AuthenticationMethod[config.AuthenticationMethodGithubConfig]{
+					Enabled: true,
+					Method: config.AuthenticationMethodGithubConfig{
+						ClientSecret:         "topsecret",
+						ClientId:             "githubid",
+						RedirectAddress:      "test.flipt.io",
+						ApiURL:               "https://api.github.com",
+						AllowedOrganizations: []string{"flipt-io"},
+						AllowedTeams:         []string{"other-org/engineering"},
+					},
+				},
+			},
+		},
+		oauth2Config: &OAuth2Mock{},
+	}
+
+	defer gock.Off()
+
+	t.Run("member of allowed organization", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New("https://api.github.com").
+			Get("/orgs/flipt-io/members/fliptuser").
+			Reply(204)
+
+		ok, err := s.authorized(context.Background(), &http.Client{}, "https://api.github.com", "AccessToken", "fliptuser")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("member of allowed team", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New("https://api.github.com").
+			Get("/orgs/flipt-io/members/fliptuser").
+			Reply(404)
+		gock.New("https://api.github.com").
+			Get("/orgs/other-org/teams/engineering/memberships/fliptuser").
+			Reply(200)
+
+		ok, err := s.authorized(context.Background(), &http.Client{}, "https://api.github.com", "AccessToken", "fliptuser")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("member of neither", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New("https://api.github.com").
+			Get("/orgs/flipt-io/members/fliptuser").
+			Reply(404)
+		gock.New("https://api.github.com").
+			Get("/orgs/other-org/teams/engineering/memberships/fliptuser").
+			Reply(404)
+
+		ok, err := s.authorized(context.Background(), &http.Client{}, "https://api.github.com", "AccessToken", "fliptuser")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestEndpoint(t *testing.T) {
+	assert.Equal(t, oauth2.Endpoint{
+		AuthURL:  "https://github.example.com/login/oauth/authorize",
+		TokenURL: "https://github.example.com/login/oauth/access_token",
+	}, endpoint("https://github.example.com"))
+
+	assert.Equal(t, oauth2GitHub.Endpoint, endpoint(""))
+	assert.Equal(t, oauth2GitHub.Endpoint, endpoint("https://github.com"))
+}
+
 func TestCallbackURL(t *testing.T) {
 	callback := callbackURL("https://flipt.io")
 	assert.Equal(t, callback, "https://flipt.io/auth/v1/method/github/callback")
@@ -0,0 +1,116 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	coreoidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/config"
+	authjwt "go.flipt.io/flipt/internal/server/auth/method/jwt"
+	authrpc "go.flipt.io/flipt/rpc/flipt/auth"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_Verifier_Verify(t *testing.T) {
+	var (
+		logger = zaptest.NewLogger(t)
+		ctx    = context.Background()
+	)
+
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tp := oidc.StartTestProvider(
+		t,
+		oidc.WithTestDefaults(&oidc.TestProviderDefaults{
+			SigningKey: &oidc.TestSigningKey{
+				PrivKey: priv,
+				PubKey:  priv.Public(),
+				Alg:     oidc.RS256,
+			},
+		}),
+	)
+	defer tp.Stop()
+
+	verifier, err := authjwt.New(
+		coreoidc.ClientContext(ctx, tp.HTTPClient()),
+		logger,
+		config.AuthenticationMethodJWTConfig{
+			JWKSURL:  tp.Addr() + "/.well-known/jwks.json",
+			Issuer:   tp.Addr(),
+			Audience: "flipt",
+		},
+	)
+	require.NoError(t, err)
+
+	sign := func(claims map[string]any) string {
+		return oidc.TestSignJWT(t, priv, string(oidc.RS256), claims, nil)
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		token := sign(map[string]any{
+			"iss":            tp.Addr(),
+			"aud":            "flipt",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"sub":            "user-1",
+			"email":          "user@example.com",
+			"email_verified": true,
+			"name":           "Some User",
+		})
+
+		a, err := verifier.Verify(ctx, token)
+		require.NoError(t, err)
+
+		assert.Equal(t, authrpc.Method_METHOD_JWT, a.Method)
+		assert.Equal(t, map[string]string{
+			"io.flipt.auth.jwt.issuer":         tp.Addr(),
+			"io.flipt.auth.jwt.subject":        "user-1",
+			"io.flipt.auth.jwt.email":          "user@example.com",
+			"io.flipt.auth.jwt.email_verified": "true",
+			"io.flipt.auth.jwt.name":           "Some User",
+		}, a.Metadata)
+		assert.NotNil(t, a.ExpiresAt)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		token := sign(map[string]any{
+			"iss": tp.Addr(),
+			"aud": "flipt",
+			"exp": time.Now().Add(-time.Hour).Unix(),
+			"sub": "user-1",
+		})
+
+		_, err := verifier.Verify(ctx, token)
+		assert.Error(t, err)
+	})
+
+	t.Run("unexpected audience", func(t *testing.T) {
+		token := sign(map[string]any{
+			"iss": tp.Addr(),
+			"aud": "someone-else",
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"sub": "user-1",
+		})
+
+		_, err := verifier.Verify(ctx, token)
+		assert.Error(t, err)
+	})
+
+	t.Run("unexpected issuer", func(t *testing.T) {
+		token := sign(map[string]any{
+			"iss": "https://someone-else.example.com",
+			"aud": "flipt",
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"sub": "user-1",
+		})
+
+		_, err := verifier.Verify(ctx, token)
+		assert.Error(t, err)
+	})
+}
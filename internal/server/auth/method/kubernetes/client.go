@@ -0,0 +1,72 @@
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"go.flipt.io/flipt/internal/config"
+	"go.uber.org/zap"
+)
+
+// newAPIServerClient builds an *http.Client trusting only the cluster's
+// configured CA certificate and authenticating every request with Flipt's
+// own service account token, for talking to the local Kubernetes API
+// server (its OIDC discovery/JWKS endpoints or the TokenReview API).
+func newAPIServerClient(logger *zap.Logger, config config.AuthenticationMethodKubernetesConfig) (*http.Client, error) {
+	caCert, err := os.ReadFile(config.CAPath)
+	if err != nil {
+		logger.Error("reading CA certificate", zap.Error(err))
+
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to append cert from path: %q", config.CAPath)
+	}
+
+	// adapted from the Go net/http.DefaultTransport
+	// This transport only uses the configured CA certificate
+	// PEM found at the configured path on the filesystem.
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig: &tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+
+	return &http.Client{
+		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			// Re-evaluate token from disk per request, since it may have
+			// been refreshed by kubernetes since the last request.
+			token, err := os.ReadFile(config.ServiceAccountTokenPath)
+			if err != nil {
+				logger.Error("reading service account token", zap.Error(err))
+
+				return nil, fmt.Errorf("authenticating api server client: %w", err)
+			}
+
+			if _, ok := r.Header["Authorization"]; !ok {
+				r.Header["Authorization"] = []string{fmt.Sprintf("Bearer %s", token)}
+			}
+
+			return transport.RoundTrip(r)
+		}),
+	}, nil
+}
+
+type transportFunc func(*http.Request) (*http.Response, error)
+
+func (fn transportFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
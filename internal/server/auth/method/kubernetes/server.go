@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"time"
 
-	"go.flipt.io/flipt/internal/config"
+	fliptconfig "go.flipt.io/flipt/internal/config"
 	storageauth "go.flipt.io/flipt/internal/storage/auth"
 	"go.flipt.io/flipt/rpc/flipt/auth"
 	"go.uber.org/zap"
@@ -49,7 +49,7 @@ type tokenVerifier interface {
 type Server struct {
 	logger *zap.Logger
 	store  storageauth.Store
-	config config.AuthenticationConfig
+	config fliptconfig.AuthenticationConfig
 
 	verifier tokenVerifier
 
@@ -57,7 +57,7 @@ type Server struct {
 }
 
 // New constructs a new Server instance based on the provided logger, store and configuration.
-func New(logger *zap.Logger, store storageauth.Store, config config.AuthenticationConfig) (*Server, error) {
+func New(logger *zap.Logger, store storageauth.Store, config fliptconfig.AuthenticationConfig) (*Server, error) {
 	s := &Server{
 		logger: logger,
 		store:  store,
@@ -65,7 +65,15 @@ func New(logger *zap.Logger, store storageauth.Store, config config.Authenticati
 	}
 
 	var err error
-	s.verifier, err = newKubernetesOIDCVerifier(logger, config.Methods.Kubernetes.Method)
+
+	switch mode := config.Methods.Kubernetes.Method.Mode; mode {
+	case fliptconfig.KubernetesAuthenticationModeTokenReview:
+		s.verifier, err = newKubernetesTokenReviewVerifier(logger, config.Methods.Kubernetes.Method)
+	case "", fliptconfig.KubernetesAuthenticationModeOIDC:
+		s.verifier, err = newKubernetesOIDCVerifier(logger, config.Methods.Kubernetes.Method)
+	default:
+		err = fmt.Errorf("unknown kubernetes authentication mode %q", mode)
+	}
 
 	return s, err
 }
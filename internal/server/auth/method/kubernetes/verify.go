@@ -2,15 +2,11 @@ package kubernetes
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strings"
-	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"go.flipt.io/flipt/internal/config"
@@ -31,57 +27,12 @@ type kubernetesOIDCVerifier struct {
 
 func newKubernetesOIDCVerifier(logger *zap.Logger, config config.AuthenticationMethodKubernetesConfig) (*kubernetesOIDCVerifier, error) {
 	ctx := context.Background()
-	caCert, err := os.ReadFile(config.CAPath)
-	if err != nil {
-		logger.Error("reading CA certificate", zap.Error(err))
 
+	client, err := newAPIServerClient(logger, config)
+	if err != nil {
 		return nil, fmt.Errorf("building OIDC client: %w", err)
 	}
 
-	rootCAs := x509.NewCertPool()
-	if !rootCAs.AppendCertsFromPEM(caCert) {
-		return nil, fmt.Errorf("failed to append cert from path: %q", config.CAPath)
-	}
-
-	// adapted from the Go net/http.DefaultTransport
-	// This transport only uses the configured CA certificate
-	// PEM found at the configured path on the filesystem.
-	transport := &http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
-		ForceAttemptHTTP2:     true,
-		MaxIdleConns:          100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
-		TLSClientConfig: &tls.Config{
-			RootCAs:    rootCAs,
-			MinVersion: tls.VersionTLS12,
-		},
-	}
-
-	client := &http.Client{
-		Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
-			// Re-evaluate token from disk per request.
-			// This client will be used by the OIDC code to periodically fetch
-			// OIDC configuration and JWKS key chain from the k8s api-server.
-			// The OIDC wrapper handles caching that result.
-			// Each time it needs to request again we should re-read the SA token
-			// as it may have been refreshed by kubernetes.
-			token, err := os.ReadFile(config.ServiceAccountTokenPath)
-			if err != nil {
-				logger.Error("reading service account token", zap.Error(err))
-
-				return nil, fmt.Errorf("authentication OIDC client: %w", err)
-			}
-
-			if _, ok := r.Header["Authorization"]; !ok {
-				r.Header["Authorization"] = []string{fmt.Sprintf("Bearer %s", token)}
-			}
-
-			return transport.RoundTrip(r)
-		}),
-	}
-
 	// Kubernetes is not an OIDC / OAuth provider in the traditional sense
 	// and they go off-specification. The Issuer returned by the "well-known" endpoint
 	// does not match the supplied discovery URL.
@@ -131,12 +82,6 @@ func (k *kubernetesOIDCVerifier) verify(ctx context.Context, jwt string) (c clai
 	return
 }
 
-type transportFunc func(*http.Request) (*http.Response, error)
-
-func (fn transportFunc) RoundTrip(r *http.Request) (*http.Response, error) {
-	return fn(r)
-}
-
 func resolveTokenIssuer(ctx context.Context, client *http.Client, discoveryURL string) (string, error) {
 	req, err := http.NewRequestWithContext(
 		ctx,
@@ -0,0 +1,103 @@
+package mtls_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/config"
+	authmtls "go.flipt.io/flipt/internal/server/auth/method/mtls"
+	authrpc "go.flipt.io/flipt/rpc/flipt/auth"
+	"go.uber.org/zap/zaptest"
+)
+
+func selfSignedCert(t *testing.T, commonName string, dnsNames []string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func Test_Verifier_Verify(t *testing.T) {
+	var (
+		logger = zaptest.NewLogger(t)
+		ctx    = context.Background()
+	)
+
+	t.Run("no allow-list permits any certificate", func(t *testing.T) {
+		verifier := authmtls.New(logger, config.AuthenticationMethodMTLSConfig{})
+
+		cert := selfSignedCert(t, "flipt-client", nil)
+
+		a, err := verifier.Verify(ctx, []*x509.Certificate{cert})
+		require.NoError(t, err)
+		assert.Equal(t, authrpc.Method_METHOD_MTLS, a.Method)
+		assert.Equal(t, "flipt-client", a.Metadata["io.flipt.auth.mtls.common_name"])
+	})
+
+	t.Run("allowed subject", func(t *testing.T) {
+		verifier := authmtls.New(logger, config.AuthenticationMethodMTLSConfig{
+			AllowedSubjects: []string{"flipt-client"},
+		})
+
+		cert := selfSignedCert(t, "flipt-client", nil)
+
+		a, err := verifier.Verify(ctx, []*x509.Certificate{cert})
+		require.NoError(t, err)
+		assert.Equal(t, authrpc.Method_METHOD_MTLS, a.Method)
+	})
+
+	t.Run("allowed dns name", func(t *testing.T) {
+		verifier := authmtls.New(logger, config.AuthenticationMethodMTLSConfig{
+			AllowedDNSNames: []string{"flipt-client.internal"},
+		})
+
+		cert := selfSignedCert(t, "flipt-client", []string{"flipt-client.internal"})
+
+		a, err := verifier.Verify(ctx, []*x509.Certificate{cert})
+		require.NoError(t, err)
+		assert.Equal(t, "flipt-client.internal", a.Metadata["io.flipt.auth.mtls.dns_names"])
+	})
+
+	t.Run("certificate not in allow-list", func(t *testing.T) {
+		verifier := authmtls.New(logger, config.AuthenticationMethodMTLSConfig{
+			AllowedSubjects: []string{"someone-else"},
+		})
+
+		cert := selfSignedCert(t, "flipt-client", nil)
+
+		_, err := verifier.Verify(ctx, []*x509.Certificate{cert})
+		assert.EqualError(t, err, `verifying client certificate: "flipt-client" is not permitted`)
+	})
+
+	t.Run("no certificate presented", func(t *testing.T) {
+		verifier := authmtls.New(logger, config.AuthenticationMethodMTLSConfig{})
+
+		_, err := verifier.Verify(ctx, nil)
+		assert.EqualError(t, err, "verifying client certificate: no certificate presented")
+	})
+}
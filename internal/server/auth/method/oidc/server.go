@@ -10,7 +10,9 @@ import (
 	capoidc "github.com/hashicorp/cap/oidc"
 	"go.flipt.io/flipt/errors"
 	"go.flipt.io/flipt/internal/config"
+	serverauth "go.flipt.io/flipt/internal/server/auth"
 	"go.flipt.io/flipt/internal/server/auth/method"
+	"go.flipt.io/flipt/internal/server/audit"
 	storageauth "go.flipt.io/flipt/internal/storage/auth"
 	"go.flipt.io/flipt/rpc/flipt/auth"
 	"go.uber.org/zap"
@@ -56,19 +58,38 @@ type Server struct {
 	store  storageauth.Store
 	config config.AuthenticationConfig
 
+	enableAuditLogging bool
+
 	auth.UnimplementedAuthenticationMethodOIDCServiceServer
 }
 
+// Option is a type which configures a *Server
+type Option func(*Server)
+
+// WithAuditLoggingEnabled sets the option for enabling audit logging for login success/failure.
+func WithAuditLoggingEnabled(enabled bool) Option {
+	return func(s *Server) {
+		s.enableAuditLogging = enabled
+	}
+}
+
 func NewServer(
 	logger *zap.Logger,
 	store storageauth.Store,
 	config config.AuthenticationConfig,
+	opts ...Option,
 ) *Server {
-	return &Server{
+	s := &Server{
 		logger: logger,
 		store:  store,
 		config: config,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // RegisterGRPC registers the server as an Server on the provided grpc server.
@@ -104,11 +125,28 @@ func (s *Server) AuthorizeURL(ctx context.Context, req *auth.AuthorizeURLRequest
 // Once verified we extract the users associated email address.
 // Given all this completes successfully then we established an associated clientToken in
 // the backing authentication store with the identity information retrieved as metadata.
-func (s *Server) Callback(ctx context.Context, req *auth.CallbackRequest) (_ *auth.CallbackResponse, err error) {
+func (s *Server) Callback(ctx context.Context, req *auth.CallbackRequest) (resp *auth.CallbackResponse, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("handling OIDC callback: %w", err)
 		}
+
+		if !s.enableAuditLogging {
+			return
+		}
+
+		actor := serverauth.ActorFromContext(ctx)
+		if err != nil {
+			event := audit.NewEvent(audit.AuthenticationType, audit.Fail, actor, map[string]string{
+				"method": "oidc",
+				"reason": err.Error(),
+			})
+			event.AddToSpan(ctx)
+			return
+		}
+
+		event := audit.NewEvent(audit.AuthenticationType, audit.Create, actor, resp.Authentication.Metadata)
+		event.AddToSpan(ctx)
 	}()
 
 	if req.State != "" {
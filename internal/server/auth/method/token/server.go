@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/server/auth"
+	"go.flipt.io/flipt/internal/server/audit"
 	storageauth "go.flipt.io/flipt/internal/storage/auth"
-	"go.flipt.io/flipt/rpc/flipt/auth"
+	rpcauth "go.flipt.io/flipt/rpc/flipt/auth"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -15,48 +20,139 @@ const (
 	storageMetadataDescriptionKey = "io.flipt.auth.token.description"
 )
 
-// Server is an implementation of auth.AuthenticationMethodTokenServiceServer
+// Server is an implementation of rpcauth.AuthenticationMethodTokenServiceServer
 //
 // It is used to create static tokens within the backing AuthenticationStore.
 type Server struct {
 	logger *zap.Logger
 	store  storageauth.Store
-	auth.UnimplementedAuthenticationMethodTokenServiceServer
+
+	enableAuditLogging bool
+	authzCfg           config.AuthorizationConfig
+
+	rpcauth.UnimplementedAuthenticationMethodTokenServiceServer
+}
+
+// Option is a type which configures a *Server
+type Option func(*Server)
+
+// WithAuditLoggingEnabled sets the option for enabling audit logging for token creation and rotation.
+func WithAuditLoggingEnabled(enabled bool) Option {
+	return func(s *Server) {
+		s.enableAuditLogging = enabled
+	}
+}
+
+// WithAuthorizationConfig configures the authorization roles CreateToken
+// accepts and enforces, so that only a caller already holding the admin
+// role may mint a token with an elevated role assigned to it.
+func WithAuthorizationConfig(cfg config.AuthorizationConfig) Option {
+	return func(s *Server) {
+		s.authzCfg = cfg
+	}
 }
 
 // NewServer constructs and configures a new *Server.
-func NewServer(logger *zap.Logger, store storageauth.Store) *Server {
-	return &Server{
+func NewServer(logger *zap.Logger, store storageauth.Store, opts ...Option) *Server {
+	s := &Server{
 		logger: logger,
 		store:  store,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // RegisterGRPC registers the server as an Server on the provided grpc server.
 func (s *Server) RegisterGRPC(server *grpc.Server) {
-	auth.RegisterAuthenticationMethodTokenServiceServer(server, s)
+	rpcauth.RegisterAuthenticationMethodTokenServiceServer(server, s)
 }
 
 // CreateToken adapts and delegates the token request to the backing AuthenticationStore.
 //
-// Implicitly, the Authentication created will be of type auth.Method_TOKEN.
+// Implicitly, the Authentication created will be of type rpcauth.Method_TOKEN.
 // Name and Description are both stored in Authentication.Metadata.
+// If Role is set, it is validated against the configured authorization roles and, when
+// authorization is required, only honored if the caller itself already holds the admin
+// role; it is then stored under auth.RoleMetadataKey so auth.RoleInterceptor can enforce
+// it on the minted token's future requests.
 // Given the token is created successfully, the generate clientToken string is returned.
 // Along with the created Authentication, which includes it's identifier and associated timestamps.
-func (s *Server) CreateToken(ctx context.Context, req *auth.CreateTokenRequest) (*auth.CreateTokenResponse, error) {
+func (s *Server) CreateToken(ctx context.Context, req *rpcauth.CreateTokenRequest) (*rpcauth.CreateTokenResponse, error) {
+	metadata := map[string]string{
+		storageMetadataNameKey:        req.GetName(),
+		storageMetadataDescriptionKey: req.GetDescription(),
+	}
+
+	if role := req.GetRole(); role != "" {
+		if err := auth.ValidateRole(s.authzCfg, role); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role: %s", err)
+		}
+
+		if err := auth.RequireAdminRole(ctx, s.authzCfg); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "assigning a role: %s", err)
+		}
+
+		metadata[auth.RoleMetadataKey] = role
+	}
+
 	clientToken, authentication, err := s.store.CreateAuthentication(ctx, &storageauth.CreateAuthenticationRequest{
-		Method:    auth.Method_METHOD_TOKEN,
+		Method:    rpcauth.Method_METHOD_TOKEN,
 		ExpiresAt: req.ExpiresAt,
-		Metadata: map[string]string{
-			storageMetadataNameKey:        req.GetName(),
-			storageMetadataDescriptionKey: req.GetDescription(),
-		},
+		Metadata:  metadata,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("attempting to create token: %w", err)
 	}
 
-	return &auth.CreateTokenResponse{
+	if s.enableAuditLogging {
+		event := audit.NewEvent(audit.TokenType, audit.Create, auth.ActorFromContext(ctx), authentication.Metadata)
+		event.AddToSpan(ctx)
+	}
+
+	return &rpcauth.CreateTokenResponse{
+		ClientToken:    clientToken,
+		Authentication: authentication,
+	}, nil
+}
+
+// RotateToken issues a replacement client token for the one used to authenticate the
+// current request, carrying forward the same name and description metadata, then revokes
+// the original. The caller must be authenticated via the "token" method, since the token
+// being rotated is the one derived from the request context, not one named explicitly.
+func (s *Server) RotateToken(ctx context.Context, req *rpcauth.RotateTokenRequest) (*rpcauth.RotateTokenResponse, error) {
+	current := auth.GetAuthenticationFrom(ctx)
+	if current == nil || current.Method != rpcauth.Method_METHOD_TOKEN {
+		return nil, status.Error(codes.Unauthenticated, "request was not authenticated via the token method")
+	}
+
+	clientToken, authentication, err := s.store.CreateAuthentication(ctx, &storageauth.CreateAuthenticationRequest{
+		Method:    rpcauth.Method_METHOD_TOKEN,
+		ExpiresAt: req.ExpiresAt,
+		Metadata:  current.Metadata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("attempting to rotate token: %w", err)
+	}
+
+	if err := s.store.DeleteAuthentications(ctx, storageauth.Delete(storageauth.WithID(current.Id))); err != nil {
+		return nil, fmt.Errorf("attempting to revoke previous token: %w", err)
+	}
+
+	if s.enableAuditLogging {
+		actor := auth.ActorFromContext(ctx)
+
+		createdEvent := audit.NewEvent(audit.TokenType, audit.Create, actor, authentication.Metadata)
+		createdEvent.AddToSpan(ctx)
+
+		deletedEvent := audit.NewEvent(audit.TokenType, audit.Delete, actor, current.Metadata)
+		deletedEvent.AddToSpan(ctx)
+	}
+
+	return &rpcauth.RotateTokenResponse{
 		ClientToken:    clientToken,
 		Authentication: authentication,
 	}, nil
@@ -9,6 +9,8 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/config"
+	serverauth "go.flipt.io/flipt/internal/server/auth"
 	middleware "go.flipt.io/flipt/internal/server/middleware/grpc"
 	"go.flipt.io/flipt/internal/storage/auth/memory"
 	"go.flipt.io/flipt/rpc/flipt/auth"
@@ -96,3 +98,85 @@ func TestServer(t *testing.T) {
 	})
 	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "attempting to create token: invalid expiry time: nanos:-1"))
 }
+
+func TestServer_RotateToken(t *testing.T) {
+	var (
+		logger = zaptest.NewLogger(t)
+		store  = memory.NewStore()
+		server = NewServer(logger, store)
+		ctx    = context.Background()
+	)
+
+	// rotating without an authenticated context is rejected
+	_, err := server.RotateToken(ctx, &auth.RotateTokenRequest{})
+	require.ErrorIs(t, err, status.Error(codes.Unauthenticated, "request was not authenticated via the token method"))
+
+	created, err := server.CreateToken(ctx, &auth.CreateTokenRequest{
+		Name:        "access_all_areas",
+		Description: "Super secret skeleton key",
+	})
+	require.NoError(t, err)
+
+	rotated, err := server.RotateToken(
+		serverauth.ContextWithAuthentication(ctx, created.Authentication),
+		&auth.RotateTokenRequest{},
+	)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, created.ClientToken, rotated.ClientToken)
+	assert.Equal(t, created.Authentication.Metadata, rotated.Authentication.Metadata)
+
+	// the original token is no longer valid
+	_, err = store.GetAuthenticationByClientToken(ctx, created.ClientToken)
+	assert.Error(t, err)
+
+	// the rotated token can be used to fetch the new authentication
+	retrieved, err := store.GetAuthenticationByClientToken(ctx, rotated.ClientToken)
+	require.NoError(t, err)
+
+	if diff := cmp.Diff(retrieved, rotated.Authentication, protocmp.Transform()); diff != "" {
+		t.Errorf("-exp/+got:\n%s", diff)
+	}
+}
+
+func TestServer_CreateToken_Role(t *testing.T) {
+	var (
+		logger  = zaptest.NewLogger(t)
+		store   = memory.NewStore()
+		authCfg = config.AuthorizationConfig{Required: true}
+		server  = NewServer(logger, store, WithAuthorizationConfig(authCfg))
+		ctx     = context.Background()
+	)
+
+	// an unknown role is rejected outright
+	_, err := server.CreateToken(ctx, &auth.CreateTokenRequest{Name: "bad-role", Role: "superuser"})
+	require.ErrorContains(t, err, "invalid role")
+
+	// a request with no authentication in its context at all (e.g. the very
+	// first bootstrap-adjacent call) is allowed, same as RoleInterceptor's
+	// handling of an auth == nil context
+	_, err = server.CreateToken(ctx, &auth.CreateTokenRequest{Name: "viewer-token", Role: "viewer"})
+	require.NoError(t, err)
+
+	// an authentication with no role assigned is treated as admin, so it may
+	// assign roles to new tokens, same as RoleInterceptor's fallback
+	adminAuth := &auth.Authentication{Id: "admin", Method: auth.Method_METHOD_TOKEN}
+	created, err := server.CreateToken(serverauth.ContextWithAuthentication(ctx, adminAuth), &auth.CreateTokenRequest{
+		Name: "editor-token",
+		Role: "editor",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "editor", created.Authentication.Metadata[serverauth.RoleMetadataKey])
+
+	// a non-admin caller may not assign a role to a new token
+	viewerAuth := &auth.Authentication{
+		Id:       "viewer",
+		Method:   auth.Method_METHOD_TOKEN,
+		Metadata: map[string]string{serverauth.RoleMetadataKey: "viewer"},
+	}
+	_, err = server.CreateToken(serverauth.ContextWithAuthentication(ctx, viewerAuth), &auth.CreateTokenRequest{
+		Name: "escalated-token",
+		Role: "admin",
+	})
+	require.ErrorContains(t, err, "not permitted to assign roles")
+}
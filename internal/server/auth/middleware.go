@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"net/http"
 	"regexp"
@@ -13,7 +14,9 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -37,6 +40,22 @@ type Authenticator interface {
 	GetAuthenticationByClientToken(ctx context.Context, clientToken string) (*authrpc.Authentication, error)
 }
 
+// JWTVerifier validates a presented bearer credential as an externally-issued JWT and
+// returns the resulting Authentication. Unlike Authenticator, this doesn't perform a
+// lookup against a backing store: the JWT itself is the proof of authenticity, re-verified
+// on every request.
+type JWTVerifier interface {
+	Verify(ctx context.Context, token string) (*authrpc.Authentication, error)
+}
+
+// MTLSVerifier validates the client certificate presented during a mutual TLS handshake
+// and returns the resulting Authentication. Like JWTVerifier, this doesn't perform a
+// lookup against a backing store: the certificate presented on the connection is itself
+// re-verified on every request.
+type MTLSVerifier interface {
+	Verify(ctx context.Context, certs []*x509.Certificate) (*authrpc.Authentication, error)
+}
+
 // GetAuthenticationFrom is a utility for extracting an Authentication stored
 // on a context.Context instance
 func GetAuthenticationFrom(ctx context.Context) *authrpc.Authentication {
@@ -56,6 +75,8 @@ func ContextWithAuthentication(ctx context.Context, a *authrpc.Authentication) c
 // InterceptorOptions configure the UnaryInterceptor
 type InterceptorOptions struct {
 	skippedServers []any
+	jwtVerifier    JWTVerifier
+	mtlsVerifier   MTLSVerifier
 }
 
 func (o InterceptorOptions) skipped(server any) bool {
@@ -78,6 +99,33 @@ func WithServerSkipsAuthentication(server any) containers.Option[InterceptorOpti
 	}
 }
 
+// WithJWTVerifier configures the UnaryInterceptor to verify any presented bearer
+// credential which looks like a JWT directly, instead of looking it up as a Flipt
+// client token. This allows the "jwt" authentication method to authenticate requests
+// without the caller first exchanging its token for one.
+func WithJWTVerifier(verifier JWTVerifier) containers.Option[InterceptorOptions] {
+	return func(o *InterceptorOptions) {
+		o.jwtVerifier = verifier
+	}
+}
+
+// WithMTLSVerifier configures the UnaryInterceptor to authenticate callers by the client
+// certificate presented during the TLS handshake, instead of requiring a bearer credential.
+// This allows the "mtls" authentication method to authenticate requests based solely on
+// the identity established at the transport layer.
+func WithMTLSVerifier(verifier MTLSVerifier) containers.Option[InterceptorOptions] {
+	return func(o *InterceptorOptions) {
+		o.mtlsVerifier = verifier
+	}
+}
+
+// looksLikeJWT reports whether token is structured like a JWT (three dot-separated
+// segments), as opposed to a Flipt client token (a base64 encoded random value, which
+// never contains a ".").
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
 // UnaryInterceptor is a grpc.UnaryServerInterceptor which extracts a clientToken found
 // within the authorization field on the incoming requests metadata.
 // The fields value is expected to be in the form "Bearer <clientToken>".
@@ -92,6 +140,21 @@ func UnaryInterceptor(logger *zap.Logger, authenticator Authenticator, o ...cont
 			return handler(ctx, req)
 		}
 
+		if opts.mtlsVerifier != nil {
+			if certs := peerCertificatesFrom(ctx); len(certs) > 0 {
+				auth, err := opts.mtlsVerifier.Verify(ctx, certs)
+				if err != nil {
+					logger.Error("unauthenticated",
+						zap.String("reason", "mtls verification failed"),
+						zap.Error(err))
+
+					return ctx, errUnauthenticated
+				}
+
+				return handler(ContextWithAuthentication(ctx, auth), req)
+			}
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
 			logger.Error("unauthenticated", zap.String("reason", "metadata not found on context"))
@@ -107,23 +170,36 @@ func UnaryInterceptor(logger *zap.Logger, authenticator Authenticator, o ...cont
 			return ctx, errUnauthenticated
 		}
 
-		auth, err := authenticator.GetAuthenticationByClientToken(ctx, clientToken)
-		if err != nil {
-			logger.Error("unauthenticated",
-				zap.String("reason", "error retrieving authentication for client token"),
-				zap.Error(err))
+		var auth *authrpc.Authentication
 
-			if errors.Is(err, context.Canceled) {
-				err = status.Error(codes.Canceled, err.Error())
-				return ctx, err
-			}
+		if opts.jwtVerifier != nil && looksLikeJWT(clientToken) {
+			auth, err = opts.jwtVerifier.Verify(ctx, clientToken)
+			if err != nil {
+				logger.Error("unauthenticated",
+					zap.String("reason", "jwt verification failed"),
+					zap.Error(err))
 
-			if errors.Is(err, context.DeadlineExceeded) {
-				err = status.Error(codes.DeadlineExceeded, err.Error())
-				return ctx, err
+				return ctx, errUnauthenticated
+			}
+		} else {
+			auth, err = authenticator.GetAuthenticationByClientToken(ctx, clientToken)
+			if err != nil {
+				logger.Error("unauthenticated",
+					zap.String("reason", "error retrieving authentication for client token"),
+					zap.Error(err))
+
+				if errors.Is(err, context.Canceled) {
+					err = status.Error(codes.Canceled, err.Error())
+					return ctx, err
+				}
+
+				if errors.Is(err, context.DeadlineExceeded) {
+					err = status.Error(codes.DeadlineExceeded, err.Error())
+					return ctx, err
+				}
+
+				return ctx, errUnauthenticated
 			}
-
-			return ctx, errUnauthenticated
 		}
 
 		if auth.ExpiresAt != nil && auth.ExpiresAt.AsTime().Before(time.Now()) {
@@ -138,6 +214,63 @@ func UnaryInterceptor(logger *zap.Logger, authenticator Authenticator, o ...cont
 	}
 }
 
+// wrappedAuthStream overrides grpc.ServerStream's Context so a streaming
+// RPC observes whatever context a unary-style interceptor attached (e.g.
+// the resolved Authentication), and intercepts the first RecvMsg call so
+// that interceptor can run against the stream's initial request message.
+type wrappedAuthStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	unary   grpc.UnaryServerInterceptor
+	info    *grpc.UnaryServerInfo
+	checked bool
+}
+
+func (w *wrappedAuthStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *wrappedAuthStream) RecvMsg(m interface{}) error {
+	if err := w.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	// Flipt's streaming RPCs (WatchNamespace, WatchFlag, SyncFlags) are all
+	// server-streaming: the client sends exactly one request message before
+	// the server starts streaming responses, so a single check here covers
+	// the whole call.
+	if w.checked {
+		return nil
+	}
+	w.checked = true
+
+	_, err := w.unary(w.ctx, m, w.info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		w.ctx = ctx
+		return nil, nil
+	})
+
+	return err
+}
+
+// StreamInterceptor adapts a grpc.UnaryServerInterceptor into a
+// grpc.StreamServerInterceptor by running it against a streaming RPC's
+// initial request message the same way it would a unary call's single
+// request. This lets UnaryInterceptor, ScopeInterceptor, RoleInterceptor,
+// PolicyInterceptor and EmailMatchingInterceptor all guard streaming RPCs
+// too, without duplicating their logic.
+func StreamInterceptor(unary grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		w := &wrappedAuthStream{
+			ServerStream: ss,
+			ctx:          ss.Context(),
+			unary:        unary,
+			info:         &grpc.UnaryServerInfo{FullMethod: info.FullMethod, Server: srv},
+		}
+
+		return handler(srv, w)
+	}
+}
+
 // EmailMatchingInterceptor is a grpc.UnaryServerInterceptor only used in the case where the user is using OIDC
 // and wants to whitelist a group of users issuing operations against the Flipt server.
 func EmailMatchingInterceptor(logger *zap.Logger, rgxs []*regexp.Regexp) grpc.UnaryServerInterceptor {
@@ -176,6 +309,130 @@ func EmailMatchingInterceptor(logger *zap.Logger, rgxs []*regexp.Regexp) grpc.Un
 	}
 }
 
+// tokenMetadataScopeKey is set on the Authentication metadata of tokens bootstrapped
+// with a restricted scope. See storageauth.WithScope.
+const tokenMetadataScopeKey = "io.flipt.auth.token.scope"
+
+// evaluationOnlyMethods are the gRPC full methods permitted for authentications
+// scoped to "evaluate" (e.g. a bootstrap token created with Bootstrap.Scope set
+// to "evaluate").
+var evaluationOnlyMethods = map[string]struct{}{
+	"/flipt.Flipt/Evaluate":      {},
+	"/flipt.Flipt/BatchEvaluate": {},
+}
+
+// isEvaluationMethod reports whether fullMethod is one of the v1 evaluation
+// RPCs, or any method of the v2 evaluation service - the set of APIs every
+// scope, including "evaluate", is permitted to call.
+func isEvaluationMethod(fullMethod string) bool {
+	if _, ok := evaluationOnlyMethods[fullMethod]; ok {
+		return true
+	}
+
+	return strings.HasPrefix(fullMethod, "/flipt.evaluation.")
+}
+
+// tokenScope restricts the set of APIs an authentication created with a
+// token may call, independent of any role assigned to it (see
+// RoleInterceptor). Unlike a role, which an administrator assigns to a
+// known identity, a scope is fixed when the token itself is minted, so a
+// token handed to a third-party application or SDK can be constrained to
+// read-only or evaluation-only access before it ever leaves the building.
+type tokenScope string
+
+const (
+	tokenScopeAdmin    tokenScope = "admin"
+	tokenScopeWrite    tokenScope = "write"
+	tokenScopeRead     tokenScope = "read"
+	tokenScopeEvaluate tokenScope = "evaluate"
+)
+
+// normalizeScope maps the legacy "evaluation" scope value - the only
+// restricted scope prior to the introduction of "write" and "read" - onto
+// its "evaluate" replacement, so authentications bootstrapped before this
+// change keep working unchanged.
+func normalizeScope(raw string) tokenScope {
+	if raw == "evaluation" {
+		return tokenScopeEvaluate
+	}
+
+	return tokenScope(raw)
+}
+
+// ScopeInterceptor is a grpc.UnaryServerInterceptor which restricts authentications
+// created with a restricted scope ("write", "read" or "evaluate") to the subset of
+// APIs permitted for that scope.
+func ScopeInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		auth := GetAuthenticationFrom(ctx)
+
+		if auth == nil {
+			return handler(ctx, req)
+		}
+
+		raw, ok := auth.Metadata[tokenMetadataScopeKey]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		scope := normalizeScope(raw)
+		if scope == tokenScopeAdmin {
+			return handler(ctx, req)
+		}
+
+		if isEvaluationMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		deny := func(reason string) (context.Context, error) {
+			logger.Error("unauthenticated",
+				zap.String("reason", reason),
+				zap.String("scope", raw),
+				zap.String("method", info.FullMethod),
+			)
+			return ctx, errUnauthenticated
+		}
+
+		switch scope {
+		case tokenScopeEvaluate:
+			return deny("method not permitted for authentication scope")
+		case tokenScopeWrite, tokenScopeRead:
+			if _, adminOnly := namespaceOnlyMethods[info.FullMethod]; adminOnly {
+				return deny("method requires the admin scope")
+			}
+
+			allowed := actionRead
+			if scope == tokenScopeWrite {
+				allowed = actionWrite
+			}
+
+			if methodAction(info.FullMethod) > allowed {
+				return deny("method not permitted for authentication scope")
+			}
+		default:
+			return deny("unknown authentication scope")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// peerCertificatesFrom extracts the chain of client certificates presented during the
+// TLS handshake for the connection backing ctx, if any.
+func peerCertificatesFrom(ctx context.Context) []*x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+
+	return tlsInfo.State.PeerCertificates
+}
+
 func clientTokenFromMetadata(md metadata.MD) (string, error) {
 	if authenticationHeader := md.Get(authenticationHeaderKey); len(authenticationHeader) > 0 {
 		return clientTokenFromAuthorization(authenticationHeader[0])
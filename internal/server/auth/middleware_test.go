@@ -2,6 +2,10 @@ package auth
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"reflect"
 	"regexp"
 	"testing"
 	"time"
@@ -14,13 +18,56 @@ import (
 	authrpc "go.flipt.io/flipt/rpc/flipt/auth"
 	"go.uber.org/zap/zaptest"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // fakeserver is used to test skipping auth
 var fakeserver struct{}
 
+// jwtAuth is the Authentication returned by fakeJWTVerifier for a recognized token.
+var jwtAuth = &authrpc.Authentication{Method: authrpc.Method_METHOD_JWT}
+
+// fakeJWTVerifier is a JWTVerifier used to test the UnaryInterceptor's jwt handling
+// without depending on a real JWKS provider.
+type fakeJWTVerifier map[string]*authrpc.Authentication
+
+func (f fakeJWTVerifier) Verify(_ context.Context, token string) (*authrpc.Authentication, error) {
+	a, ok := f[token]
+	if !ok {
+		return nil, errors.New("jwt verification failed")
+	}
+
+	return a, nil
+}
+
+// mtlsAuth is the Authentication returned by fakeMTLSVerifier for a recognized certificate.
+var mtlsAuth = &authrpc.Authentication{Method: authrpc.Method_METHOD_MTLS}
+
+// fakeMTLSVerifier is an MTLSVerifier used to test the UnaryInterceptor's mtls handling
+// without depending on a real TLS listener.
+type fakeMTLSVerifier bool
+
+func (f fakeMTLSVerifier) Verify(_ context.Context, _ []*x509.Certificate) (*authrpc.Authentication, error) {
+	if !f {
+		return nil, errors.New("mtls verification failed")
+	}
+
+	return mtlsAuth, nil
+}
+
+// contextWithPeerCertificate returns a context carrying peer TLS connection state as
+// produced by a grpc.Server for an mTLS connection.
+func contextWithPeerCertificate(ctx context.Context, cert *x509.Certificate) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}},
+		},
+	})
+}
+
 func TestUnaryInterceptor(t *testing.T) {
 	authenticator := memory.NewStore()
 	clientToken, storedAuth, err := authenticator.CreateAuthentication(
@@ -42,6 +89,7 @@ func TestUnaryInterceptor(t *testing.T) {
 	for _, test := range []struct {
 		name         string
 		metadata     metadata.MD
+		peerCert     bool
 		server       any
 		options      []containers.Option[InterceptorOptions]
 		expectedErr  error
@@ -114,6 +162,62 @@ func TestUnaryInterceptor(t *testing.T) {
 			metadata:    nil,
 			expectedErr: errUnauthenticated,
 		},
+		{
+			name: "successful jwt verification",
+			metadata: metadata.MD{
+				"Authorization": []string{"Bearer some.valid.jwt"},
+			},
+			options: []containers.Option[InterceptorOptions]{
+				WithJWTVerifier(fakeJWTVerifier{"some.valid.jwt": jwtAuth}),
+			},
+			expectedAuth: jwtAuth,
+		},
+		{
+			name: "jwt verification failed",
+			metadata: metadata.MD{
+				"Authorization": []string{"Bearer some.invalid.jwt"},
+			},
+			options: []containers.Option[InterceptorOptions]{
+				WithJWTVerifier(fakeJWTVerifier{}),
+			},
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name: "client token not mistaken for a jwt",
+			metadata: metadata.MD{
+				"Authorization": []string{"Bearer " + clientToken},
+			},
+			options: []containers.Option[InterceptorOptions]{
+				WithJWTVerifier(fakeJWTVerifier{}),
+			},
+			expectedAuth: storedAuth,
+		},
+		{
+			name:     "successful mtls verification",
+			peerCert: true,
+			options: []containers.Option[InterceptorOptions]{
+				WithMTLSVerifier(fakeMTLSVerifier(true)),
+			},
+			expectedAuth: mtlsAuth,
+		},
+		{
+			name:     "mtls verification failed",
+			peerCert: true,
+			options: []containers.Option[InterceptorOptions]{
+				WithMTLSVerifier(fakeMTLSVerifier(false)),
+			},
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name: "mtls verifier configured but no certificate presented falls back to bearer token",
+			metadata: metadata.MD{
+				"Authorization": []string{"Bearer " + clientToken},
+			},
+			options: []containers.Option[InterceptorOptions]{
+				WithMTLSVerifier(fakeMTLSVerifier(true)),
+			},
+			expectedAuth: storedAuth,
+		},
 	} {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
@@ -133,6 +237,10 @@ func TestUnaryInterceptor(t *testing.T) {
 				ctx = metadata.NewIncomingContext(ctx, test.metadata)
 			}
 
+			if test.peerCert {
+				ctx = contextWithPeerCertificate(ctx, &x509.Certificate{})
+			}
+
 			_, err := UnaryInterceptor(logger, authenticator, test.options...)(
 				ctx,
 				nil,
@@ -282,3 +390,229 @@ func TestEmailMatchingInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func TestScopeInterceptor(t *testing.T) {
+	authenticator := memory.NewStore()
+
+	_, adminAuth, err := authenticator.CreateAuthentication(
+		context.TODO(),
+		&auth.CreateAuthenticationRequest{
+			Method:   authrpc.Method_METHOD_TOKEN,
+			Metadata: map[string]string{},
+		},
+	)
+	require.NoError(t, err)
+
+	_, evaluationAuth, err := authenticator.CreateAuthentication(
+		context.TODO(),
+		&auth.CreateAuthenticationRequest{
+			Method: authrpc.Method_METHOD_TOKEN,
+			Metadata: map[string]string{
+				"io.flipt.auth.token.scope": "evaluation",
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, writeAuth, err := authenticator.CreateAuthentication(
+		context.TODO(),
+		&auth.CreateAuthenticationRequest{
+			Method: authrpc.Method_METHOD_TOKEN,
+			Metadata: map[string]string{
+				"io.flipt.auth.token.scope": "write",
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, readAuth, err := authenticator.CreateAuthentication(
+		context.TODO(),
+		&auth.CreateAuthenticationRequest{
+			Method: authrpc.Method_METHOD_TOKEN,
+			Metadata: map[string]string{
+				"io.flipt.auth.token.scope": "read",
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name        string
+		auth        *authrpc.Authentication
+		fullMethod  string
+		expectedErr error
+	}{
+		{
+			name:       "unrestricted token may call any method",
+			auth:       adminAuth,
+			fullMethod: "/flipt.Flipt/UpdateFlag",
+		},
+		{
+			name:       "evaluation scoped token may call legacy evaluate",
+			auth:       evaluationAuth,
+			fullMethod: "/flipt.Flipt/Evaluate",
+		},
+		{
+			name:       "evaluation scoped token may call evaluation service",
+			auth:       evaluationAuth,
+			fullMethod: "/flipt.evaluation.EvaluationService/Boolean",
+		},
+		{
+			name:        "evaluation scoped token may not call management methods",
+			auth:        evaluationAuth,
+			fullMethod:  "/flipt.Flipt/UpdateFlag",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "read scoped token may call read methods",
+			auth:       readAuth,
+			fullMethod: "/flipt.Flipt/GetFlag",
+		},
+		{
+			name:       "read scoped token may call evaluation service",
+			auth:       readAuth,
+			fullMethod: "/flipt.evaluation.EvaluationService/Boolean",
+		},
+		{
+			name:        "read scoped token may not call write methods",
+			auth:        readAuth,
+			fullMethod:  "/flipt.Flipt/UpdateFlag",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:        "read scoped token may not call namespace management methods",
+			auth:        readAuth,
+			fullMethod:  "/flipt.Flipt/CreateNamespace",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "write scoped token may call read and write methods",
+			auth:       writeAuth,
+			fullMethod: "/flipt.Flipt/UpdateFlag",
+		},
+		{
+			name:        "write scoped token may not call namespace management methods",
+			auth:        writeAuth,
+			fullMethod:  "/flipt.Flipt/DeleteNamespace",
+			expectedErr: errUnauthenticated,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				logger  = zaptest.NewLogger(t)
+				ctx     = ContextWithAuthentication(context.Background(), test.auth)
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, nil
+				}
+			)
+
+			_, err := ScopeInterceptor(logger)(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: test.fullMethod},
+				handler,
+			)
+			require.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+// fakeServerStream is a minimal grpc.ServerStream used to exercise
+// StreamInterceptor without a real streaming RPC.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	msg any
+}
+
+func (f *fakeServerStream) Context() context.Context { return f.ctx }
+
+func (f *fakeServerStream) RecvMsg(m any) error {
+	reflect.ValueOf(m).Elem().Set(reflect.ValueOf(f.msg).Elem())
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	authenticator := memory.NewStore()
+	clientToken, storedAuth, err := authenticator.CreateAuthentication(
+		context.TODO(),
+		&auth.CreateAuthenticationRequest{Method: authrpc.Method_METHOD_TOKEN},
+	)
+	require.NoError(t, err)
+
+	logger := zaptest.NewLogger(t)
+	req := &authrpc.Authentication{}
+
+	for _, test := range []struct {
+		name        string
+		metadata    metadata.MD
+		fullMethod  string
+		expectedErr error
+	}{
+		{
+			name: "authenticated stream is allowed through with authentication attached to context",
+			metadata: metadata.MD{
+				"Authorization": []string{"Bearer " + clientToken},
+			},
+			fullMethod: "/flipt.watch.WatchService/WatchNamespace",
+		},
+		{
+			name:        "unauthenticated stream is rejected",
+			metadata:    metadata.MD{},
+			fullMethod:  "/flipt.watch.WatchService/WatchNamespace",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "authenticated WatchFlag stream is allowed through",
+			metadata:   metadata.MD{"Authorization": []string{"Bearer " + clientToken}},
+			fullMethod: "/flipt.watch.WatchService/WatchFlag",
+		},
+		{
+			name:        "unauthenticated WatchFlag stream is rejected",
+			metadata:    metadata.MD{},
+			fullMethod:  "/flipt.watch.WatchService/WatchFlag",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "authenticated SyncFlags stream is allowed through",
+			metadata:   metadata.MD{"Authorization": []string{"Bearer " + clientToken}},
+			fullMethod: "/flipt.sync.FlagSyncService/SyncFlags",
+		},
+		{
+			name:        "unauthenticated SyncFlags stream is rejected",
+			metadata:    metadata.MD{},
+			fullMethod:  "/flipt.sync.FlagSyncService/SyncFlags",
+			expectedErr: errUnauthenticated,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				ctx = metadata.NewIncomingContext(context.Background(), test.metadata)
+				ss  = &fakeServerStream{ctx: ctx, msg: req}
+			)
+
+			var gotCtx context.Context
+
+			handler := func(_ any, stream grpc.ServerStream) error {
+				err := stream.RecvMsg(req)
+				gotCtx = stream.Context()
+				return err
+			}
+
+			err := StreamInterceptor(UnaryInterceptor(logger, authenticator))(
+				nil,
+				ss,
+				&grpc.StreamServerInfo{FullMethod: test.fullMethod},
+				handler,
+			)
+
+			require.Equal(t, test.expectedErr, err)
+
+			if test.expectedErr == nil {
+				assert.Equal(t, storedAuth, GetAuthenticationFrom(gotCtx))
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.flipt.io/flipt/internal/config"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// PolicyInput is the document sent as input to the policy engine for every
+// management request. It deliberately mirrors the shape RoleInterceptor
+// already reasons about (subject, verb, resource, namespace) so the two
+// layers are easy to reconcile.
+type PolicyInput struct {
+	// Subject is the authentication ID of the caller, or "" if the request
+	// is unauthenticated.
+	Subject string `json:"subject"`
+	// Verb is the CRUD-style action being performed, e.g. "read", "create",
+	// "update" or "delete".
+	Verb string `json:"verb"`
+	// Resource is the kind of thing being acted on, derived from the gRPC
+	// method name, e.g. "Flag", "Segment", "Namespace".
+	Resource string `json:"resource"`
+	// Namespace is the namespace the request targets, if any.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PolicyEngine evaluates a PolicyInput and reports whether the request it
+// describes is permitted.
+type PolicyEngine interface {
+	Evaluate(ctx context.Context, input PolicyInput) (bool, error)
+}
+
+// OPAPolicyEngine evaluates requests against an external Open Policy Agent
+// server's Data API (https://www.openpolicyagent.org/docs/latest/rest-api/#data-api).
+// Flipt does not embed the OPA runtime itself; only this HTTP-backed mode
+// is currently supported.
+type OPAPolicyEngine struct {
+	url    string
+	client *http.Client
+}
+
+// NewOPAPolicyEngine constructs an OPAPolicyEngine which queries the policy
+// decision at url, e.g. "http://opa:8181/v1/data/flipt/authz/allow".
+func NewOPAPolicyEngine(url string) *OPAPolicyEngine {
+	return &OPAPolicyEngine{
+		url:    url,
+		client: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+type opaRequest struct {
+	Input PolicyInput `json:"input"`
+}
+
+type opaResponse struct {
+	Result *bool `json:"result"`
+}
+
+// Evaluate posts input to the configured OPA Data API endpoint and reports
+// the boolean decision. Any response other than an unambiguous `true`
+// (including a missing result, e.g. because the policy is undefined for
+// this input) is treated as a denial.
+func (e *OPAPolicyEngine) Evaluate(ctx context.Context, input PolicyInput) (bool, error) {
+	body, err := json.Marshal(opaRequest{Input: input})
+	if err != nil {
+		return false, fmt.Errorf("marshaling policy input: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("building policy request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("querying policy engine: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("policy engine returned status %d", resp.StatusCode)
+	}
+
+	var out opaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return false, fmt.Errorf("decoding policy response: %w", err)
+	}
+
+	return out.Result != nil && *out.Result, nil
+}
+
+// methodVerbs classifies the verb of a management method by the prefix of
+// its name, mirroring methodAction's classification in rbac.go but
+// expressed as the CRUD-style verbs a policy author is likely to write
+// rules against.
+var methodVerbs = []struct {
+	prefix string
+	verb   string
+}{
+	{"Get", "read"},
+	{"List", "read"},
+	{"Evaluate", "read"},
+	{"BatchEvaluate", "read"},
+	{"Create", "create"},
+	{"Update", "update"},
+	{"Order", "update"},
+	{"Delete", "delete"},
+}
+
+// classifyMethod splits a full gRPC method name into the verb and resource
+// a policy author would reason about, e.g. "/flipt.Flipt/CreateFlag"
+// becomes ("create", "Flag").
+func classifyMethod(fullMethod string) (verb, resource string) {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	for _, mv := range methodVerbs {
+		if strings.HasPrefix(name, mv.prefix) {
+			return mv.verb, strings.TrimPrefix(name, mv.prefix)
+		}
+	}
+
+	return "unknown", name
+}
+
+// PolicyInterceptor is a grpc.UnaryServerInterceptor which consults an
+// external OPA/Rego policy engine on every management request, in addition
+// to (not instead of) RoleInterceptor's RBAC checks. It is a no-op unless
+// policy enforcement has been marked as required in config.
+func PolicyInterceptor(logger *zap.Logger, cfg config.PolicyConfig, engine PolicyEngine) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// evaluation is always permitted once authenticated; policy, like
+		// roles, only restricts the management API surface.
+		if strings.HasPrefix(info.FullMethod, "/flipt.evaluation.") {
+			return handler(ctx, req)
+		}
+
+		var subject string
+		if a := GetAuthenticationFrom(ctx); a != nil {
+			subject = a.Id
+		}
+
+		verb, resource := classifyMethod(info.FullMethod)
+
+		var namespace string
+		if keyer, ok := req.(namespaceKeyer); ok {
+			namespace = keyer.GetNamespaceKey()
+		}
+
+		input := PolicyInput{
+			Subject:   subject,
+			Verb:      verb,
+			Resource:  resource,
+			Namespace: namespace,
+		}
+
+		allowed, err := engine.Evaluate(ctx, input)
+		if err != nil {
+			logger.Error("policy evaluation failed", zap.Error(err), zap.String("method", info.FullMethod))
+			return ctx, errUnauthenticated
+		}
+
+		if !allowed {
+			logger.Error("unauthenticated",
+				zap.String("reason", "denied by authorization policy"),
+				zap.String("subject", subject),
+				zap.String("verb", verb),
+				zap.String("resource", resource),
+				zap.String("namespace", namespace),
+			)
+			return ctx, errUnauthenticated
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/config"
+	flipt "go.flipt.io/flipt/rpc/flipt"
+	authrpc "go.flipt.io/flipt/rpc/flipt/auth"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+)
+
+type policyEngineFunc func(ctx context.Context, input PolicyInput) (bool, error)
+
+func (f policyEngineFunc) Evaluate(ctx context.Context, input PolicyInput) (bool, error) {
+	return f(ctx, input)
+}
+
+func TestClassifyMethod(t *testing.T) {
+	for _, test := range []struct {
+		fullMethod       string
+		expectedVerb     string
+		expectedResource string
+	}{
+		{"/flipt.Flipt/GetFlag", "read", "Flag"},
+		{"/flipt.Flipt/ListFlags", "read", "Flags"},
+		{"/flipt.Flipt/CreateFlag", "create", "Flag"},
+		{"/flipt.Flipt/UpdateFlag", "update", "Flag"},
+		{"/flipt.Flipt/DeleteFlag", "delete", "Flag"},
+		{"/flipt.Flipt/OrderRules", "update", "Rules"},
+	} {
+		verb, resource := classifyMethod(test.fullMethod)
+		require.Equal(t, test.expectedVerb, verb, test.fullMethod)
+		require.Equal(t, test.expectedResource, resource, test.fullMethod)
+	}
+}
+
+func TestPolicyInterceptor(t *testing.T) {
+	auth := &authrpc.Authentication{Id: "subject-1"}
+
+	for _, test := range []struct {
+		name        string
+		auth        *authrpc.Authentication
+		req         interface{}
+		fullMethod  string
+		engine      PolicyEngine
+		expectedErr error
+	}{
+		{
+			name:       "policy allows request",
+			auth:       auth,
+			req:        &flipt.UpdateFlagRequest{NamespaceKey: "default"},
+			fullMethod: "/flipt.Flipt/UpdateFlag",
+			engine:     policyEngineFunc(func(context.Context, PolicyInput) (bool, error) { return true, nil }),
+		},
+		{
+			name:        "policy denies request",
+			auth:        auth,
+			req:         &flipt.UpdateFlagRequest{NamespaceKey: "default"},
+			fullMethod:  "/flipt.Flipt/UpdateFlag",
+			engine:      policyEngineFunc(func(context.Context, PolicyInput) (bool, error) { return false, nil }),
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:        "policy evaluation error denies request",
+			auth:        auth,
+			req:         &flipt.UpdateFlagRequest{NamespaceKey: "default"},
+			fullMethod:  "/flipt.Flipt/UpdateFlag",
+			engine:      policyEngineFunc(func(context.Context, PolicyInput) (bool, error) { return false, errors.New("unreachable") }),
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "evaluation is always permitted",
+			auth:       auth,
+			req:        &flipt.EvaluationRequest{},
+			fullMethod: "/flipt.evaluation.EvaluationService/Boolean",
+			engine:     policyEngineFunc(func(context.Context, PolicyInput) (bool, error) { return false, nil }),
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				logger  = zaptest.NewLogger(t)
+				ctx     = ContextWithAuthentication(context.Background(), test.auth)
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, nil
+				}
+			)
+
+			_, err := PolicyInterceptor(logger, config.PolicyConfig{Required: true}, test.engine)(
+				ctx,
+				test.req,
+				&grpc.UnaryServerInfo{FullMethod: test.fullMethod},
+				handler,
+			)
+			require.Equal(t, test.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/storage/auth"
+	"go.flipt.io/flipt/internal/storage/auth/memory"
+	flipt "go.flipt.io/flipt/rpc/flipt"
+	authrpc "go.flipt.io/flipt/rpc/flipt/auth"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+)
+
+func TestRoleInterceptor(t *testing.T) {
+	authenticator := memory.NewStore()
+
+	newAuth := func(role string) *authrpc.Authentication {
+		metadata := map[string]string{}
+		if role != "" {
+			metadata[RoleMetadataKey] = role
+		}
+
+		_, a, err := authenticator.CreateAuthentication(
+			context.TODO(),
+			&auth.CreateAuthenticationRequest{
+				Method:   authrpc.Method_METHOD_TOKEN,
+				Metadata: metadata,
+			},
+		)
+		require.NoError(t, err)
+
+		return a
+	}
+
+	var (
+		unassignedAuth = newAuth("")
+		viewerAuth     = newAuth("viewer")
+		editorAuth     = newAuth("editor")
+		adminAuth      = newAuth("admin")
+		unknownAuth    = newAuth("does-not-exist")
+	)
+
+	cfg := config.AuthorizationConfig{
+		Required: true,
+		Roles: map[string]config.AuthorizationRole{
+			"team-a-editor": {Inherits: "editor", Namespace: "team-a"},
+		},
+	}
+	scopedAuth := newAuth("team-a-editor")
+
+	for _, test := range []struct {
+		name        string
+		auth        *authrpc.Authentication
+		req         interface{}
+		fullMethod  string
+		expectedErr error
+	}{
+		{
+			name:       "unassigned role may call any method",
+			auth:       unassignedAuth,
+			req:        &flipt.UpdateFlagRequest{},
+			fullMethod: "/flipt.Flipt/UpdateFlag",
+		},
+		{
+			name:       "viewer may read",
+			auth:       viewerAuth,
+			req:        &flipt.GetFlagRequest{},
+			fullMethod: "/flipt.Flipt/GetFlag",
+		},
+		{
+			name:        "viewer may not write",
+			auth:        viewerAuth,
+			req:         &flipt.UpdateFlagRequest{},
+			fullMethod:  "/flipt.Flipt/UpdateFlag",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "editor may write flags",
+			auth:       editorAuth,
+			req:        &flipt.UpdateFlagRequest{},
+			fullMethod: "/flipt.Flipt/UpdateFlag",
+		},
+		{
+			name:        "editor may not manage namespaces",
+			auth:        editorAuth,
+			req:         &flipt.CreateNamespaceRequest{},
+			fullMethod:  "/flipt.Flipt/CreateNamespace",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "admin may manage namespaces",
+			auth:       adminAuth,
+			req:        &flipt.CreateNamespaceRequest{},
+			fullMethod: "/flipt.Flipt/CreateNamespace",
+		},
+		{
+			name:        "unknown role is denied",
+			auth:        unknownAuth,
+			req:         &flipt.GetFlagRequest{},
+			fullMethod:  "/flipt.Flipt/GetFlag",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "namespace scoped custom role may write in its namespace",
+			auth:       scopedAuth,
+			req:        &flipt.UpdateFlagRequest{NamespaceKey: "team-a"},
+			fullMethod: "/flipt.Flipt/UpdateFlag",
+		},
+		{
+			name:        "namespace scoped custom role may not write in another namespace",
+			auth:        scopedAuth,
+			req:         &flipt.UpdateFlagRequest{NamespaceKey: "team-b"},
+			fullMethod:  "/flipt.Flipt/UpdateFlag",
+			expectedErr: errUnauthenticated,
+		},
+		{
+			name:       "evaluation is always permitted",
+			auth:       viewerAuth,
+			req:        &flipt.EvaluationRequest{},
+			fullMethod: "/flipt.evaluation.EvaluationService/Boolean",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				logger  = zaptest.NewLogger(t)
+				ctx     = ContextWithAuthentication(context.Background(), test.auth)
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, nil
+				}
+			)
+
+			_, err := RoleInterceptor(logger, cfg)(
+				ctx,
+				test.req,
+				&grpc.UnaryServerInfo{FullMethod: test.fullMethod},
+				handler,
+			)
+			require.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func TestValidateRole(t *testing.T) {
+	cfg := config.AuthorizationConfig{
+		Roles: map[string]config.AuthorizationRole{
+			"team-a-editor": {Inherits: "editor", Namespace: "team-a"},
+		},
+	}
+
+	require.NoError(t, ValidateRole(cfg, "admin"))
+	require.NoError(t, ValidateRole(cfg, "team-a-editor"))
+	require.Error(t, ValidateRole(cfg, "does-not-exist"))
+}
+
+func TestRequireAdminRole(t *testing.T) {
+	authenticator := memory.NewStore()
+
+	newAuth := func(role string) *authrpc.Authentication {
+		metadata := map[string]string{}
+		if role != "" {
+			metadata[RoleMetadataKey] = role
+		}
+
+		_, a, err := authenticator.CreateAuthentication(
+			context.TODO(),
+			&auth.CreateAuthenticationRequest{
+				Method:   authrpc.Method_METHOD_TOKEN,
+				Metadata: metadata,
+			},
+		)
+		require.NoError(t, err)
+
+		return a
+	}
+
+	cfg := config.AuthorizationConfig{Required: true}
+
+	require.NoError(t, RequireAdminRole(context.Background(), cfg), "no authentication in context at all")
+
+	editorCtx := ContextWithAuthentication(context.Background(), newAuth("editor"))
+	require.NoError(t, RequireAdminRole(editorCtx, config.AuthorizationConfig{Required: false}), "authorization not required")
+
+	ctx := ContextWithAuthentication(context.Background(), newAuth(""))
+	require.NoError(t, RequireAdminRole(ctx, cfg), "unassigned role is treated as admin")
+
+	ctx = ContextWithAuthentication(context.Background(), newAuth("admin"))
+	require.NoError(t, RequireAdminRole(ctx, cfg))
+
+	ctx = ContextWithAuthentication(context.Background(), newAuth("editor"))
+	require.Error(t, RequireAdminRole(ctx, cfg))
+
+	ctx = ContextWithAuthentication(context.Background(), newAuth("does-not-exist"))
+	require.Error(t, RequireAdminRole(ctx, cfg))
+}
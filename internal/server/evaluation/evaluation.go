@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"hash/crc32"
+	"strings"
 	"time"
 
 	errs "go.flipt.io/flipt/errors"
@@ -18,17 +19,41 @@ import (
 	"go.uber.org/zap"
 )
 
+// qualifiedFlagKeySeparator delimits a namespace from a flag key in a
+// qualified flag key (e.g. "platform/dark-launch"), letting a request made
+// against one namespace evaluate a flag defined in another namespace
+// instead. This is useful for platform-wide toggles that would otherwise
+// need to be duplicated into every namespace that wants to read them.
+//
+// Authorization of cross-namespace reads (e.g. restricting which
+// namespaces a token may qualify into) is left to the authorization layer;
+// this package only performs the resolution.
+const qualifiedFlagKeySeparator = "/"
+
+// resolveFlagKey splits a possibly-qualified flag key into the namespace
+// and flag key that should actually be looked up. If key does not contain
+// qualifiedFlagKeySeparator, namespaceKey is returned unchanged.
+func resolveFlagKey(namespaceKey, key string) (string, string) {
+	if ns, k, ok := strings.Cut(key, qualifiedFlagKeySeparator); ok && ns != "" && k != "" {
+		return ns, k
+	}
+
+	return namespaceKey, key
+}
+
 // Variant evaluates a request for a multi-variate flag and entity.
 // It adapts the 'v2' evaluation API and proxies the request to the 'v1' evaluation API.
 func (s *Server) Variant(ctx context.Context, r *rpcevaluation.EvaluationRequest) (*rpcevaluation.VariantEvaluationResponse, error) {
-	flag, err := s.store.GetFlag(ctx, r.NamespaceKey, r.FlagKey)
+	namespaceKey, flagKey := resolveFlagKey(r.NamespaceKey, r.FlagKey)
+
+	flag, err := s.store.GetFlag(ctx, namespaceKey, flagKey)
 	if err != nil {
 		return nil, err
 	}
 
 	s.logger.Debug("variant", zap.Stringer("request", r))
 
-	resp, err := s.variant(ctx, flag, r)
+	resp, err := s.variant(ctx, flag, r, namespaceKey)
 	if err != nil {
 		return nil, err
 	}
@@ -52,13 +77,13 @@ func (s *Server) Variant(ctx context.Context, r *rpcevaluation.EvaluationRequest
 	return resp, nil
 }
 
-func (s *Server) variant(ctx context.Context, flag *flipt.Flag, r *rpcevaluation.EvaluationRequest) (*rpcevaluation.VariantEvaluationResponse, error) {
+func (s *Server) variant(ctx context.Context, flag *flipt.Flag, r *rpcevaluation.EvaluationRequest, namespaceKey string) (*rpcevaluation.VariantEvaluationResponse, error) {
 	resp, err := s.evaluator.Evaluate(ctx, flag, &flipt.EvaluationRequest{
 		RequestId:    r.RequestId,
-		FlagKey:      r.FlagKey,
+		FlagKey:      flag.Key,
 		EntityId:     r.EntityId,
 		Context:      r.Context,
-		NamespaceKey: r.NamespaceKey,
+		NamespaceKey: namespaceKey,
 	})
 	if err != nil {
 		return nil, err
@@ -92,7 +117,9 @@ func (s *Server) variant(ctx context.Context, flag *flipt.Flag, r *rpcevaluation
 
 // Boolean evaluates a request for a boolean flag and entity.
 func (s *Server) Boolean(ctx context.Context, r *rpcevaluation.EvaluationRequest) (*rpcevaluation.BooleanEvaluationResponse, error) {
-	flag, err := s.store.GetFlag(ctx, r.NamespaceKey, r.FlagKey)
+	namespaceKey, flagKey := resolveFlagKey(r.NamespaceKey, r.FlagKey)
+
+	flag, err := s.store.GetFlag(ctx, namespaceKey, flagKey)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +130,7 @@ func (s *Server) Boolean(ctx context.Context, r *rpcevaluation.EvaluationRequest
 		return nil, errs.ErrInvalidf("flag type %s invalid", flag.Type)
 	}
 
-	resp, err := s.boolean(ctx, flag, r)
+	resp, err := s.boolean(ctx, flag, r, namespaceKey)
 	if err != nil {
 		return nil, err
 	}
@@ -125,8 +152,8 @@ func (s *Server) Boolean(ctx context.Context, r *rpcevaluation.EvaluationRequest
 	return resp, nil
 }
 
-func (s *Server) boolean(ctx context.Context, flag *flipt.Flag, r *rpcevaluation.EvaluationRequest) (*rpcevaluation.BooleanEvaluationResponse, error) {
-	rollouts, err := s.store.GetEvaluationRollouts(ctx, r.NamespaceKey, flag.Key)
+func (s *Server) boolean(ctx context.Context, flag *flipt.Flag, r *rpcevaluation.EvaluationRequest, namespaceKey string) (*rpcevaluation.BooleanEvaluationResponse, error) {
+	rollouts, err := s.store.GetEvaluationRollouts(ctx, namespaceKey, flag.Key)
 	if err != nil {
 		return nil, err
 	}
@@ -140,8 +167,8 @@ func (s *Server) boolean(ctx context.Context, flag *flipt.Flag, r *rpcevaluation
 
 	var (
 		startTime     = time.Now().UTC()
-		namespaceAttr = metrics.AttributeNamespace.String(r.NamespaceKey)
-		flagAttr      = metrics.AttributeFlag.String(r.FlagKey)
+		namespaceAttr = metrics.AttributeNamespace.String(namespaceKey)
+		flagAttr      = metrics.AttributeFlag.String(flag.Key)
 	)
 
 	metrics.EvaluationsTotal.Add(ctx, 1, metric.WithAttributeSet(attribute.NewSet(namespaceAttr, flagAttr)))
@@ -184,7 +211,7 @@ func (s *Server) boolean(ctx context.Context, flag *flipt.Flag, r *rpcevaluation
 
 		if rollout.Threshold != nil {
 			// consistent hashing based on the entity id and flag key.
-			hash := crc32.ChecksumIEEE([]byte(r.EntityId + r.FlagKey))
+			hash := crc32.ChecksumIEEE([]byte(r.EntityId + flag.Key))
 
 			normalizedValue := float32(int(hash) % 100)
 
@@ -252,7 +279,9 @@ func (s *Server) Batch(ctx context.Context, b *rpcevaluation.BatchEvaluationRequ
 	}
 
 	for _, req := range b.GetRequests() {
-		f, err := s.store.GetFlag(ctx, req.NamespaceKey, req.FlagKey)
+		namespaceKey, flagKey := resolveFlagKey(req.NamespaceKey, req.FlagKey)
+
+		f, err := s.store.GetFlag(ctx, namespaceKey, flagKey)
 		if err != nil {
 			var errnf errs.ErrNotFound
 			if errors.As(err, &errnf) {
@@ -276,7 +305,7 @@ func (s *Server) Batch(ctx context.Context, b *rpcevaluation.BatchEvaluationRequ
 
 		switch f.Type {
 		case flipt.FlagType_BOOLEAN_FLAG_TYPE:
-			res, err := s.boolean(ctx, f, req)
+			res, err := s.boolean(ctx, f, req, namespaceKey)
 			if err != nil {
 				return nil, err
 			}
@@ -290,7 +319,7 @@ func (s *Server) Batch(ctx context.Context, b *rpcevaluation.BatchEvaluationRequ
 
 			resp.Responses = append(resp.Responses, eresp)
 		case flipt.FlagType_VARIANT_FLAG_TYPE:
-			res, err := s.variant(ctx, f, req)
+			res, err := s.variant(ctx, f, req, namespaceKey)
 			if err != nil {
 				return nil, err
 			}
@@ -192,6 +192,34 @@ func TestVariant_Success(t *testing.T) {
 	assert.Equal(t, rpcevaluation.EvaluationReason_MATCH_EVALUATION_REASON, res.Reason)
 }
 
+func TestVariant_QualifiedFlagKey_CrossNamespace(t *testing.T) {
+	var (
+		flagKey      = "test-flag"
+		namespaceKey = "other-namespace"
+		store        = &evaluationStoreMock{}
+		logger       = zaptest.NewLogger(t)
+		s            = New(logger, store)
+		flag         = &flipt.Flag{
+			NamespaceKey: namespaceKey,
+			Key:          flagKey,
+			Enabled:      true,
+			Type:         flipt.FlagType_VARIANT_FLAG_TYPE,
+		}
+	)
+
+	store.On("GetFlag", mock.Anything, namespaceKey, flagKey).Return(flag, nil)
+	store.On("GetEvaluationRules", mock.Anything, namespaceKey, flagKey).Return([]*storage.EvaluationRule{}, nil)
+
+	res, err := s.Variant(context.TODO(), &rpcevaluation.EvaluationRequest{
+		FlagKey:      namespaceKey + "/" + flagKey,
+		EntityId:     "test-entity",
+		NamespaceKey: "test-namespace",
+	})
+
+	require.NoError(t, err)
+	assert.False(t, res.Match)
+}
+
 func TestBoolean_FlagNotFoundError(t *testing.T) {
 	var (
 		flagKey      = "test-flag"
@@ -155,11 +155,7 @@ func (e *Evaluator) Evaluate(ctx context.Context, flag *flipt.Flag, r *flipt.Eva
 			resp.SegmentKeys = segmentKeys
 		}
 
-		distributions, err := e.store.GetEvaluationDistributions(ctx, rule.ID)
-		if err != nil {
-			resp.Reason = flipt.EvaluationReason_ERROR_EVALUATION_REASON
-			return resp, err
-		}
+		distributions := rule.Distributions
 
 		var (
 			validDistributions []*storage.EvaluationDistribution
@@ -993,52 +993,6 @@ func TestEvaluator_ErrorParsingDateTime(t *testing.T) {
 	assert.Equal(t, flipt.EvaluationReason_ERROR_EVALUATION_REASON, resp.Reason)
 }
 
-func TestEvaluator_ErrorGettingDistributions(t *testing.T) {
-	var (
-		store  = &evaluationStoreMock{}
-		logger = zaptest.NewLogger(t)
-		s      = NewEvaluator(logger, store)
-	)
-
-	store.On("GetEvaluationRules", mock.Anything, mock.Anything, "foo").Return(
-		[]*storage.EvaluationRule{
-			{
-				ID:      "1",
-				FlagKey: "foo",
-				Rank:    0,
-				Segments: map[string]*storage.EvaluationSegment{
-					"bar": {
-						SegmentKey: "bar",
-						MatchType:  flipt.MatchType_ALL_MATCH_TYPE,
-						Constraints: []storage.EvaluationConstraint{
-							{
-								ID:       "2",
-								Type:     flipt.ComparisonType_STRING_COMPARISON_TYPE,
-								Property: "bar",
-								Operator: flipt.OpEQ,
-								Value:    "baz",
-							},
-						},
-					},
-				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return([]*storage.EvaluationDistribution{}, errors.New("error getting distributions!"))
-
-	resp, err := s.Evaluate(context.TODO(), enabledFlag, &flipt.EvaluationRequest{
-		EntityId: "1",
-		FlagKey:  "foo",
-		Context: map[string]string{
-			"bar": "baz",
-		},
-	})
-
-	assert.Error(t, err)
-	assert.False(t, resp.Match)
-	assert.Equal(t, flipt.EvaluationReason_ERROR_EVALUATION_REASON, resp.Reason)
-}
-
 // Match ALL constraints
 func TestEvaluator_MatchAll_NoVariants_NoDistributions(t *testing.T) {
 	var (
@@ -1068,11 +1022,10 @@ func TestEvaluator_MatchAll_NoVariants_NoDistributions(t *testing.T) {
 						},
 					},
 				},
+				Distributions: []*storage.EvaluationDistribution{},
 			},
 		}, nil)
 
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return([]*storage.EvaluationDistribution{}, nil)
-
 	tests := []struct {
 		name      string
 		req       *flipt.EvaluationRequest
@@ -1170,11 +1123,10 @@ func TestEvaluator_MatchAll_MultipleSegments(t *testing.T) {
 						},
 					},
 				},
+				Distributions: []*storage.EvaluationDistribution{},
 			},
 		}, nil)
 
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return([]*storage.EvaluationDistribution{}, nil)
-
 	tests := []struct {
 		name      string
 		req       *flipt.EvaluationRequest
@@ -1270,18 +1222,16 @@ func TestEvaluator_DistributionNotMatched(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:                "4",
-				RuleID:            "1",
-				VariantID:         "5",
-				Rollout:           10,
-				VariantKey:        "boz",
-				VariantAttachment: `{"key":"value"}`,
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:                "4",
+						RuleID:            "1",
+						VariantID:         "5",
+						Rollout:           10,
+						VariantKey:        "boz",
+						VariantAttachment: `{"key":"value"}`,
+					},
+				},
 			},
 		}, nil)
 
@@ -1337,18 +1287,16 @@ func TestEvaluator_MatchAll_SingleVariantDistribution(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:                "4",
-				RuleID:            "1",
-				VariantID:         "5",
-				Rollout:           100,
-				VariantKey:        "boz",
-				VariantAttachment: `{"key":"value"}`,
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:                "4",
+						RuleID:            "1",
+						VariantID:         "5",
+						Rollout:           100,
+						VariantKey:        "boz",
+						VariantAttachment: `{"key":"value"}`,
+					},
+				},
 			},
 		}, nil)
 
@@ -1459,24 +1407,22 @@ func TestEvaluator_MatchAll_RolloutDistribution(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "4",
-				RuleID:     "1",
-				VariantID:  "5",
-				Rollout:    50,
-				VariantKey: "boz",
-			},
-			{
-				ID:         "6",
-				RuleID:     "1",
-				VariantID:  "7",
-				Rollout:    50,
-				VariantKey: "booz",
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "4",
+						RuleID:     "1",
+						VariantID:  "5",
+						Rollout:    50,
+						VariantKey: "boz",
+					},
+					{
+						ID:         "6",
+						RuleID:     "1",
+						VariantID:  "7",
+						Rollout:    50,
+						VariantKey: "booz",
+					},
+				},
 			},
 		}, nil)
 
@@ -1578,6 +1524,22 @@ func TestEvaluator_MatchAll_RolloutDistribution_MultiRule(t *testing.T) {
 						},
 					},
 				},
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "4",
+						RuleID:     "1",
+						VariantID:  "5",
+						Rollout:    50,
+						VariantKey: "released",
+					},
+					{
+						ID:         "6",
+						RuleID:     "1",
+						VariantID:  "7",
+						Rollout:    50,
+						VariantKey: "unreleased",
+					},
+				},
 			},
 			{
 				ID:      "2",
@@ -1592,24 +1554,6 @@ func TestEvaluator_MatchAll_RolloutDistribution_MultiRule(t *testing.T) {
 			},
 		}, nil)
 
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "4",
-				RuleID:     "1",
-				VariantID:  "5",
-				Rollout:    50,
-				VariantKey: "released",
-			},
-			{
-				ID:         "6",
-				RuleID:     "1",
-				VariantID:  "7",
-				Rollout:    50,
-				VariantKey: "unreleased",
-			},
-		}, nil)
-
 	resp, err := s.Evaluate(context.TODO(), enabledFlag, &flipt.EvaluationRequest{
 		FlagKey:  "foo",
 		EntityId: uuid.Must(uuid.NewV4()).String(),
@@ -1647,24 +1591,22 @@ func TestEvaluator_MatchAll_NoConstraints(t *testing.T) {
 						MatchType:  flipt.MatchType_ALL_MATCH_TYPE,
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "4",
-				RuleID:     "1",
-				VariantID:  "5",
-				Rollout:    50,
-				VariantKey: "boz",
-			},
-			{
-				ID:         "6",
-				RuleID:     "1",
-				VariantID:  "7",
-				Rollout:    50,
-				VariantKey: "moz",
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "4",
+						RuleID:     "1",
+						VariantID:  "5",
+						Rollout:    50,
+						VariantKey: "boz",
+					},
+					{
+						ID:         "6",
+						RuleID:     "1",
+						VariantID:  "7",
+						Rollout:    50,
+						VariantKey: "moz",
+					},
+				},
 			},
 		}, nil)
 
@@ -1766,11 +1708,10 @@ func TestEvaluator_MatchAny_NoVariants_NoDistributions(t *testing.T) {
 						},
 					},
 				},
+				Distributions: []*storage.EvaluationDistribution{},
 			},
 		}, nil)
 
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return([]*storage.EvaluationDistribution{}, nil)
-
 	tests := []struct {
 		name      string
 		req       *flipt.EvaluationRequest
@@ -1862,17 +1803,15 @@ func TestEvaluator_MatchAny_SingleVariantDistribution(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "4",
-				RuleID:     "1",
-				VariantID:  "5",
-				Rollout:    100,
-				VariantKey: "boz",
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "4",
+						RuleID:     "1",
+						VariantID:  "5",
+						Rollout:    100,
+						VariantKey: "boz",
+					},
+				},
 			},
 		}, nil)
 
@@ -2016,24 +1955,22 @@ func TestEvaluator_MatchAny_RolloutDistribution(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "4",
-				RuleID:     "1",
-				VariantID:  "5",
-				Rollout:    50,
-				VariantKey: "boz",
-			},
-			{
-				ID:         "6",
-				RuleID:     "1",
-				VariantID:  "7",
-				Rollout:    50,
-				VariantKey: "booz",
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "4",
+						RuleID:     "1",
+						VariantID:  "5",
+						Rollout:    50,
+						VariantKey: "boz",
+					},
+					{
+						ID:         "6",
+						RuleID:     "1",
+						VariantID:  "7",
+						Rollout:    50,
+						VariantKey: "booz",
+					},
+				},
 			},
 		}, nil)
 
@@ -2135,6 +2072,22 @@ func TestEvaluator_MatchAny_RolloutDistribution_MultiRule(t *testing.T) {
 						},
 					},
 				},
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "4",
+						RuleID:     "1",
+						VariantID:  "5",
+						Rollout:    50,
+						VariantKey: "released",
+					},
+					{
+						ID:         "6",
+						RuleID:     "1",
+						VariantID:  "7",
+						Rollout:    50,
+						VariantKey: "unreleased",
+					},
+				},
 			},
 			{
 				ID:      "2",
@@ -2158,24 +2111,6 @@ func TestEvaluator_MatchAny_RolloutDistribution_MultiRule(t *testing.T) {
 			},
 		}, nil)
 
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "4",
-				RuleID:     "1",
-				VariantID:  "5",
-				Rollout:    50,
-				VariantKey: "released",
-			},
-			{
-				ID:         "6",
-				RuleID:     "1",
-				VariantID:  "7",
-				Rollout:    50,
-				VariantKey: "unreleased",
-			},
-		}, nil)
-
 	resp, err := s.Evaluate(context.TODO(), enabledFlag, &flipt.EvaluationRequest{
 		FlagKey:  "foo",
 		EntityId: uuid.Must(uuid.NewV4()).String(),
@@ -2213,24 +2148,22 @@ func TestEvaluator_MatchAny_NoConstraints(t *testing.T) {
 						MatchType:  flipt.MatchType_ANY_MATCH_TYPE,
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "4",
-				RuleID:     "1",
-				VariantID:  "5",
-				Rollout:    50,
-				VariantKey: "boz",
-			},
-			{
-				ID:         "6",
-				RuleID:     "1",
-				VariantID:  "7",
-				Rollout:    50,
-				VariantKey: "moz",
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "4",
+						RuleID:     "1",
+						VariantID:  "5",
+						Rollout:    50,
+						VariantKey: "boz",
+					},
+					{
+						ID:         "6",
+						RuleID:     "1",
+						VariantID:  "7",
+						Rollout:    50,
+						VariantKey: "moz",
+					},
+				},
 			},
 		}, nil)
 
@@ -2333,24 +2266,22 @@ func TestEvaluator_FirstRolloutRuleIsZero(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "4",
-				RuleID:     "1",
-				VariantID:  "5",
-				Rollout:    0,
-				VariantKey: "boz",
-			},
-			{
-				ID:         "6",
-				RuleID:     "1",
-				VariantID:  "7",
-				Rollout:    100,
-				VariantKey: "booz",
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "4",
+						RuleID:     "1",
+						VariantID:  "5",
+						Rollout:    0,
+						VariantKey: "boz",
+					},
+					{
+						ID:         "6",
+						RuleID:     "1",
+						VariantID:  "7",
+						Rollout:    100,
+						VariantKey: "booz",
+					},
+				},
 			},
 		}, nil)
 
@@ -2432,52 +2363,50 @@ func TestEvaluator_MultipleZeroRolloutDistributions(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:         "1",
-				RuleID:     "1",
-				VariantID:  "1",
-				VariantKey: "1",
-				Rollout:    0,
-			},
-			{
-				ID:         "2",
-				RuleID:     "1",
-				VariantID:  "2",
-				VariantKey: "2",
-				Rollout:    0,
-			},
-			{
-				ID:         "3",
-				RuleID:     "1",
-				VariantID:  "3",
-				VariantKey: "3",
-				Rollout:    50,
-			},
-			{
-				ID:         "4",
-				RuleID:     "4",
-				VariantID:  "4",
-				VariantKey: "4",
-				Rollout:    0,
-			},
-			{
-				ID:         "5",
-				RuleID:     "1",
-				VariantID:  "5",
-				VariantKey: "5",
-				Rollout:    0,
-			},
-			{
-				ID:         "6",
-				RuleID:     "1",
-				VariantID:  "6",
-				VariantKey: "6",
-				Rollout:    50,
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:         "1",
+						RuleID:     "1",
+						VariantID:  "1",
+						VariantKey: "1",
+						Rollout:    0,
+					},
+					{
+						ID:         "2",
+						RuleID:     "1",
+						VariantID:  "2",
+						VariantKey: "2",
+						Rollout:    0,
+					},
+					{
+						ID:         "3",
+						RuleID:     "1",
+						VariantID:  "3",
+						VariantKey: "3",
+						Rollout:    50,
+					},
+					{
+						ID:         "4",
+						RuleID:     "4",
+						VariantID:  "4",
+						VariantKey: "4",
+						Rollout:    0,
+					},
+					{
+						ID:         "5",
+						RuleID:     "1",
+						VariantID:  "5",
+						VariantKey: "5",
+						Rollout:    0,
+					},
+					{
+						ID:         "6",
+						RuleID:     "1",
+						VariantID:  "6",
+						VariantKey: "6",
+						Rollout:    50,
+					},
+				},
 			},
 		}, nil)
 
@@ -0,0 +1,124 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often a Store's background sweep evicts expired
+// entries, bounding the map's size between requests instead of only
+// evicting lazily as keys happen to be looked up again. It's a var rather
+// than a const so tests can shorten it.
+var sweepInterval = time.Minute
+
+// Store is a short-lived, in-memory mapping of idempotency keys to the
+// marshalled response produced the first time that key was seen. It is
+// used to deduplicate retried requests from automation without changing
+// the result they observe.
+type Store struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]entry
+
+	done   chan struct{}
+	cancel func()
+}
+
+type entry struct {
+	value   []byte
+	expires time.Time
+}
+
+// New constructs a Store whose entries are retained for the given ttl.
+// Run must be called to start evicting expired entries in the background;
+// until then, entries only expire lazily as they're looked up.
+func New(ttl time.Duration) *Store {
+	return &Store{
+		ttl:     ttl,
+		entries: make(map[string]entry),
+		cancel:  func() {},
+		done:    make(chan struct{}),
+	}
+}
+
+// Get returns the value previously stored under key, if any, and whether
+// it was found and has not yet expired.
+func (s *Store) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(e.expires) {
+		delete(s.entries, key)
+		return nil, false
+	}
+
+	return e.value, true
+}
+
+// Put stores value under key, to be retained until the store's ttl elapses.
+func (s *Store) Put(key string, value []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = entry{
+		value:   value,
+		expires: time.Now().Add(s.ttl),
+	}
+}
+
+// Run starts a background goroutine that periodically evicts expired
+// entries, until ctx is cancelled or Shutdown is called. Without it,
+// keys that are never looked up again (e.g. a retried request that never
+// retries again) would never be evicted, growing the store unbounded. It
+// returns immediately; the sweep loop runs in the background.
+func (s *Store) Run(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(sweepInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.sweep()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Shutdown signals the sweep loop to stop and waits for it to finish.
+func (s *Store) Shutdown(ctx context.Context) error {
+	s.cancel()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+		return nil
+	}
+}
+
+// sweep evicts every entry that has expired as of now.
+func (s *Store) sweep() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for key, e := range s.entries {
+		if now.After(e.expires) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_GetPut(t *testing.T) {
+	s := New(time.Minute)
+
+	_, ok := s.Get("missing")
+	assert.False(t, ok)
+
+	s.Put("key", []byte("value"))
+
+	got, ok := s.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, []byte("value"), got)
+}
+
+func TestStore_GetExpired(t *testing.T) {
+	s := New(time.Millisecond)
+
+	s.Put("key", []byte("value"))
+	time.Sleep(10 * time.Millisecond)
+
+	_, ok := s.Get("key")
+	assert.False(t, ok, "expired entry should not be returned")
+}
+
+func TestStore_RunSweepsExpiredEntries(t *testing.T) {
+	old := sweepInterval
+	sweepInterval = 10 * time.Millisecond
+	t.Cleanup(func() { sweepInterval = old })
+
+	s := New(time.Millisecond)
+	s.Put("key", []byte("value"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	s.Run(ctx)
+	t.Cleanup(func() { require.NoError(t, s.Shutdown(context.Background())) })
+
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		_, ok := s.entries["key"]
+		return !ok
+	}, time.Second, 10*time.Millisecond, "expired entry should have been swept")
+}
+
+func TestStore_Shutdown(t *testing.T) {
+	s := New(time.Minute)
+	s.Run(context.Background())
+
+	require.NoError(t, s.Shutdown(context.Background()))
+}
+
+func TestStore_ShutdownContextCancelled(t *testing.T) {
+	s := New(time.Minute)
+	s.Run(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the sweep loop is still running (never cancelled), so Shutdown must
+	// respect ctx's own deadline rather than blocking on it.
+	err := s.Shutdown(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	require.NoError(t, s.Shutdown(context.Background()))
+}
@@ -0,0 +1,88 @@
+package loadshed
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter sheds excess load by admitting at most a dynamically adjusted
+// number of concurrent requests, rejecting the rest immediately rather
+// than queueing them. The limit rises by one (additive increase) after
+// each request that completes under the target latency, and backs off
+// multiplicatively whenever a request exceeds it, so the admitted
+// concurrency tracks what the server can currently serve without its
+// latency degrading, rather than a fixed number picked up front.
+type Limiter struct {
+	min    float64
+	max    float64
+	target time.Duration
+
+	mu       sync.Mutex
+	limit    float64
+	inFlight int64
+}
+
+// New constructs a Limiter that starts at min, never exceeds max, and
+// backs off whenever a request's latency exceeds target.
+func New(min, max int, target time.Duration) *Limiter {
+	return &Limiter{
+		min:    float64(min),
+		max:    float64(max),
+		target: target,
+		limit:  float64(min),
+	}
+}
+
+// Allow attempts to admit a request. It returns false immediately if the
+// server is already serving at its current concurrency limit, in which
+// case the caller should shed the request rather than serve it. Otherwise
+// it returns a done func the caller must call exactly once, with the
+// request's latency, when the request finishes.
+func (l *Limiter) Allow() (done func(latency time.Duration), ok bool) {
+	l.mu.Lock()
+	if float64(l.inFlight) >= l.limit {
+		l.mu.Unlock()
+		return nil, false
+	}
+
+	l.inFlight++
+	l.mu.Unlock()
+
+	return func(latency time.Duration) { l.finish(latency) }, true
+}
+
+func (l *Limiter) finish(latency time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+
+	if latency > l.target {
+		// multiplicative decrease: back off hard so a burst of slow
+		// requests doesn't keep admitting more work than can be served.
+		l.limit -= l.limit * 0.5
+	} else {
+		// additive increase: only grow once requests are using most of
+		// the current allowance, so the limit doesn't run away during a
+		// lull.
+		if float64(l.inFlight) >= l.limit-1 {
+			l.limit++
+		}
+	}
+
+	if l.limit < l.min {
+		l.limit = l.min
+	}
+
+	if l.limit > l.max {
+		l.limit = l.max
+	}
+}
+
+// Limit returns the current admitted concurrency, for observability.
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return int(l.limit)
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 
 	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/containers"
 	"go.flipt.io/flipt/internal/info"
+	"go.flipt.io/flipt/internal/storage/fs"
 	"go.flipt.io/flipt/rpc/flipt/meta"
 	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc"
@@ -14,17 +16,42 @@ import (
 )
 
 type Server struct {
-	cfg  *config.Config
-	info info.Flipt
+	cfg            *config.Config
+	info           info.Flipt
+	warnings       []string
+	snapshotStatus fs.SnapshotStatusReporter
 
 	meta.UnimplementedMetadataServiceServer
 }
 
-func NewServer(cfg *config.Config, info info.Flipt) *Server {
-	return &Server{
+// WithWarnings sets the deprecation (and other) warnings produced while
+// loading cfg, so they can be surfaced alongside it from GetConfiguration.
+func WithWarnings(warnings []string) containers.Option[Server] {
+	return func(s *Server) {
+		s.warnings = warnings
+	}
+}
+
+// WithSnapshotStatus configures the reporter used to surface the outcome of
+// the most recent declarative snapshot build (e.g. git, object, local
+// storage) from GetConfiguration. It is a no-op if reporter is nil, which
+// is the case for storage backends which don't build snapshots from a
+// declarative source (e.g. the database backend).
+func WithSnapshotStatus(reporter fs.SnapshotStatusReporter) containers.Option[Server] {
+	return func(s *Server) {
+		s.snapshotStatus = reporter
+	}
+}
+
+func NewServer(cfg *config.Config, info info.Flipt, opts ...containers.Option[Server]) *Server {
+	s := &Server{
 		cfg:  cfg,
 		info: info,
 	}
+
+	containers.ApplyAll(s, opts...)
+
+	return s
 }
 
 // RegisterGRPC registers the server on the provided gRPC server instance.
@@ -32,10 +59,30 @@ func (s *Server) RegisterGRPC(server *grpc.Server) {
 	meta.RegisterMetadataServiceServer(server, s)
 }
 
+// configuration wraps the resolved Config alongside any warnings produced
+// while loading it (e.g. deprecation notices) and, when the configured
+// storage backend builds its snapshot from a declarative source, the
+// outcome of the most recent attempt to do so, so operators and CI can
+// verify what a running instance is actually using (and serving) via
+// GetConfiguration.
+type configuration struct {
+	*config.Config
+	Warnings       []string           `json:"warnings,omitempty"`
+	SnapshotStatus *fs.SnapshotStatus `json:"snapshotStatus,omitempty"`
+}
+
 // GetConfiguration returns a HttpBody instance containing the Flipt instance's
-// configuration structure marshalled as JSON.
+// effective configuration structure (secrets redacted) plus any warnings
+// produced while loading it, marshalled as JSON.
 func (s *Server) GetConfiguration(ctx context.Context, _ *emptypb.Empty) (*httpbody.HttpBody, error) {
-	return response(ctx, s.cfg)
+	cfg := configuration{Config: s.cfg, Warnings: s.warnings}
+
+	if s.snapshotStatus != nil {
+		status := s.snapshotStatus.SnapshotStatus()
+		cfg.SnapshotStatus = &status
+	}
+
+	return response(ctx, cfg)
 }
 
 // GetInfo returns a HttpBody instance containing the Flipt instance's
@@ -21,6 +21,13 @@ var (
 			prometheus.BuildFQName(namespace, serverSubsystem, "errors"),
 			metric.WithDescription("The total number of server errors"),
 		)
+
+	// RateLimitedTotal is the total number of requests rejected due to rate limiting
+	RateLimitedTotal = metrics.MustInt64().
+				Counter(
+			prometheus.BuildFQName(namespace, serverSubsystem, "rate_limited"),
+			metric.WithDescription("The total number of requests rejected due to rate limiting"),
+		)
 )
 
 // Evaluation specific metrics
@@ -53,6 +60,14 @@ var (
 		metric.WithUnit("ms"),
 	)
 
+	// LoadSheddedTotal is the total number of evaluation requests rejected
+	// by the load shedder because the server was saturated
+	LoadSheddedTotal = metrics.MustInt64().
+				Counter(
+			prometheus.BuildFQName(namespace, evaluationsSubsystem, "load_shedded"),
+			metric.WithDescription("The total number of evaluation requests rejected due to load shedding"),
+		)
+
 	// Attributes used in evaluation metrics
 	//nolint
 	AttributeMatch     = attribute.Key("match")
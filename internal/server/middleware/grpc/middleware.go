@@ -5,14 +5,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	errs "go.flipt.io/flipt/errors"
 	"go.flipt.io/flipt/internal/cache"
+	"go.flipt.io/flipt/internal/config"
 	"go.flipt.io/flipt/internal/server/audit"
 	"go.flipt.io/flipt/internal/server/auth"
+	"go.flipt.io/flipt/internal/server/idempotency"
+	"go.flipt.io/flipt/internal/server/loadshed"
 	"go.flipt.io/flipt/internal/server/metrics"
+	"go.flipt.io/flipt/internal/server/ratelimit"
+	"go.flipt.io/flipt/internal/server/requestid"
 	flipt "go.flipt.io/flipt/rpc/flipt"
 	fauth "go.flipt.io/flipt/rpc/flipt/auth"
 	"go.flipt.io/flipt/rpc/flipt/evaluation"
@@ -20,6 +27,8 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
@@ -74,6 +83,234 @@ func ErrorUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnarySe
 	return
 }
 
+// RequestIDUnaryInterceptor attaches a request ID to the context for the
+// lifetime of the request, honoring an incoming x-request-id metadata value
+// where present and generating one otherwise. The ID is tagged for logging
+// via grpc_ctxtags and echoed back to the caller as a response header.
+func RequestIDUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := requestid.New()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(requestid.MetadataKey); len(v) > 0 && v[0] != "" {
+			id = v[0]
+		}
+	}
+
+	ctx = requestid.NewContext(ctx, id)
+	grpc_ctxtags.Extract(ctx).Set("request_id", id)
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(requestid.MetadataKey, id)); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// rateLimitKey identifies the caller for the purposes of rate limiting,
+// preferring the authenticated token ID and falling back to the client's
+// peer address so unauthenticated requests are still limited per-IP.
+func rateLimitKey(ctx context.Context) string {
+	if a := auth.GetAuthenticationFrom(ctx); a != nil && a.Id != "" {
+		return "token:" + a.Id
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return "ip:" + p.Addr.String()
+	}
+
+	return "unknown"
+}
+
+// RateLimitUnaryInterceptor rejects requests once the caller has exceeded the
+// configured requests-per-second/burst, identifying callers by authenticated
+// token where present and by client IP otherwise.
+func RateLimitUnaryInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !limiter.Allow(rateLimitKey(ctx)) {
+			metrics.RateLimitedTotal.Add(ctx, 1)
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamInterceptor applies the same rate limiting as
+// RateLimitUnaryInterceptor to streaming RPCs.
+func RateLimitStreamInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		if !limiter.Allow(rateLimitKey(ctx)) {
+			metrics.RateLimitedTotal.Add(ctx, 1)
+			return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// isEvaluationMethod reports whether fullMethod is one of the evaluation
+// RPCs, covering both the current evaluation.EvaluationService and the
+// deprecated Flipt.Evaluate/BatchEvaluate methods.
+func isEvaluationMethod(fullMethod string) bool {
+	if strings.HasPrefix(fullMethod, "/flipt.evaluation.EvaluationService/") {
+		return true
+	}
+
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	return strings.HasPrefix(fullMethod, "/flipt.Flipt/") &&
+		(name == "Evaluate" || name == "BatchEvaluate")
+}
+
+// LoadSheddingUnaryInterceptor rejects evaluation requests once the server
+// is serving at its currently admitted concurrency, so that the traffic
+// which is served keeps its latency instead of every request slowing down
+// under overload. Non-evaluation methods are unaffected.
+func LoadSheddingUnaryInterceptor(limiter *loadshed.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isEvaluationMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		done, ok := limiter.Allow()
+		if !ok {
+			metrics.LoadSheddedTotal.Add(ctx, 1)
+			return nil, status.Error(codes.ResourceExhausted, "server is overloaded, please retry")
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		done(time.Since(start))
+
+		return resp, err
+	}
+}
+
+// isMutatingMethod reports whether a management method name denotes a
+// mutation rather than a read, so it can be rejected for a read-only
+// namespace.
+func isMutatingMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	switch {
+	case strings.HasPrefix(name, "Get"),
+		strings.HasPrefix(name, "List"),
+		strings.HasPrefix(name, "Evaluate"),
+		strings.HasPrefix(name, "BatchEvaluate"):
+		return false
+	default:
+		return true
+	}
+}
+
+// ReadOnlyNamespaceUnaryInterceptor rejects mutating requests that target a
+// namespace configured as read-only (see config.StorageConfig.ReadOnlyNamespaces),
+// so individual namespaces can be frozen - e.g. production during a change
+// window - without affecting others.
+func ReadOnlyNamespaceUnaryInterceptor(cfg config.StorageConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		var namespace string
+		switch r := req.(type) {
+		case *flipt.UpdateNamespaceRequest:
+			namespace = r.Key
+		case *flipt.DeleteNamespaceRequest:
+			namespace = r.Key
+		case namespaceKeyer:
+			namespace = r.GetNamespaceKey()
+		default:
+			return handler(ctx, req)
+		}
+
+		if cfg.NamespaceReadOnly(namespace) {
+			return nil, status.Errorf(codes.FailedPrecondition, "namespace %q is read-only", namespace)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+const idempotencyKeyMetadataKey = "idempotency-key"
+
+// idempotencyKey extracts the caller-supplied Idempotency-Key header from
+// the incoming request metadata, if any.
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(idempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// idempotencyResponse constructs the zero-value response message expected
+// for one of the idempotency-aware create requests, so a cached result can
+// be unmarshalled into it.
+func idempotencyResponse(req interface{}) (proto.Message, bool) {
+	switch req.(type) {
+	case *flipt.CreateFlagRequest:
+		return &flipt.Flag{}, true
+	case *flipt.CreateSegmentRequest:
+		return &flipt.Segment{}, true
+	case *flipt.CreateRuleRequest:
+		return &flipt.Rule{}, true
+	case *flipt.CreateDistributionRequest:
+		return &flipt.Distribution{}, true
+	default:
+		return nil, false
+	}
+}
+
+// IdempotencyUnaryInterceptor deduplicates retried
+// Create{Flag,Segment,Rule,Distribution} requests which carry a matching
+// Idempotency-Key header, returning the response recorded for the first
+// request instead of re-executing it.
+func IdempotencyUnaryInterceptor(store *idempotency.Store, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, ok := idempotencyResponse(req)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKey(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		cacheKey := fmt.Sprintf("%s:%s", info.FullMethod, key)
+
+		if cached, ok := store.Get(cacheKey); ok {
+			if err := proto.Unmarshal(cached, resp); err != nil {
+				logger.Error("unmarshalling idempotent response", zap.Error(err))
+				return handler(ctx, req)
+			}
+
+			return resp, nil
+		}
+
+		result, err := handler(ctx, req)
+		if err != nil {
+			return result, err
+		}
+
+		if msg, ok := result.(proto.Message); ok {
+			if data, merr := proto.Marshal(msg); merr == nil {
+				store.Put(cacheKey, data)
+			} else {
+				logger.Error("marshalling idempotent response", zap.Error(merr))
+			}
+		}
+
+		return result, err
+	}
+}
+
 type RequestIdentifiable interface {
 	// SetRequestIDIfNotBlank attempts to set the provided ID on the instance
 	// If the ID was blank, it returns the ID provided to this call.
@@ -314,6 +551,9 @@ func AuditUnaryInterceptor(logger *zap.Logger, eventPairChecker EventPairChecker
 		}
 
 		actor := auth.ActorFromContext(ctx)
+		if id := requestid.FromContext(ctx); id != "" {
+			actor["request_id"] = id
+		}
 
 		var event *audit.Event
 
@@ -667,18 +667,16 @@ func TestCacheUnaryInterceptor_Evaluate(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:                "4",
-				RuleID:            "1",
-				VariantID:         "5",
-				Rollout:           100,
-				VariantKey:        "boz",
-				VariantAttachment: `{"key":"value"}`,
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:                "4",
+						RuleID:            "1",
+						VariantID:         "5",
+						Rollout:           100,
+						VariantKey:        "boz",
+						VariantAttachment: `{"key":"value"}`,
+					},
+				},
 			},
 		}, nil)
 
@@ -823,18 +821,16 @@ func TestCacheUnaryInterceptor_Evaluation_Variant(t *testing.T) {
 						},
 					},
 				},
-			},
-		}, nil)
-
-	store.On("GetEvaluationDistributions", mock.Anything, "1").Return(
-		[]*storage.EvaluationDistribution{
-			{
-				ID:                "4",
-				RuleID:            "1",
-				VariantID:         "5",
-				Rollout:           100,
-				VariantKey:        "boz",
-				VariantAttachment: `{"key":"value"}`,
+				Distributions: []*storage.EvaluationDistribution{
+					{
+						ID:                "4",
+						RuleID:            "1",
+						VariantID:         "5",
+						Rollout:           100,
+						VariantKey:        "boz",
+						VariantAttachment: `{"key":"value"}`,
+					},
+				},
 			},
 		}, nil)
 
@@ -2207,3 +2203,69 @@ func TestAuditUnaryInterceptor_CreateToken(t *testing.T) {
 	span.End()
 	assert.Equal(t, 1, exporterSpy.GetSendAuditsCalled())
 }
+
+func TestReadOnlyNamespaceUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        config.StorageConfig
+		fullMethod string
+		req        interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "mutating request against read-only namespace",
+			cfg:        config.StorageConfig{ReadOnlyNamespaces: []string{"production"}},
+			fullMethod: "/flipt.Flipt/CreateFlag",
+			req:        &flipt.CreateFlagRequest{Key: "flag", NamespaceKey: "production"},
+			wantErr:    true,
+		},
+		{
+			name:       "mutating request against default namespace marked read-only",
+			cfg:        config.StorageConfig{ReadOnlyNamespaces: []string{"default"}},
+			fullMethod: "/flipt.Flipt/CreateFlag",
+			req:        &flipt.CreateFlagRequest{Key: "flag"},
+			wantErr:    true,
+		},
+		{
+			name:       "mutating request against writable namespace",
+			cfg:        config.StorageConfig{ReadOnlyNamespaces: []string{"production"}},
+			fullMethod: "/flipt.Flipt/CreateFlag",
+			req:        &flipt.CreateFlagRequest{Key: "flag", NamespaceKey: "staging"},
+			wantErr:    false,
+		},
+		{
+			name:       "read request against read-only namespace",
+			cfg:        config.StorageConfig{ReadOnlyNamespaces: []string{"production"}},
+			fullMethod: "/flipt.Flipt/GetFlag",
+			req:        &flipt.GetFlagRequest{Key: "flag", NamespaceKey: "production"},
+			wantErr:    false,
+		},
+		{
+			name:       "update namespace against read-only namespace",
+			cfg:        config.StorageConfig{ReadOnlyNamespaces: []string{"production"}},
+			fullMethod: "/flipt.Flipt/UpdateNamespace",
+			req:        &flipt.UpdateNamespaceRequest{Key: "production"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(ctx context.Context, r interface{}) (interface{}, error) {
+				return r, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tt.fullMethod}
+
+			_, err := ReadOnlyNamespaceUnaryInterceptor(tt.cfg)(context.Background(), tt.req, info, handler)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// Limiter enforces a requests-per-second limit with a burst allowance,
+// keyed by an arbitrary identity such as a client IP address or an
+// authenticated token ID.
+type Limiter struct {
+	rps   rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// New constructs a Limiter which permits rps requests per second, per key,
+// with the provided burst allowance.
+func New(rps float64, burst int) *Limiter {
+	return &Limiter{
+		rps:      rate.Limit(rps),
+		burst:    burst,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// Allow reports whether a request identified by key is permitted under the
+// configured rate limit.
+func (l *Limiter) Allow(key string) bool {
+	return l.limiterFor(key).Allow()
+}
+
+func (l *Limiter) limiterFor(key string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(l.rps, l.burst)
+		l.limiters[key] = limiter
+	}
+
+	return limiter
+}
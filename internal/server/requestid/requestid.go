@@ -0,0 +1,36 @@
+// Package requestid provides a request-scoped identifier used to correlate
+// logs, audit events and error responses for a single request across the
+// gRPC and HTTP gateway layers.
+package requestid
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+)
+
+// Header is the canonical HTTP header used to propagate a request ID.
+const Header = "X-Request-Id"
+
+// MetadataKey is the gRPC metadata key used to propagate a request ID.
+// gRPC lower-cases all metadata keys, so this differs from Header.
+const MetadataKey = "x-request-id"
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the given request ID.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID carried on ctx, or the empty string if
+// none is present.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// New generates a new request ID.
+func New() string {
+	return uuid.Must(uuid.NewV4()).String()
+}
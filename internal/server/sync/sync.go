@@ -0,0 +1,250 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"go.flipt.io/flipt/internal/storage"
+	"go.flipt.io/flipt/rpc/flipt"
+	syncv1 "go.flipt.io/flipt/rpc/flipt/sync"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// pollInterval is how often SyncFlags checks whether the flag configuration
+// has changed and needs to be re-streamed to connected flagd sidecars.
+const pollInterval = 30 * time.Second
+
+// Storer is the minimal abstraction for interacting with the storage layer
+// required to build a flagd-compatible flag configuration document.
+type Storer interface {
+	ListFlags(ctx context.Context, namespaceKey string, opts ...storage.QueryOption) (storage.ResultSet[*flipt.Flag], error)
+}
+
+// Server implements flagd's sync.v1 FlagSyncService, backed by Flipt's flag
+// storage, so that a flagd sidecar can use Flipt as its sync source instead
+// of a file on disk or a custom in-process provider.
+//
+// The flag configuration streamed to clients is a best-effort snapshot:
+// flag state, variants and enablement are translated directly, but Flipt's
+// rule and rollout based targeting is not translated into flagd's JSON
+// targeting logic, since the two engines don't share a rule representation.
+// Flags that rely on targeting rules will therefore evaluate against their
+// default variant only, until flagd gains a richer sync contract.
+type Server struct {
+	logger *zap.Logger
+	store  Storer
+	syncv1.UnimplementedFlagSyncServiceServer
+}
+
+// New constructs a new Server.
+func New(logger *zap.Logger, store Storer) *Server {
+	return &Server{
+		logger: logger,
+		store:  store,
+	}
+}
+
+// RegisterGRPC registers the Server onto the provided gRPC Server.
+func (s *Server) RegisterGRPC(server *grpc.Server) {
+	syncv1.RegisterFlagSyncServiceServer(server, s)
+}
+
+// SyncFlags streams the flag configuration for the namespace selected by
+// req.Selector (the default namespace, if unset) to the client on connect,
+// and again every time it changes.
+//
+// Like every other server-streaming RPC registered on Flipt's grpc.Server,
+// SyncFlags is guarded by the stream interceptor chain built alongside the
+// unary one (see auth.StreamInterceptor), not by anything in this file, so
+// authentication.required/authorization.required apply to it exactly as
+// they do to the unary management API.
+func (s *Server) SyncFlags(req *syncv1.SyncFlagsRequest, stream syncv1.FlagSyncService_SyncFlagsServer) error {
+	ctx := stream.Context()
+	namespace := namespaceFromSelector(req.Selector)
+
+	last, err := s.sendFlagConfiguration(ctx, stream, namespace, "")
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			last, err = s.sendFlagConfiguration(ctx, stream, namespace, last)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendFlagConfiguration builds the flag configuration document for
+// namespace and, if it differs from previouslySent, streams it to the
+// client. It returns the document it sent (or previouslySent, if nothing
+// changed).
+func (s *Server) sendFlagConfiguration(ctx context.Context, stream syncv1.FlagSyncService_SyncFlagsServer, namespace, previouslySent string) (string, error) {
+	config, err := buildFlagConfiguration(ctx, s.store, namespace)
+	if err != nil {
+		s.logger.Error("building flagd flag configuration", zap.String("namespace", namespace), zap.Error(err))
+		return previouslySent, err
+	}
+
+	if config == previouslySent {
+		return previouslySent, nil
+	}
+
+	if err := stream.Send(&syncv1.SyncFlagsResponse{FlagConfiguration: config}); err != nil {
+		return previouslySent, err
+	}
+
+	return config, nil
+}
+
+// FetchAllFlags returns the flag configuration for the namespace selected by
+// req.Selector (the default namespace, if unset) as it currently stands.
+func (s *Server) FetchAllFlags(ctx context.Context, req *syncv1.FetchAllFlagsRequest) (*syncv1.FetchAllFlagsResponse, error) {
+	config, err := buildFlagConfiguration(ctx, s.store, namespaceFromSelector(req.Selector))
+	if err != nil {
+		return nil, err
+	}
+
+	return &syncv1.FetchAllFlagsResponse{FlagConfiguration: config}, nil
+}
+
+// GetMetadata returns additional information about this sync source.
+func (s *Server) GetMetadata(ctx context.Context, _ *syncv1.GetMetadataRequest) (*syncv1.GetMetadataResponse, error) {
+	metadata, err := structpb.NewStruct(map[string]any{
+		"source": "flipt",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &syncv1.GetMetadataResponse{Metadata: metadata}, nil
+}
+
+func namespaceFromSelector(selector string) string {
+	if selector == "" {
+		return flipt.DefaultNamespace
+	}
+
+	return selector
+}
+
+// flagdFlag is a single entry in a flagd flag definition document.
+// See: https://flagd.dev/reference/flag-definitions/
+type flagdFlag struct {
+	State          string         `json:"state"`
+	DefaultVariant string         `json:"defaultVariant,omitempty"`
+	Variants       map[string]any `json:"variants,omitempty"`
+}
+
+type flagdConfiguration struct {
+	Flags map[string]flagdFlag `json:"flags"`
+}
+
+// buildFlagConfiguration lists every flag in namespace and translates it
+// into a flagd flag definition document, encoded as JSON.
+func buildFlagConfiguration(ctx context.Context, store Storer, namespace string) (string, error) {
+	flags, err := listAllFlags(ctx, store, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	config := flagdConfiguration{Flags: make(map[string]flagdFlag, len(flags))}
+	for _, flag := range flags {
+		config.Flags[flag.Key] = toFlagdFlag(flag)
+	}
+
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func listAllFlags(ctx context.Context, store Storer, namespace string) ([]*flipt.Flag, error) {
+	var (
+		flags     []*flipt.Flag
+		pageToken string
+	)
+
+	for {
+		opts := []storage.QueryOption{storage.WithLimit(storage.MaxListLimit)}
+		if pageToken != "" {
+			opts = append(opts, storage.WithPageToken(pageToken))
+		}
+
+		set, err := store.ListFlags(ctx, namespace, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		flags = append(flags, set.Results...)
+
+		if set.NextPageToken == "" {
+			break
+		}
+
+		pageToken = set.NextPageToken
+	}
+
+	return flags, nil
+}
+
+func toFlagdFlag(flag *flipt.Flag) flagdFlag {
+	state := "DISABLED"
+	if flag.Enabled {
+		state = "ENABLED"
+	}
+
+	if flag.Type == flipt.FlagType_BOOLEAN_FLAG_TYPE {
+		return flagdFlag{
+			State:          state,
+			DefaultVariant: state,
+			Variants: map[string]any{
+				"ENABLED":  true,
+				"DISABLED": false,
+			},
+		}
+	}
+
+	variants := make(map[string]any, len(flag.Variants))
+	keys := make([]string, 0, len(flag.Variants))
+	for _, v := range flag.Variants {
+		keys = append(keys, v.Key)
+
+		var value any = v.Key
+		if v.Attachment != "" {
+			var attachment any
+			if err := json.Unmarshal([]byte(v.Attachment), &attachment); err == nil {
+				value = attachment
+			}
+		}
+
+		variants[v.Key] = value
+	}
+
+	sort.Strings(keys)
+
+	var defaultVariant string
+	if len(keys) > 0 {
+		defaultVariant = keys[0]
+	}
+
+	return flagdFlag{
+		State:          state,
+		DefaultVariant: defaultVariant,
+		Variants:       variants,
+	}
+}
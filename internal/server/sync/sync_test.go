@@ -0,0 +1,62 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/storage"
+	"go.flipt.io/flipt/rpc/flipt"
+)
+
+var _ Storer = &storeMock{}
+
+type storeMock struct {
+	mock.Mock
+}
+
+func (s *storeMock) ListFlags(ctx context.Context, namespaceKey string, opts ...storage.QueryOption) (storage.ResultSet[*flipt.Flag], error) {
+	args := s.Called(ctx, namespaceKey)
+	return args.Get(0).(storage.ResultSet[*flipt.Flag]), args.Error(1)
+}
+
+func TestBuildFlagConfiguration(t *testing.T) {
+	store := &storeMock{}
+	store.On("ListFlags", mock.Anything, "default").Return(storage.ResultSet[*flipt.Flag]{
+		Results: []*flipt.Flag{
+			{
+				Key:     "boolean-flag",
+				Type:    flipt.FlagType_BOOLEAN_FLAG_TYPE,
+				Enabled: true,
+			},
+			{
+				Key:  "variant-flag",
+				Type: flipt.FlagType_VARIANT_FLAG_TYPE,
+				Variants: []*flipt.Variant{
+					{Key: "b"},
+					{Key: "a", Attachment: `{"color":"red"}`},
+				},
+			},
+		},
+	}, nil)
+
+	config, err := buildFlagConfiguration(context.Background(), store, "default")
+	require.NoError(t, err)
+
+	var got flagdConfiguration
+	require.NoError(t, json.Unmarshal([]byte(config), &got))
+
+	assert.Equal(t, "ENABLED", got.Flags["boolean-flag"].State)
+	assert.Equal(t, "ENABLED", got.Flags["boolean-flag"].DefaultVariant)
+	assert.Equal(t, true, got.Flags["boolean-flag"].Variants["ENABLED"])
+
+	assert.Equal(t, "DISABLED", got.Flags["variant-flag"].State)
+	assert.Equal(t, "a", got.Flags["variant-flag"].DefaultVariant)
+	assert.Equal(t, map[string]any{"color": "red"}, got.Flags["variant-flag"].Variants["a"])
+	assert.Equal(t, "b", got.Flags["variant-flag"].Variants["b"])
+
+	store.AssertExpectations(t)
+}
@@ -0,0 +1,154 @@
+package watch
+
+import (
+	"context"
+	"time"
+
+	"go.flipt.io/flipt/errors"
+	"go.flipt.io/flipt/rpc/flipt"
+	watchv1 "go.flipt.io/flipt/rpc/flipt/watch"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// pollInterval is how often a watch checks the store for changes to the
+// namespace or flag it is watching.
+const pollInterval = 5 * time.Second
+
+// Storer is the minimal abstraction required to serve WatchNamespace and
+// WatchFlag.
+type Storer interface {
+	GetNamespace(ctx context.Context, key string) (*flipt.Namespace, error)
+	GetFlag(ctx context.Context, namespaceKey, key string) (*flipt.Flag, error)
+}
+
+// Server implements WatchService, pushing change notifications for a
+// namespace or flag as they're observed, so that SDKs can refresh their
+// cache immediately instead of relying on a polling interval of their own.
+//
+// Watches are themselves implemented by polling the store every
+// pollInterval: there is no underlying change-feed to subscribe to, so a
+// change that happens and reverts between two polls will not be observed.
+type Server struct {
+	logger *zap.Logger
+	store  Storer
+	watchv1.UnimplementedWatchServiceServer
+}
+
+// New constructs a new Server.
+func New(logger *zap.Logger, store Storer) *Server {
+	return &Server{
+		logger: logger,
+		store:  store,
+	}
+}
+
+// RegisterGRPC registers the Server onto the provided gRPC Server.
+func (s *Server) RegisterGRPC(server *grpc.Server) {
+	watchv1.RegisterWatchServiceServer(server, s)
+}
+
+// WatchNamespace streams a snapshot of the namespace selected by
+// req.NamespaceKey, followed by a new snapshot each time it changes, until
+// it is deleted or the client disconnects.
+func (s *Server) WatchNamespace(req *watchv1.WatchNamespaceRequest, stream watchv1.WatchService_WatchNamespaceServer) error {
+	ctx := stream.Context()
+
+	namespace, err := s.store.GetNamespace(ctx, req.NamespaceKey)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&watchv1.NamespaceEvent{
+		Action:    watchv1.ChangeAction_CHANGE_ACTION_UPDATED,
+		Namespace: namespace,
+	}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			updated, err := s.store.GetNamespace(ctx, req.NamespaceKey)
+			if _, notFound := errors.As[errors.ErrNotFound](err); notFound {
+				return stream.Send(&watchv1.NamespaceEvent{Action: watchv1.ChangeAction_CHANGE_ACTION_DELETED})
+			}
+
+			if err != nil {
+				s.logger.Error("watching namespace", zap.String("namespace", req.NamespaceKey), zap.Error(err))
+				return err
+			}
+
+			if proto.Equal(namespace, updated) {
+				continue
+			}
+
+			namespace = updated
+
+			if err := stream.Send(&watchv1.NamespaceEvent{
+				Action:    watchv1.ChangeAction_CHANGE_ACTION_UPDATED,
+				Namespace: namespace,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// WatchFlag streams a snapshot of the flag selected by req.NamespaceKey and
+// req.Key, followed by a new snapshot each time it changes, until it is
+// deleted or the client disconnects.
+func (s *Server) WatchFlag(req *watchv1.WatchFlagRequest, stream watchv1.WatchService_WatchFlagServer) error {
+	ctx := stream.Context()
+
+	flag, err := s.store.GetFlag(ctx, req.NamespaceKey, req.Key)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&watchv1.FlagEvent{
+		Action: watchv1.ChangeAction_CHANGE_ACTION_UPDATED,
+		Flag:   flag,
+	}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			updated, err := s.store.GetFlag(ctx, req.NamespaceKey, req.Key)
+			if _, notFound := errors.As[errors.ErrNotFound](err); notFound {
+				return stream.Send(&watchv1.FlagEvent{Action: watchv1.ChangeAction_CHANGE_ACTION_DELETED})
+			}
+
+			if err != nil {
+				s.logger.Error("watching flag", zap.String("namespace", req.NamespaceKey), zap.String("flag", req.Key), zap.Error(err))
+				return err
+			}
+
+			if proto.Equal(flag, updated) {
+				continue
+			}
+
+			flag = updated
+
+			if err := stream.Send(&watchv1.FlagEvent{
+				Action: watchv1.ChangeAction_CHANGE_ACTION_UPDATED,
+				Flag:   flag,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package watch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/errors"
+	"go.flipt.io/flipt/rpc/flipt"
+	watchv1 "go.flipt.io/flipt/rpc/flipt/watch"
+	"go.uber.org/zap/zaptest"
+)
+
+var _ Storer = &storeMock{}
+
+type storeMock struct {
+	mock.Mock
+}
+
+func (s *storeMock) GetNamespace(ctx context.Context, key string) (*flipt.Namespace, error) {
+	args := s.Called(ctx, key)
+	if v := args.Get(0); v != nil {
+		return v.(*flipt.Namespace), args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+func (s *storeMock) GetFlag(ctx context.Context, namespaceKey, key string) (*flipt.Flag, error) {
+	args := s.Called(ctx, namespaceKey, key)
+	if v := args.Get(0); v != nil {
+		return v.(*flipt.Flag), args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+type watchFlagStreamMock struct {
+	mock.Mock
+	watchv1.WatchService_WatchFlagServer
+
+	ctx  context.Context
+	sent []*watchv1.FlagEvent
+}
+
+func (m *watchFlagStreamMock) Context() context.Context {
+	return m.ctx
+}
+
+func (m *watchFlagStreamMock) Send(e *watchv1.FlagEvent) error {
+	m.sent = append(m.sent, e)
+	return m.Called(e).Error(0)
+}
+
+func TestWatchFlag_NotFound(t *testing.T) {
+	store := &storeMock{}
+	store.On("GetFlag", mock.Anything, "default", "missing").Return(nil, errors.ErrNotFound("flag \"missing\""))
+
+	server := New(zaptest.NewLogger(t), store)
+
+	stream := &watchFlagStreamMock{ctx: context.Background()}
+
+	err := server.WatchFlag(&watchv1.WatchFlagRequest{NamespaceKey: "default", Key: "missing"}, stream)
+	require.Error(t, err)
+	assert.Empty(t, stream.sent)
+
+	store.AssertExpectations(t)
+}
+
+func TestWatchFlag_SendsInitialSnapshot(t *testing.T) {
+	store := &storeMock{}
+
+	flag := &flipt.Flag{Key: "my-flag", Enabled: true}
+	store.On("GetFlag", mock.Anything, "default", "my-flag").Return(flag, nil)
+
+	server := New(zaptest.NewLogger(t), store)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream := &watchFlagStreamMock{ctx: ctx}
+	stream.On("Send", mock.AnythingOfType("*watch.FlagEvent")).Run(func(mock.Arguments) {
+		cancel()
+	}).Return(nil)
+
+	err := server.WatchFlag(&watchv1.WatchFlagRequest{NamespaceKey: "default", Key: "my-flag"}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 1)
+
+	assert.Equal(t, watchv1.ChangeAction_CHANGE_ACTION_UPDATED, stream.sent[0].Action)
+	assert.Equal(t, flag, stream.sent[0].Flag)
+
+	store.AssertExpectations(t)
+}
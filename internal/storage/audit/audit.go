@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.flipt.io/flipt/internal/storage"
+	rpcaudit "go.flipt.io/flipt/rpc/flipt/audit"
+)
+
+// Store persists audit events and allows them to be queried back out again.
+type Store interface {
+	// InsertEvent persists a single audit event to the backing store.
+	InsertEvent(context.Context, *InsertEventRequest) error
+	// ListEvents retrieves a set of audit events based on the provided predicates with
+	// the supplied ListRequest.
+	ListEvents(context.Context, *storage.ListRequest[ListEventsPredicate]) (storage.ResultSet[*rpcaudit.AuditEvent], error)
+}
+
+// InsertEventRequest is the argument passed when persisting an audit event to
+// a target Store.
+type InsertEventRequest struct {
+	ID           string
+	Type         string
+	Action       string
+	Actor        map[string]string
+	NamespaceKey string
+	Payload      interface{}
+	Timestamp    time.Time
+}
+
+// ListEventsPredicate contains the fields necessary to predicate a list operation
+// on an audit events storage backend.
+type ListEventsPredicate struct {
+	Actor        *string
+	Action       *string
+	Type         *string
+	NamespaceKey *string
+	Start        *time.Time
+	End          *time.Time
+}
+
+// ListWithActor can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListEvents by the actor who performed it.
+func ListWithActor(actor string) storage.ListOption[ListEventsPredicate] {
+	return func(r *storage.ListRequest[ListEventsPredicate]) {
+		r.Predicate.Actor = &actor
+	}
+}
+
+// ListWithAction can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListEvents by action (e.g. "created").
+func ListWithAction(action string) storage.ListOption[ListEventsPredicate] {
+	return func(r *storage.ListRequest[ListEventsPredicate]) {
+		r.Predicate.Action = &action
+	}
+}
+
+// ListWithType can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListEvents by resource type (e.g. "flag").
+func ListWithType(t string) storage.ListOption[ListEventsPredicate] {
+	return func(r *storage.ListRequest[ListEventsPredicate]) {
+		r.Predicate.Type = &t
+	}
+}
+
+// ListWithNamespaceKey can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListEvents by namespace.
+func ListWithNamespaceKey(namespaceKey string) storage.ListOption[ListEventsPredicate] {
+	return func(r *storage.ListRequest[ListEventsPredicate]) {
+		r.Predicate.NamespaceKey = &namespaceKey
+	}
+}
+
+// ListWithStart can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListEvents to those occurring at or after t.
+func ListWithStart(t time.Time) storage.ListOption[ListEventsPredicate] {
+	return func(r *storage.ListRequest[ListEventsPredicate]) {
+		r.Predicate.Start = &t
+	}
+}
+
+// ListWithEnd can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListEvents to those occurring at or before t.
+func ListWithEnd(t time.Time) storage.ListOption[ListEventsPredicate] {
+	return func(r *storage.ListRequest[ListEventsPredicate]) {
+		r.Predicate.End = &t
+	}
+}
@@ -0,0 +1,245 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/gofrs/uuid"
+	"go.flipt.io/flipt/internal/storage"
+	storageaudit "go.flipt.io/flipt/internal/storage/audit"
+	storagesql "go.flipt.io/flipt/internal/storage/sql"
+	rpcaudit "go.flipt.io/flipt/rpc/flipt/audit"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Store is the persistent storage layer for audit events backed by SQL
+// based relational database systems.
+type Store struct {
+	logger  *zap.Logger
+	driver  storagesql.Driver
+	builder sq.StatementBuilderType
+
+	generateID func() string
+}
+
+// Option is a type which configures a *Store
+type Option func(*Store)
+
+// NewStore constructs and configures a new instance of *Store.
+// Queries are issued to the database via the provided statement builder.
+func NewStore(driver storagesql.Driver, builder sq.StatementBuilderType, logger *zap.Logger, opts ...Option) *Store {
+	store := &Store{
+		logger:  logger,
+		driver:  driver,
+		builder: builder,
+		generateID: func() string {
+			return uuid.Must(uuid.NewV4()).String()
+		},
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// WithIDGeneratorFunc overrides the stores ID generator function used to
+// generate new random ID strings, when inserting new audit events.
+// The default is a string containing a valid UUID (V4).
+func WithIDGeneratorFunc(fn func() string) Option {
+	return func(s *Store) {
+		s.generateID = fn
+	}
+}
+
+// InsertEvent persists a single audit event to the backing store.
+func (s *Store) InsertEvent(ctx context.Context, r *storageaudit.InsertEventRequest) error {
+	id := r.ID
+	if id == "" {
+		id = s.generateID()
+	}
+
+	if _, err := s.builder.Insert("audit_events").
+		Columns(
+			"id",
+			"type",
+			"action",
+			"actor",
+			"namespace_key",
+			"payload",
+			"occurred_at",
+		).
+		Values(
+			&id,
+			&r.Type,
+			&r.Action,
+			&storagesql.JSONField[map[string]string]{T: r.Actor},
+			nullableString(r.NamespaceKey),
+			&storagesql.JSONField[interface{}]{T: r.Payload},
+			&storagesql.Timestamp{Timestamp: timestamppb.New(r.Timestamp)},
+		).
+		ExecContext(ctx); err != nil {
+		return fmt.Errorf("inserting audit event %q: %w", id, s.driver.AdaptError(err))
+	}
+
+	return nil
+}
+
+// nullableString returns nil for an empty string, so that the column is stored as NULL
+// rather than an empty value, and can be omitted from equality predicates.
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// ListEvents lists a page of audit events from the backing store.
+func (s *Store) ListEvents(ctx context.Context, req *storage.ListRequest[storageaudit.ListEventsPredicate]) (set storage.ResultSet[*rpcaudit.AuditEvent], err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("listing audit events: %w", s.driver.AdaptError(err))
+		}
+	}()
+
+	// adjust the query parameters within normal bounds
+	req.QueryParams.Normalize()
+
+	query := s.builder.
+		Select(
+			"id",
+			"type",
+			"action",
+			"actor",
+			"namespace_key",
+			"payload",
+			"occurred_at",
+		).
+		From("audit_events").
+		Limit(req.QueryParams.Limit + 1).
+		OrderBy(fmt.Sprintf("occurred_at %s", req.QueryParams.Order))
+
+	if req.Predicate.Actor != nil {
+		query = query.Where(sq.Like{"actor": fmt.Sprintf("%%%s%%", *req.Predicate.Actor)})
+	}
+
+	if req.Predicate.Action != nil {
+		query = query.Where(sq.Eq{"action": *req.Predicate.Action})
+	}
+
+	if req.Predicate.Type != nil {
+		query = query.Where(sq.Eq{"type": *req.Predicate.Type})
+	}
+
+	if req.Predicate.NamespaceKey != nil {
+		query = query.Where(sq.Eq{"namespace_key": *req.Predicate.NamespaceKey})
+	}
+
+	if req.Predicate.Start != nil {
+		query = query.Where(sq.GtOrEq{
+			"occurred_at": &storagesql.Timestamp{Timestamp: timestamppb.New(*req.Predicate.Start)},
+		})
+	}
+
+	if req.Predicate.End != nil {
+		query = query.Where(sq.LtOrEq{
+			"occurred_at": &storagesql.Timestamp{Timestamp: timestamppb.New(*req.Predicate.End)},
+		})
+	}
+
+	var offset int
+	if v, err := strconv.ParseInt(req.QueryParams.PageToken, 10, 64); err == nil {
+		offset = int(v)
+		query = query.Offset(uint64(v))
+	}
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return set, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		event, err := s.scanEvent(rows)
+		if err != nil {
+			return set, err
+		}
+
+		if len(set.Results) >= int(req.QueryParams.Limit) {
+			// set the next page token to the first
+			// row beyond the query limit and break
+			set.NextPageToken = fmt.Sprintf("%d", offset+int(req.QueryParams.Limit))
+			break
+		}
+
+		set.Results = append(set.Results, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return set, err
+	}
+
+	return set, nil
+}
+
+func (s *Store) scanEvent(scanner sq.RowScanner) (*rpcaudit.AuditEvent, error) {
+	var (
+		event        rpcaudit.AuditEvent
+		actor        map[string]string
+		namespaceKey *string
+		payload      interface{}
+		occurredAt   storagesql.Timestamp
+	)
+
+	if err := scanner.Scan(
+		&event.Id,
+		&event.Type,
+		&event.Action,
+		&storagesql.JSONField[*map[string]string]{T: &actor},
+		&namespaceKey,
+		&storagesql.JSONField[*interface{}]{T: &payload},
+		&occurredAt,
+	); err != nil {
+		return nil, fmt.Errorf("reading audit event: %w", s.driver.AdaptError(err))
+	}
+
+	event.Actor = actor
+	event.Timestamp = occurredAt.Timestamp
+
+	if namespaceKey != nil {
+		event.NamespaceKey = *namespaceKey
+	}
+
+	if payload != nil {
+		// payload is stored as opaque JSON and may be the product of marshalling an
+		// arbitrary Go struct, so round-trip it through a map before converting to
+		// a protobuf Struct.
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("reading audit event payload: %w", err)
+		}
+
+		var fields map[string]interface{}
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return nil, fmt.Errorf("reading audit event payload: %w", err)
+		}
+
+		payloadStruct, err := structpb.NewStruct(fields)
+		if err != nil {
+			return nil, fmt.Errorf("reading audit event payload: %w", err)
+		}
+
+		event.Payload = payloadStruct
+	}
+
+	return &event, nil
+}
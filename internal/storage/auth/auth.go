@@ -71,11 +71,12 @@ type DeleteAuthenticationsRequest struct {
 	ID            *string
 	Method        *auth.Method
 	ExpiredBefore *timestamppb.Timestamp
+	Metadata      map[string]string
 }
 
 func (d *DeleteAuthenticationsRequest) Valid() error {
-	if d.ID == nil && d.Method == nil && d.ExpiredBefore == nil {
-		return errors.ErrInvalidf("id, method or expired-before timestamp is required")
+	if d.ID == nil && d.Method == nil && d.ExpiredBefore == nil && len(d.Metadata) == 0 {
+		return errors.ErrInvalidf("id, method, expired-before timestamp or metadata is required")
 	}
 
 	return nil
@@ -114,6 +115,15 @@ func WithExpiredBefore(t time.Time) containers.Option[DeleteAuthenticationsReque
 	}
 }
 
+// WithMetadata is an option which ensures a delete only applies to Authentications whose
+// metadata contains every key/value pair supplied. This allows bulk revocation of tokens
+// sharing some common metadata (e.g. all tokens issued to a particular service account).
+func WithMetadata(metadata map[string]string) containers.Option[DeleteAuthenticationsRequest] {
+	return func(r *DeleteAuthenticationsRequest) {
+		r.Metadata = metadata
+	}
+}
+
 // GenerateRandomToken produces a URL safe base64 encoded string of random characters
 // the data is sourced from a pseudo-random input stream
 func GenerateRandomToken() string {
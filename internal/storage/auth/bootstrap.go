@@ -10,9 +10,15 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// bootstrapMetadataScopeKey stores the scope of the bootstrap token in its
+// Authentication metadata, so the auth middleware can restrict what it's
+// permitted to do.
+const bootstrapMetadataScopeKey = "io.flipt.auth.token.scope"
+
 type bootstrapOpt struct {
 	token      string
 	expiration time.Duration
+	scope      string
 }
 
 // BootstrapOption is a type which configures the bootstrap or initial static token.
@@ -32,6 +38,14 @@ func WithExpiration(expiration time.Duration) BootstrapOption {
 	}
 }
 
+// WithScope restricts the bootstrap token to the provided scope (e.g. "read").
+// An empty scope leaves the token unrestricted.
+func WithScope(scope string) BootstrapOption {
+	return func(o *bootstrapOpt) {
+		o.scope = scope
+	}
+}
+
 // Bootstrap creates an initial static authentication of type token
 // if one does not already exist.
 func Bootstrap(ctx context.Context, store Store, opts ...BootstrapOption) (string, error) {
@@ -63,6 +77,11 @@ func Bootstrap(ctx context.Context, store Store, opts ...BootstrapOption) (strin
 		req.ClientToken = o.token
 	}
 
+	// if a scope is provided, restrict the token to it
+	if o.scope != "" {
+		req.Metadata[bootstrapMetadataScopeKey] = o.scope
+	}
+
 	// if an expiration is provided, use it
 	if o.expiration != 0 {
 		req.ExpiresAt = timestamppb.New(time.Now().Add(o.expiration))
@@ -206,7 +206,8 @@ func (s *Store) DeleteAuthentications(_ context.Context, req *auth.DeleteAuthent
 		if (req.ID == nil || *req.ID == a.Id) &&
 			(req.Method == nil || *req.Method == a.Method) &&
 			(req.ExpiredBefore == nil ||
-				(a.ExpiresAt != nil && a.ExpiresAt.AsTime().Before(req.ExpiredBefore.AsTime()))) {
+				(a.ExpiresAt != nil && a.ExpiresAt.AsTime().Before(req.ExpiredBefore.AsTime()))) &&
+			metadataMatches(req.Metadata, a.Metadata) {
 			delete(s.byID, a.Id)
 			delete(s.byToken, hashedToken)
 		}
@@ -215,6 +216,18 @@ func (s *Store) DeleteAuthentications(_ context.Context, req *auth.DeleteAuthent
 	return nil
 }
 
+// metadataMatches reports whether candidate contains every key/value pair present in predicate.
+// An empty (or nil) predicate always matches.
+func metadataMatches(predicate, candidate map[string]string) bool {
+	for k, v := range predicate {
+		if candidate[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ExpireAuthenticationByID attempts to expire an Authentication by ID string and the provided expiry time.
 func (s *Store) ExpireAuthenticationByID(ctx context.Context, id string, expireAt *timestamppb.Timestamp) error {
 	s.mu.Lock()
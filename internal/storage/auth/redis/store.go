@@ -0,0 +1,358 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	fliptErrors "go.flipt.io/flipt/errors"
+	"go.flipt.io/flipt/internal/storage"
+	storageauth "go.flipt.io/flipt/internal/storage/auth"
+	rpcauth "go.flipt.io/flipt/rpc/flipt/auth"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// keyPrefix namespaces all keys written by this store within the target Redis database.
+const keyPrefix = "flipt:auth:"
+
+// indexKey is a sorted set of every Authentication ID, scored by creation time, used to
+// support ordered listing and to enumerate candidates for predicated deletes.
+const indexKey = keyPrefix + "index"
+
+func idKey(id string) string {
+	return keyPrefix + "id:" + id
+}
+
+func tokenKey(hashedToken string) string {
+	return keyPrefix + "token:" + hashedToken
+}
+
+func tokenByIDKey(id string) string {
+	return keyPrefix + "token-for-id:" + id
+}
+
+// Store is a Redis-backed implementation of storageauth.Store.
+//
+// It allows the auth state (client tokens, OIDC sessions) to be shared across stateless
+// Flipt replicas without requiring the primary database in the hot path. Each Authentication
+// is stored as a protobuf-marshalled value keyed by ID, alongside a token-to-ID lookup key
+// used to resolve a presented client token, and an entry in a sorted set used to support
+// ordered listing and predicated bulk deletes.
+type Store struct {
+	logger *zap.Logger
+	rdb    *goredis.Client
+
+	now           func() *timestamppb.Timestamp
+	generateID    func() string
+	generateToken func() string
+}
+
+// Option is a type which configures a *Store
+type Option func(*Store)
+
+// NewStore constructs and configures a new instance of *Store.
+// Queries are issued to the database via the provided redis client.
+func NewStore(rdb *goredis.Client, logger *zap.Logger, opts ...Option) *Store {
+	store := &Store{
+		logger: logger,
+		rdb:    rdb,
+		now: func() *timestamppb.Timestamp {
+			// we truncate timestamps to the microsecond to match the precision supported
+			// by the SQL backed stores, so behaviour doesn't change when switching backends.
+			now := time.Now().UTC().Truncate(time.Microsecond)
+			return timestamppb.New(now)
+		},
+		generateID: func() string {
+			return uuid.Must(uuid.NewV4()).String()
+		},
+		generateToken: storageauth.GenerateRandomToken,
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// WithNowFunc overrides the stores now() function used to obtain
+// a protobuf timestamp representative of the current time of evaluation.
+func WithNowFunc(fn func() *timestamppb.Timestamp) Option {
+	return func(s *Store) {
+		s.now = fn
+	}
+}
+
+// WithTokenGeneratorFunc overrides the stores token generator function
+// used to generate new random token strings as client tokens, when
+// creating new instances of Authentication.
+// The default is a pseudo-random string of bytes base64 encoded.
+func WithTokenGeneratorFunc(fn func() string) Option {
+	return func(s *Store) {
+		s.generateToken = fn
+	}
+}
+
+// WithIDGeneratorFunc overrides the stores ID generator function
+// used to generate new random ID strings, when creating new instances
+// of Authentications.
+// The default is a string containing a valid UUID (V4).
+func WithIDGeneratorFunc(fn func() string) Option {
+	return func(s *Store) {
+		s.generateID = fn
+	}
+}
+
+// CreateAuthentication creates and persists an instance of an Authentication.
+func (s *Store) CreateAuthentication(ctx context.Context, r *storageauth.CreateAuthenticationRequest) (string, *rpcauth.Authentication, error) {
+	if r.ExpiresAt != nil && !r.ExpiresAt.IsValid() {
+		return "", nil, fliptErrors.ErrInvalidf("invalid expiry time: %v", r.ExpiresAt)
+	}
+
+	var (
+		now            = s.now()
+		clientToken    = r.ClientToken
+		authentication = &rpcauth.Authentication{
+			Id:        s.generateID(),
+			Method:    r.Method,
+			Metadata:  r.Metadata,
+			ExpiresAt: r.ExpiresAt,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	)
+
+	// if no client token is provided, generate a new one
+	if clientToken == "" {
+		clientToken = s.generateToken()
+	}
+
+	hashedToken, err := storageauth.HashClientToken(clientToken)
+	if err != nil {
+		return "", nil, fmt.Errorf("creating authentication: %w", err)
+	}
+
+	data, err := proto.Marshal(authentication)
+	if err != nil {
+		return "", nil, fmt.Errorf("creating authentication: %w", err)
+	}
+
+	pipe := s.rdb.TxPipeline()
+	pipe.Set(ctx, idKey(authentication.Id), data, 0)
+	pipe.Set(ctx, tokenKey(hashedToken), authentication.Id, 0)
+	pipe.Set(ctx, tokenByIDKey(authentication.Id), hashedToken, 0)
+	pipe.ZAdd(ctx, indexKey, goredis.Z{Score: float64(now.AsTime().UnixNano()), Member: authentication.Id})
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", nil, fmt.Errorf("creating authentication %q: %w", authentication.Id, err)
+	}
+
+	return clientToken, authentication, nil
+}
+
+// GetAuthenticationByClientToken retrieves an instance of Authentication from the backing
+// store using the provided clientToken string as the key.
+func (s *Store) GetAuthenticationByClientToken(ctx context.Context, clientToken string) (*rpcauth.Authentication, error) {
+	hashedToken, err := storageauth.HashClientToken(clientToken)
+	if err != nil {
+		return nil, fmt.Errorf("getting authentication by token: %w", err)
+	}
+
+	id, err := s.rdb.Get(ctx, tokenKey(hashedToken)).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, fliptErrors.ErrNotFoundf("getting authentication by token")
+		}
+
+		return nil, fmt.Errorf("getting authentication by token: %w", err)
+	}
+
+	return s.getByID(ctx, id)
+}
+
+// GetAuthenticationByID retrieves an instance of Authentication from the backing
+// store using the provided id string.
+func (s *Store) GetAuthenticationByID(ctx context.Context, id string) (*rpcauth.Authentication, error) {
+	return s.getByID(ctx, id)
+}
+
+func (s *Store) getByID(ctx context.Context, id string) (*rpcauth.Authentication, error) {
+	data, err := s.rdb.Get(ctx, idKey(id)).Bytes()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, fliptErrors.ErrNotFoundf("getting authentication by token")
+		}
+
+		return nil, fmt.Errorf("getting authentication by token: %w", err)
+	}
+
+	var authentication rpcauth.Authentication
+	if err := proto.Unmarshal(data, &authentication); err != nil {
+		return nil, fmt.Errorf("getting authentication by token: %w", err)
+	}
+
+	return &authentication, nil
+}
+
+// ListAuthentications lists a page of Authentications from the backing store.
+func (s *Store) ListAuthentications(ctx context.Context, req *storage.ListRequest[storageauth.ListAuthenticationsPredicate]) (set storage.ResultSet[*rpcauth.Authentication], err error) {
+	// adjust the query parameters within normal bounds
+	req.QueryParams.Normalize()
+
+	ids, err := s.orderedIDs(ctx, req.QueryParams.Order == storage.OrderDesc)
+	if err != nil {
+		return set, fmt.Errorf("listing authentications: %w", err)
+	}
+
+	matched, err := s.authenticationsMatching(ctx, ids, func(a *rpcauth.Authentication) bool {
+		return req.Predicate.Method == nil || *req.Predicate.Method == a.Method
+	})
+	if err != nil {
+		return set, fmt.Errorf("listing authentications: %w", err)
+	}
+
+	var offset int
+	if v, err := strconv.ParseInt(req.QueryParams.PageToken, 10, 64); err == nil {
+		offset = int(v)
+	}
+
+	if offset > len(matched) {
+		offset = len(matched)
+	}
+
+	// ensure end of page does not exceed entire set
+	end := offset + int(req.QueryParams.Limit)
+	if end > len(matched) {
+		end = len(matched)
+	} else if end < len(matched) {
+		// set next page token given there are more entries
+		set.NextPageToken = fmt.Sprintf("%d", end)
+	}
+
+	set.Results = matched[offset:end]
+
+	return set, nil
+}
+
+// orderedIDs returns every Authentication ID present in the index, ordered by creation time.
+func (s *Store) orderedIDs(ctx context.Context, desc bool) ([]string, error) {
+	if desc {
+		return s.rdb.ZRevRange(ctx, indexKey, 0, -1).Result()
+	}
+
+	return s.rdb.ZRange(ctx, indexKey, 0, -1).Result()
+}
+
+// authenticationsMatching resolves ids to their Authentication, in order, skipping any whose
+// record has since disappeared (e.g. expired via Redis TTL out from under the index), and
+// filtering the remainder via the supplied predicate.
+func (s *Store) authenticationsMatching(ctx context.Context, ids []string, predicate func(*rpcauth.Authentication) bool) ([]*rpcauth.Authentication, error) {
+	matched := make([]*rpcauth.Authentication, 0, len(ids))
+
+	for _, id := range ids {
+		authentication, err := s.getByID(ctx, id)
+		if err != nil {
+			var notFound fliptErrors.ErrNotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if predicate(authentication) {
+			matched = append(matched, authentication)
+		}
+	}
+
+	return matched, nil
+}
+
+// DeleteAuthentications attempts to delete one or more Authentication instances from the backing store.
+// Use auth.DeleteByID to construct a request to delete a single Authentication by ID string.
+// Use auth.DeleteByMethod to construct a request to delete 0 or more Authentications by Method and optional expired before constraint.
+func (s *Store) DeleteAuthentications(ctx context.Context, req *storageauth.DeleteAuthenticationsRequest) error {
+	if err := req.Valid(); err != nil {
+		return fmt.Errorf("deleting authentications: %w", err)
+	}
+
+	ids, err := s.orderedIDs(ctx, false)
+	if err != nil {
+		return fmt.Errorf("deleting authentications: %w", err)
+	}
+
+	matched, err := s.authenticationsMatching(ctx, ids, func(a *rpcauth.Authentication) bool {
+		return (req.ID == nil || *req.ID == a.Id) &&
+			(req.Method == nil || *req.Method == a.Method) &&
+			(req.ExpiredBefore == nil ||
+				(a.ExpiresAt != nil && a.ExpiresAt.AsTime().Before(req.ExpiredBefore.AsTime()))) &&
+			metadataMatches(req.Metadata, a.Metadata)
+	})
+	if err != nil {
+		return fmt.Errorf("deleting authentications: %w", err)
+	}
+
+	if len(matched) == 0 {
+		return nil
+	}
+
+	pipe := s.rdb.TxPipeline()
+
+	for _, a := range matched {
+		hashedToken, err := s.rdb.Get(ctx, tokenByIDKey(a.Id)).Result()
+		if err != nil && !errors.Is(err, goredis.Nil) {
+			return fmt.Errorf("deleting authentications: %w", err)
+		}
+
+		pipe.Del(ctx, idKey(a.Id))
+		pipe.Del(ctx, tokenByIDKey(a.Id))
+		if hashedToken != "" {
+			pipe.Del(ctx, tokenKey(hashedToken))
+		}
+		pipe.ZRem(ctx, indexKey, a.Id)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("deleting authentications: %w", err)
+	}
+
+	return nil
+}
+
+// metadataMatches reports whether candidate contains every key/value pair present in predicate.
+func metadataMatches(predicate, candidate map[string]string) bool {
+	for k, v := range predicate {
+		if candidate[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ExpireAuthenticationByID attempts to expire an Authentication by ID string and the provided expiry time.
+func (s *Store) ExpireAuthenticationByID(ctx context.Context, id string, expireAt *timestamppb.Timestamp) error {
+	authentication, err := s.getByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("expiring authentication by id: %w", err)
+	}
+
+	authentication.ExpiresAt = expireAt
+
+	data, err := proto.Marshal(authentication)
+	if err != nil {
+		return fmt.Errorf("expiring authentication by id: %w", err)
+	}
+
+	if err := s.rdb.Set(ctx, idKey(id), data, 0).Err(); err != nil {
+		return fmt.Errorf("expiring authentication by id: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.flipt.io/flipt/internal/storage/auth"
+	authtesting "go.flipt.io/flipt/internal/storage/auth/testing"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestAuthenticationStoreHarness(t *testing.T) {
+	authtesting.TestAuthenticationStoreHarness(t, func(t *testing.T) auth.Store {
+		return newStore(t)
+	})
+}
+
+type redisContainer struct {
+	testcontainers.Container
+	host string
+	port string
+}
+
+func setupRedis(ctx context.Context) (*redisContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "redis:latest",
+		ExposedPorts: []string{"6379/tcp"},
+		WaitingFor:   wait.ForLog("* Ready to accept connections"),
+	}
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mappedPort, err := container.MappedPort(ctx, "6379")
+	if err != nil {
+		return nil, err
+	}
+
+	hostIP, err := container.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisContainer{Container: container, host: hostIP, port: mappedPort.Port()}, nil
+}
+
+func newStore(t *testing.T) *Store {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+
+	ctx := context.Background()
+
+	var (
+		redisAddr   = os.Getenv("REDIS_HOST")
+		redisCancel = func(context.Context) error { return nil }
+	)
+
+	if redisAddr == "" {
+		t.Log("Starting redis container.")
+
+		redisContainer, err := setupRedis(ctx)
+		require.NoError(t, err, "Failed to start redis container.")
+
+		redisCancel = redisContainer.Terminate
+		redisAddr = fmt.Sprintf("%s:%s", redisContainer.host, redisContainer.port)
+	}
+
+	rdb := goredis.NewClient(&goredis.Options{
+		Addr: redisAddr,
+	})
+
+	t.Cleanup(func() {
+		_ = rdb.Close()
+		_ = redisCancel(ctx)
+	})
+
+	return NewStore(rdb, zaptest.NewLogger(t))
+}
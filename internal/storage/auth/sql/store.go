@@ -9,6 +9,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/gofrs/uuid"
+	"go.flipt.io/flipt/internal/crypto"
 	"go.flipt.io/flipt/internal/storage"
 	storageauth "go.flipt.io/flipt/internal/storage/auth"
 	storagesql "go.flipt.io/flipt/internal/storage/sql"
@@ -28,6 +29,10 @@ type Store struct {
 
 	generateID    func() string
 	generateToken func() string
+
+	// encryptor, if set, encrypts the metadata column at rest. A nil
+	// encryptor (the default) leaves metadata stored as plain JSON.
+	encryptor crypto.Encryptor
 }
 
 // Option is a type which configures a *Store
@@ -87,6 +92,15 @@ func WithIDGeneratorFunc(fn func() string) Option {
 	}
 }
 
+// WithEncryptor configures the Store to encrypt the metadata column at
+// rest using the provided crypto.Encryptor. When unset, metadata is stored
+// as plain JSON.
+func WithEncryptor(encryptor crypto.Encryptor) Option {
+	return func(s *Store) {
+		s.encryptor = encryptor
+	}
+}
+
 // CreateAuthentication creates and persists an instance of an Authentication.
 func (s *Store) CreateAuthentication(ctx context.Context, r *storageauth.CreateAuthenticationRequest) (string, *rpcauth.Authentication, error) {
 	var (
@@ -126,7 +140,7 @@ func (s *Store) CreateAuthentication(ctx context.Context, r *storageauth.CreateA
 			&authentication.Id,
 			&hashedToken,
 			&authentication.Method,
-			&storagesql.JSONField[map[string]string]{T: authentication.Metadata},
+			&storagesql.EncryptedJSONField[map[string]string]{T: authentication.Metadata, Encryptor: s.encryptor},
 			&storagesql.NullableTimestamp{Timestamp: authentication.ExpiresAt},
 			&storagesql.Timestamp{Timestamp: authentication.CreatedAt},
 			&storagesql.Timestamp{Timestamp: authentication.UpdatedAt},
@@ -286,6 +300,27 @@ func (s *Store) DeleteAuthentications(ctx context.Context, req *storageauth.Dele
 		return err
 	}
 
+	if len(req.Metadata) > 0 {
+		// metadata is stored as an opaque JSON column, so there's no portable cross-dialect
+		// way to filter on it in SQL: select the candidate rows matching the other predicates,
+		// filter them client-side by metadata equality, then delete the matching IDs.
+		ids, err := s.authenticationIDsMatching(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		_, err = s.builder.
+			Delete("authentications").
+			Where(sq.Eq{"id": ids}).
+			ExecContext(ctx)
+
+		return err
+	}
+
 	query := s.builder.
 		Delete("authentications")
 
@@ -308,6 +343,70 @@ func (s *Store) DeleteAuthentications(ctx context.Context, req *storageauth.Dele
 	return
 }
 
+// authenticationIDsMatching returns the IDs of authentications satisfying req.ID, req.Method
+// and req.ExpiredBefore (when set), further filtered in-memory to those whose metadata
+// contains every key/value pair in req.Metadata.
+func (s *Store) authenticationIDsMatching(ctx context.Context, req *storageauth.DeleteAuthenticationsRequest) ([]string, error) {
+	query := s.builder.
+		Select("id", "metadata").
+		From("authentications")
+
+	if req.ID != nil {
+		query = query.Where(sq.Eq{"id": req.ID})
+	}
+
+	if req.Method != nil {
+		query = query.Where(sq.Eq{"method": req.Method})
+	}
+
+	if req.ExpiredBefore != nil {
+		query = query.Where(sq.Lt{
+			"expires_at": &storagesql.Timestamp{Timestamp: req.ExpiredBefore},
+		})
+	}
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var ids []string
+
+	for rows.Next() {
+		var (
+			id       string
+			metadata map[string]string
+		)
+
+		if err := rows.Scan(&id, &storagesql.EncryptedJSONField[*map[string]string]{T: &metadata, Encryptor: s.encryptor}); err != nil {
+			return nil, err
+		}
+
+		if metadataMatches(req.Metadata, metadata) {
+			ids = append(ids, id)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// metadataMatches reports whether candidate contains every key/value pair present in predicate.
+func metadataMatches(predicate, candidate map[string]string) bool {
+	for k, v := range predicate {
+		if candidate[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ExpireAuthenticationByID attempts to expire an Authentication by ID string and the provided expiry time.
 func (s *Store) ExpireAuthenticationByID(ctx context.Context, id string, expireAt *timestamppb.Timestamp) (err error) {
 	defer s.adaptError("expiring authentication by id: %w", &err)
@@ -332,7 +431,7 @@ func (s *Store) scanAuthentication(scanner sq.RowScanner, authentication *rpcaut
 		Scan(
 			&authentication.Id,
 			&authentication.Method,
-			&storagesql.JSONField[*map[string]string]{T: &authentication.Metadata},
+			&storagesql.EncryptedJSONField[*map[string]string]{T: &authentication.Metadata, Encryptor: s.encryptor},
 			&expiresAt,
 			&createdAt,
 			&updatedAt,
@@ -133,6 +133,39 @@ func TestAuthenticationStoreHarness(t *testing.T, fn func(t *testing.T) storagea
 		}
 	})
 
+	t.Run("Delete by metadata", func(t *testing.T) {
+		// create a couple of extra authentications sharing a distinguishing piece of metadata
+		var tagged []authTuple
+		for i := 0; i < 2; i++ {
+			token, auth, err := store.CreateAuthentication(ctx, &storageauth.CreateAuthenticationRequest{
+				Method: auth.Method_METHOD_TOKEN,
+				Metadata: map[string]string{
+					"name":              fmt.Sprintf("tagged_%d", i+1),
+					"service-account":   "ci",
+					"unrelated-for-key": "should-not-affect-match",
+				},
+			})
+			require.NoError(t, err)
+
+			tagged = append(tagged, authTuple{Token: token, Auth: auth})
+		}
+
+		req := storageauth.Delete(storageauth.WithMetadata(map[string]string{"service-account": "ci"}))
+		err := store.DeleteAuthentications(ctx, req)
+		require.NoError(t, err)
+
+		for _, a := range tagged {
+			_, err := store.GetAuthenticationByClientToken(ctx, a.Token)
+			var expected errors.ErrNotFound
+			assert.ErrorAs(t, err, &expected, "tagged authentication still exists in the database")
+		}
+
+		// ensure nothing else was affected
+		all, err := storage.ListAll(ctx, store.ListAuthentications, storage.ListAllParams{})
+		require.NoError(t, err)
+		assert.Equal(t, allAuths(created[:99]), all)
+	})
+
 	t.Run("Delete by method Token with before expired constraint", func(t *testing.T) {
 		// all tokens with expiry [t1, t51)
 		req := storageauth.Delete(
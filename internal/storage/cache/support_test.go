@@ -94,6 +94,16 @@ func (m *storeMock) DeleteVariant(ctx context.Context, r *flipt.DeleteVariantReq
 	return args.Error(0)
 }
 
+func (m *storeMock) CreateVariants(ctx context.Context, reqs []*flipt.CreateVariantRequest) ([]*flipt.Variant, error) {
+	args := m.Called(ctx, reqs)
+	return args.Get(0).([]*flipt.Variant), args.Error(1)
+}
+
+func (m *storeMock) UpdateVariants(ctx context.Context, reqs []*flipt.UpdateVariantRequest) ([]*flipt.Variant, error) {
+	args := m.Called(ctx, reqs)
+	return args.Get(0).([]*flipt.Variant), args.Error(1)
+}
+
 func (m *storeMock) GetSegment(ctx context.Context, namespaceKey string, key string) (*flipt.Segment, error) {
 	args := m.Called(ctx, namespaceKey, key)
 	return args.Get(0).(*flipt.Segment), args.Error(1)
@@ -139,6 +149,26 @@ func (m *storeMock) DeleteConstraint(ctx context.Context, r *flipt.DeleteConstra
 	return args.Error(0)
 }
 
+func (m *storeMock) CreateConstraints(ctx context.Context, reqs []*flipt.CreateConstraintRequest) ([]*flipt.Constraint, error) {
+	args := m.Called(ctx, reqs)
+	return args.Get(0).([]*flipt.Constraint), args.Error(1)
+}
+
+func (m *storeMock) UpdateConstraints(ctx context.Context, reqs []*flipt.UpdateConstraintRequest) ([]*flipt.Constraint, error) {
+	args := m.Called(ctx, reqs)
+	return args.Get(0).([]*flipt.Constraint), args.Error(1)
+}
+
+func (m *storeMock) DeleteConstraints(ctx context.Context, reqs []*flipt.DeleteConstraintRequest) error {
+	args := m.Called(ctx, reqs)
+	return args.Error(0)
+}
+
+func (m *storeMock) OrderConstraints(ctx context.Context, r *storage.OrderConstraintsRequest) error {
+	args := m.Called(ctx, r)
+	return args.Error(0)
+}
+
 func (m *storeMock) ListRollouts(ctx context.Context, namespaceKey string, flagKey string, opts ...storage.QueryOption) (storage.ResultSet[*flipt.Rollout], error) {
 	args := m.Called(ctx, namespaceKey, flagKey, opts)
 	return args.Get(0).(storage.ResultSet[*flipt.Rollout]), args.Error(1)
@@ -189,6 +219,11 @@ func (m *storeMock) CountRules(ctx context.Context, namespaceKey, flagKey string
 	return args.Get(0).(uint64), args.Error(1)
 }
 
+func (m *storeMock) CountRulesByFlag(ctx context.Context, namespaceKey string) (map[string]uint64, error) {
+	args := m.Called(ctx, namespaceKey)
+	return args.Get(0).(map[string]uint64), args.Error(1)
+}
+
 func (m *storeMock) CreateRule(ctx context.Context, r *flipt.CreateRuleRequest) (*flipt.Rule, error) {
 	args := m.Called(ctx, r)
 	return args.Get(0).(*flipt.Rule), args.Error(1)
@@ -209,6 +244,11 @@ func (m *storeMock) OrderRules(ctx context.Context, r *flipt.OrderRulesRequest)
 	return args.Error(0)
 }
 
+func (m *storeMock) ReplaceRules(ctx context.Context, namespaceKey, flagKey string, rules []storage.DraftRule) ([]*flipt.Rule, error) {
+	args := m.Called(ctx, namespaceKey, flagKey, rules)
+	return args.Get(0).([]*flipt.Rule), args.Error(1)
+}
+
 func (m *storeMock) CreateDistribution(ctx context.Context, r *flipt.CreateDistributionRequest) (*flipt.Distribution, error) {
 	args := m.Called(ctx, r)
 	return args.Get(0).(*flipt.Distribution), args.Error(1)
@@ -224,6 +264,11 @@ func (m *storeMock) DeleteDistribution(ctx context.Context, r *flipt.DeleteDistr
 	return args.Error(0)
 }
 
+func (m *storeMock) NormalizeDistributions(ctx context.Context, r *storage.NormalizeDistributionsRequest) ([]*flipt.Distribution, error) {
+	args := m.Called(ctx, r)
+	return args.Get(0).([]*flipt.Distribution), args.Error(1)
+}
+
 func (m *storeMock) GetEvaluationRules(ctx context.Context, namespaceKey string, flagKey string) ([]*storage.EvaluationRule, error) {
 	args := m.Called(ctx, namespaceKey, flagKey)
 	return args.Get(0).([]*storage.EvaluationRule), args.Error(1)
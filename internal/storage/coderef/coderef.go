@@ -0,0 +1,76 @@
+package coderef
+
+import (
+	"context"
+	"time"
+
+	"go.flipt.io/flipt/internal/storage"
+)
+
+// Reference records a single place in source code where a flag key is
+// referenced, so that users can see where a flag is used before deleting it.
+type Reference struct {
+	ID           string
+	NamespaceKey string
+	FlagKey      string
+	Repository   string
+	Path         string
+	Line         int
+	Ref          string
+	CreatedAt    time.Time
+}
+
+// Store persists code references and allows them to be queried by flag.
+type Store interface {
+	// AddReferences persists a batch of code references, as reported by a
+	// single CI scan.
+	AddReferences(ctx context.Context, refs []*AddReferenceRequest) ([]*Reference, error)
+	// ListReferences retrieves the set of code references matching the
+	// provided predicate.
+	ListReferences(ctx context.Context, req *storage.ListRequest[ListReferencesPredicate]) (storage.ResultSet[*Reference], error)
+}
+
+// AddReferenceRequest is the argument passed when recording a single code
+// reference.
+type AddReferenceRequest struct {
+	NamespaceKey string
+	FlagKey      string
+	Repository   string
+	Path         string
+	Line         int
+	// Ref identifies the commit or branch the scan was performed against, if
+	// known.
+	Ref string
+}
+
+// ListReferencesPredicate contains the fields necessary to predicate a list
+// operation on a code reference storage backend.
+type ListReferencesPredicate struct {
+	NamespaceKey *string
+	FlagKey      *string
+	Repository   *string
+}
+
+// ListWithNamespaceKey can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListReferences by namespace.
+func ListWithNamespaceKey(namespaceKey string) storage.ListOption[ListReferencesPredicate] {
+	return func(r *storage.ListRequest[ListReferencesPredicate]) {
+		r.Predicate.NamespaceKey = &namespaceKey
+	}
+}
+
+// ListWithFlagKey can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListReferences by flag key.
+func ListWithFlagKey(flagKey string) storage.ListOption[ListReferencesPredicate] {
+	return func(r *storage.ListRequest[ListReferencesPredicate]) {
+		r.Predicate.FlagKey = &flagKey
+	}
+}
+
+// ListWithRepository can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListReferences by repository.
+func ListWithRepository(repository string) storage.ListOption[ListReferencesPredicate] {
+	return func(r *storage.ListRequest[ListReferencesPredicate]) {
+		r.Predicate.Repository = &repository
+	}
+}
@@ -0,0 +1,194 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+	"go.flipt.io/flipt/internal/storage"
+	storagecoderef "go.flipt.io/flipt/internal/storage/coderef"
+	storagesql "go.flipt.io/flipt/internal/storage/sql"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Store is the persistent storage layer for code references backed by SQL
+// based relational database systems.
+type Store struct {
+	driver  storagesql.Driver
+	builder sq.StatementBuilderType
+
+	generateID func() string
+}
+
+// NewStore constructs and configures a new instance of *Store.
+// Queries are issued to the database via the provided statement builder.
+func NewStore(driver storagesql.Driver, builder sq.StatementBuilderType) *Store {
+	return &Store{
+		driver:  driver,
+		builder: builder,
+		generateID: func() string {
+			return uuid.Must(uuid.NewV4()).String()
+		},
+	}
+}
+
+// AddReferences persists a batch of code references, as reported by a single
+// CI scan.
+func (s *Store) AddReferences(ctx context.Context, reqs []*storagecoderef.AddReferenceRequest) ([]*storagecoderef.Reference, error) {
+	now := time.Now().UTC()
+
+	refs := make([]*storagecoderef.Reference, 0, len(reqs))
+
+	for _, r := range reqs {
+		ref := &storagecoderef.Reference{
+			ID:           s.generateID(),
+			NamespaceKey: r.NamespaceKey,
+			FlagKey:      r.FlagKey,
+			Repository:   r.Repository,
+			Path:         r.Path,
+			Line:         r.Line,
+			Ref:          r.Ref,
+			CreatedAt:    now,
+		}
+
+		if _, err := s.builder.Insert("flag_code_references").
+			Columns(
+				"id",
+				"namespace_key",
+				"flag_key",
+				"repository",
+				"\"path\"",
+				"line",
+				"ref",
+				"created_at",
+			).
+			Values(
+				ref.ID,
+				ref.NamespaceKey,
+				ref.FlagKey,
+				ref.Repository,
+				ref.Path,
+				ref.Line,
+				nullableString(ref.Ref),
+				&storagesql.Timestamp{Timestamp: timestamppb.New(now)},
+			).
+			ExecContext(ctx); err != nil {
+			return nil, fmt.Errorf("adding code reference: %w", s.driver.AdaptError(err))
+		}
+
+		refs = append(refs, ref)
+	}
+
+	return refs, nil
+}
+
+// ListReferences retrieves the set of code references matching the provided
+// predicate.
+func (s *Store) ListReferences(ctx context.Context, req *storage.ListRequest[storagecoderef.ListReferencesPredicate]) (set storage.ResultSet[*storagecoderef.Reference], err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("listing code references: %w", s.driver.AdaptError(err))
+		}
+	}()
+
+	req.QueryParams.Normalize()
+
+	query := s.builder.Select(
+		"id",
+		"namespace_key",
+		"flag_key",
+		"repository",
+		"\"path\"",
+		"line",
+		"ref",
+		"created_at",
+	).
+		From("flag_code_references").
+		Limit(req.QueryParams.Limit + 1).
+		OrderBy(fmt.Sprintf("created_at %s", req.QueryParams.Order))
+
+	if req.Predicate.NamespaceKey != nil {
+		query = query.Where(sq.Eq{"namespace_key": *req.Predicate.NamespaceKey})
+	}
+
+	if req.Predicate.FlagKey != nil {
+		query = query.Where(sq.Eq{"flag_key": *req.Predicate.FlagKey})
+	}
+
+	if req.Predicate.Repository != nil {
+		query = query.Where(sq.Eq{"repository": *req.Predicate.Repository})
+	}
+
+	var offset int
+	if v, err := strconv.ParseInt(req.QueryParams.PageToken, 10, 64); err == nil {
+		offset = int(v)
+		query = query.Offset(uint64(v))
+	}
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return set, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		ref, err := s.scanReference(rows)
+		if err != nil {
+			return set, err
+		}
+
+		if len(set.Results) >= int(req.QueryParams.Limit) {
+			set.NextPageToken = fmt.Sprintf("%d", offset+int(req.QueryParams.Limit))
+			break
+		}
+
+		set.Results = append(set.Results, ref)
+	}
+
+	if err = rows.Err(); err != nil {
+		return set, err
+	}
+
+	return set, nil
+}
+
+func (s *Store) scanReference(scanner sq.RowScanner) (*storagecoderef.Reference, error) {
+	var (
+		ref       storagecoderef.Reference
+		gitRef    *string
+		createdAt storagesql.Timestamp
+	)
+
+	if err := scanner.Scan(
+		&ref.ID,
+		&ref.NamespaceKey,
+		&ref.FlagKey,
+		&ref.Repository,
+		&ref.Path,
+		&ref.Line,
+		&gitRef,
+		&createdAt,
+	); err != nil {
+		return nil, err
+	}
+
+	ref.CreatedAt = createdAt.AsTime()
+
+	if gitRef != nil {
+		ref.Ref = *gitRef
+	}
+
+	return &ref, nil
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,98 @@
+package fs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing/fstest"
+)
+
+// Archive serializes every regular file in f into a gzipped tar stream, so
+// the resulting bytes can be shipped to and reconstituted by another
+// process (e.g. published to a shared cache and consumed by other
+// replicas), without that process needing access to whatever produced f.
+func Archive(f fs.FS) ([]byte, error) {
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	if err := fs.WalkDir(f, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		data, err := fs.ReadFile(f, path)
+		if err != nil {
+			return fmt.Errorf("reading %q: %w", path, err)
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name: path,
+			Size: int64(len(data)),
+			Mode: 0o644,
+		}); err != nil {
+			return fmt.Errorf("writing header for %q: %w", path, err)
+		}
+
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("writing contents for %q: %w", path, err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("archiving fs: %w", err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("closing tar writer: %w", err)
+	}
+
+	if err := gw.Close(); err != nil {
+		return nil, fmt.Errorf("closing gzip writer: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unarchive reconstructs an in-memory fs.FS from bytes produced by Archive.
+func Unarchive(data []byte) (fs.FS, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("opening gzip reader: %w", err)
+	}
+	defer gr.Close()
+
+	out := fstest.MapFS{}
+
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading tar entry: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("reading contents for %q: %w", hdr.Name, err)
+		}
+
+		out[hdr.Name] = &fstest.MapFile{Data: data}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,29 @@
+package fs
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveUnarchive(t *testing.T) {
+	src := fstest.MapFS{
+		".flipt.yml":               &fstest.MapFile{Data: []byte("version: \"1.2\"")},
+		"production/features.yaml": &fstest.MapFile{Data: []byte("namespace: production\nflags: []\n")},
+	}
+
+	data, err := Archive(src)
+	require.NoError(t, err)
+
+	out, err := Unarchive(data)
+	require.NoError(t, err)
+
+	for name, file := range src {
+		got, err := fs.ReadFile(out, name)
+		require.NoError(t, err)
+		assert.Equal(t, file.Data, got)
+	}
+}
@@ -0,0 +1,151 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	iofs "io/fs"
+	"time"
+
+	"go.flipt.io/flipt/internal/cache"
+	fliptfs "go.flipt.io/flipt/internal/storage/fs"
+	"go.flipt.io/flipt/internal/storage/oplock"
+	"go.uber.org/zap"
+)
+
+// Source is an implementation of fliptfs.FSSource which elects a single
+// leader (via lock) among a set of Flipt replicas sharing cacher, to
+// fetch from upstream and publish the resulting tree to cacher. Every
+// replica, including the leader, then builds its fs.FS from that
+// published copy instead of talking to upstream directly. This is
+// intended to sit in front of an expensive or rate-limited FSSource, such
+// as a git remote or object bucket, so that only one replica needs to
+// poll it, and every replica serves an identical snapshot.
+type Source struct {
+	logger   *zap.Logger
+	upstream fliptfs.FSSource
+	lock     oplock.Service
+	cacher   cache.Cacher
+	key      string
+	interval time.Duration
+
+	lastSum string
+}
+
+// New constructs a Source which publishes snapshots fetched from upstream
+// to cacher under key, at most once per interval, guarded by lock so only
+// one replica performs the fetch.
+func New(logger *zap.Logger, upstream fliptfs.FSSource, lock oplock.Service, cacher cache.Cacher, key string, interval time.Duration) *Source {
+	return &Source{
+		logger:   logger,
+		upstream: upstream,
+		lock:     lock,
+		cacher:   cacher,
+		key:      key,
+		interval: interval,
+	}
+}
+
+// Get returns the most recently published snapshot. If nothing has been
+// published yet (e.g. on a cold start, before any replica has won the
+// leader election), it falls back to fetching directly from upstream.
+func (s *Source) Get() (iofs.FS, error) {
+	ctx := context.Background()
+
+	data, ok, err := s.cacher.Get(ctx, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("reading published snapshot: %w", err)
+	}
+
+	if ok {
+		return fliptfs.Unarchive(data)
+	}
+
+	return s.upstream.Get()
+}
+
+// Subscribe runs two concurrent loops for as long as ctx is not cancelled:
+// one which, only while this instance holds the lease for its operation,
+// fetches from upstream and publishes the result to cacher; and another
+// which polls cacher for a changed published snapshot and feeds it onto
+// ch, regardless of whether this instance is the current leader.
+func (s *Source) Subscribe(ctx context.Context, ch chan<- iofs.FS) {
+	defer close(ch)
+
+	operation := oplock.Operation(fmt.Sprintf("snapshot_cache_publish_%s", s.key))
+	go oplock.RunOnSchedule(ctx, s.logger, s.lock, operation, s.interval, s.publish)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f, changed, err := s.poll(ctx)
+			if err != nil {
+				s.logger.Error("failed polling published snapshot", zap.Error(err))
+				continue
+			}
+
+			if !changed {
+				continue
+			}
+
+			ch <- f
+		}
+	}
+}
+
+// publish fetches the latest tree from upstream and writes it to cacher,
+// invoked only while this instance holds the publishing lease.
+func (s *Source) publish(ctx context.Context) {
+	f, err := s.upstream.Get()
+	if err != nil {
+		s.logger.Error("failed fetching upstream snapshot to publish", zap.Error(err))
+		return
+	}
+
+	data, err := fliptfs.Archive(f)
+	if err != nil {
+		s.logger.Error("failed archiving upstream snapshot to publish", zap.Error(err))
+		return
+	}
+
+	if err := s.cacher.Set(ctx, s.key, data); err != nil {
+		s.logger.Error("failed publishing snapshot", zap.Error(err))
+		return
+	}
+
+	s.logger.Debug("published latest snapshot")
+}
+
+// poll checks cacher for a published snapshot which differs from the last
+// one observed, returning the rebuilt fs.FS and changed=true if so.
+func (s *Source) poll(ctx context.Context) (f iofs.FS, changed bool, err error) {
+	data, ok, err := s.cacher.Get(ctx, s.key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	sum := sha256.Sum256(data)
+	sumHex := hex.EncodeToString(sum[:])
+	if sumHex == s.lastSum {
+		return nil, false, nil
+	}
+
+	f, err = fliptfs.Unarchive(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("unarchiving published snapshot: %w", err)
+	}
+
+	s.lastSum = sumHex
+	return f, true, nil
+}
+
+// String returns an identifier string for the store type.
+func (s *Source) String() string {
+	return fmt.Sprintf("cache+%s", s.upstream)
+}
@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"context"
+	"io/fs"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/storage/oplock/memory"
+	"go.uber.org/zap/zaptest"
+)
+
+type fakeUpstream struct {
+	mu  sync.Mutex
+	fs  fs.FS
+	got int
+}
+
+func (f *fakeUpstream) set(m fstest.MapFS) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fs = m
+}
+
+func (f *fakeUpstream) Get() (fs.FS, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.got++
+	return f.fs, nil
+}
+
+func (f *fakeUpstream) Subscribe(ctx context.Context, ch chan<- fs.FS) { close(ch) }
+
+func (f *fakeUpstream) String() string { return "fake" }
+
+type memCacher struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func newMemCacher() *memCacher {
+	return &memCacher{data: map[string][]byte{}}
+}
+
+func (c *memCacher) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.data[key]
+	return v, ok, nil
+}
+
+func (c *memCacher) Set(_ context.Context, key string, value []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data[key] = value
+	return nil
+}
+
+func (c *memCacher) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.data, key)
+	return nil
+}
+
+func (c *memCacher) String() string { return "mem" }
+
+func TestSource_Get_FallsBackToUpstreamWhenUnpublished(t *testing.T) {
+	upstream := &fakeUpstream{fs: fstest.MapFS{".flipt.yml": &fstest.MapFile{Data: []byte("version: \"1.2\"")}}}
+
+	src := New(zaptest.NewLogger(t), upstream, memory.New(), newMemCacher(), "snapshot", time.Second)
+
+	f, err := src.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 1, upstream.got)
+
+	_, err = fs.ReadFile(f, ".flipt.yml")
+	require.NoError(t, err)
+}
+
+func TestSource_Subscribe_PublishesAndFeedsFollowers(t *testing.T) {
+	upstream := &fakeUpstream{fs: fstest.MapFS{".flipt.yml": &fstest.MapFile{Data: []byte("version: \"1.2\"")}}}
+	cacher := newMemCacher()
+	lock := memory.New()
+
+	leader := New(zaptest.NewLogger(t), upstream, lock, cacher, "snapshot", 100*time.Millisecond)
+	follower := New(zaptest.NewLogger(t), upstream, lock, cacher, "snapshot", 100*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	ch := make(chan fs.FS, 1)
+	go leader.Subscribe(ctx, make(chan fs.FS))
+	go follower.Subscribe(ctx, ch)
+
+	select {
+	case f := <-ch:
+		data, err := fs.ReadFile(f, ".flipt.yml")
+		require.NoError(t, err)
+		assert.Equal(t, "version: \"1.2\"", string(data))
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for published snapshot")
+	}
+
+	// only the leader loop should ever have fetched from upstream; the
+	// follower must be served entirely from the cache.
+	assert.Equal(t, "cache+fake", follower.String())
+}
@@ -0,0 +1,122 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"go.flipt.io/flipt/internal/containers"
+)
+
+// Committer writes changes back to a tracked git repository, turning an
+// otherwise read-only git Source into a two-way GitOps store. Each call to
+// Commit performs a fresh, shallow, single-branch clone of the target
+// branch so that it always commits on top of the latest upstream state.
+type Committer struct {
+	url    string
+	branch string
+	auth   transport.AuthMethod
+
+	authorName  string
+	authorEmail string
+}
+
+// WithCommitBranch overrides the branch that changes are committed (and
+// pushed) to. If unset, the Committer commits directly to the ref the
+// associated Source is tracking.
+func WithCommitBranch(branch string) containers.Option[Committer] {
+	return func(c *Committer) {
+		c.branch = branch
+	}
+}
+
+// WithCommitAuthor sets the name and email recorded against commits made by
+// the Committer.
+func WithCommitAuthor(name, email string) containers.Option[Committer] {
+	return func(c *Committer) {
+		c.authorName = name
+		c.authorEmail = email
+	}
+}
+
+// NewCommitter constructs a Committer which writes back to the provided
+// repository URL, authenticating with auth, and committing to branch (the
+// branch tracked by the Source it is paired with).
+func NewCommitter(url, branch string, auth transport.AuthMethod, opts ...containers.Option[Committer]) *Committer {
+	committer := &Committer{
+		url:         url,
+		branch:      branch,
+		auth:        auth,
+		authorName:  "Flipt",
+		authorEmail: "dev@flipt.io",
+	}
+	containers.ApplyAll(committer, opts...)
+
+	return committer
+}
+
+// Commit checks out the tracked branch fresh, applies files (a set of
+// repository-relative paths to their desired contents), and commits +
+// pushes the result with the given message. It returns the hash of the
+// new commit.
+func (c *Committer) Commit(ctx context.Context, message string, files map[string][]byte) (plumbing.Hash, error) {
+	wt := memfs.New()
+
+	repo, err := git.CloneContext(ctx, memory.NewStorage(), wt, &git.CloneOptions{
+		URL:           c.url,
+		Auth:          c.auth,
+		ReferenceName: plumbing.NewBranchReferenceName(c.branch),
+		SingleBranch:  true,
+		Depth:         1,
+	})
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("cloning for write-back: %w", err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	for path, data := range files {
+		if err := util.WriteFile(wt, path, data, 0o644); err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("writing %q: %w", path, err)
+		}
+
+		if _, err := worktree.Add(path); err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("staging %q: %w", path, err)
+		}
+	}
+
+	hash, err := worktree.Commit(message, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  c.authorName,
+			Email: c.authorEmail,
+			When:  time.Now(),
+		},
+	})
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("committing: %w", err)
+	}
+
+	if err := repo.PushContext(ctx, &git.PushOptions{
+		Auth: c.auth,
+		RefSpecs: []config.RefSpec{config.RefSpec(fmt.Sprintf(
+			"%s:%s",
+			plumbing.NewBranchReferenceName(c.branch),
+			plumbing.NewBranchReferenceName(c.branch),
+		))},
+	}); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("pushing write-back commit: %w", err)
+	}
+
+	return hash, nil
+}
@@ -0,0 +1,123 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v32/github"
+	"go.flipt.io/flipt/internal/config"
+	"golang.org/x/oauth2"
+)
+
+// OpenPullRequest opens a pull (or merge) request against the repository
+// identified by url, from head into base, using the given provider API.
+// It returns the URL of the created pull request.
+func OpenPullRequest(ctx context.Context, provider config.PullRequestProvider, token, url, base, head, title, body string) (string, error) {
+	owner, repo, err := ownerRepoFromURL(url)
+	if err != nil {
+		return "", err
+	}
+
+	switch provider {
+	case config.PullRequestProviderGithub:
+		return openGithubPullRequest(ctx, token, owner, repo, base, head, title, body)
+	case config.PullRequestProviderGitlab:
+		return openGitlabMergeRequest(ctx, token, owner, repo, base, head, title, body)
+	default:
+		return "", fmt.Errorf("unsupported pull request provider: %q", provider)
+	}
+}
+
+func openGithubPullRequest(ctx context.Context, token, owner, repo, base, head, title, body string) (string, error) {
+	client := github.NewClient(oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})))
+
+	pr, _, err := client.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+		Title: &title,
+		Body:  &body,
+		Base:  &base,
+		Head:  &head,
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating github pull request: %w", err)
+	}
+
+	return pr.GetHTMLURL(), nil
+}
+
+// openGitlabMergeRequest opens a GitLab merge request via the plain REST
+// API. Flipt does not otherwise depend on a GitLab client library, so this
+// avoids pulling one in for a single call.
+func openGitlabMergeRequest(ctx context.Context, token, owner, repo, base, head, title, body string) (string, error) {
+	project := strings.TrimPrefix(fmt.Sprintf("%s/%s", owner, repo), "/")
+
+	payload, err := json.Marshal(map[string]string{
+		"source_branch": head,
+		"target_branch": base,
+		"title":         title,
+		"description":   body,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("https://gitlab.com/api/v4/projects/%s/merge_requests", url.QueryEscape(project))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("PRIVATE-TOKEN", token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("creating gitlab merge request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return "", fmt.Errorf("creating gitlab merge request: unexpected status %s", resp.Status)
+	}
+
+	var result struct {
+		WebURL string `json:"web_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	return result.WebURL, nil
+}
+
+// ownerRepoFromURL extracts the "owner/repo" pair from a common git remote
+// URL, supporting both HTTPS and SSH forms.
+func ownerRepoFromURL(remote string) (owner, repo string, err error) {
+	trimmed := strings.TrimSuffix(remote, ".git")
+
+	switch {
+	case strings.Contains(trimmed, "://"):
+		parts := strings.SplitN(trimmed, "://", 2)
+		trimmed = parts[1]
+		if idx := strings.Index(trimmed, "/"); idx >= 0 {
+			trimmed = trimmed[idx+1:]
+		}
+	case strings.Contains(trimmed, "@"):
+		parts := strings.SplitN(trimmed, ":", 2)
+		if len(parts) != 2 {
+			return "", "", fmt.Errorf("parsing repository URL: %q", remote)
+		}
+		trimmed = parts[1]
+	}
+
+	segments := strings.Split(trimmed, "/")
+	if len(segments) < 2 {
+		return "", "", fmt.Errorf("parsing owner/repo from URL: %q", remote)
+	}
+
+	return segments[len(segments)-2], segments[len(segments)-1], nil
+}
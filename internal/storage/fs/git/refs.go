@@ -0,0 +1,103 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"testing/fstest"
+
+	"go.flipt.io/flipt/internal/ext"
+	"gopkg.in/yaml.v3"
+)
+
+// mergeRefFS combines the provided ref -> fs.FS mapping into a single
+// fs.FS, materializing each ref's tree under a directory named after its
+// mapped namespace and forcing every state document sourced from that
+// tree to declare that namespace. This lets a single Flipt instance serve
+// multiple refs (e.g. main -> production, develop -> staging) as
+// independent namespaces out of one repository.
+func mergeRefFS(trees map[string]fs.FS) (fs.FS, error) {
+	merged := fstest.MapFS{}
+
+	for ns, tree := range trees {
+		if err := fs.WalkDir(tree, ".", func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+
+			data, err := fs.ReadFile(tree, path)
+			if err != nil {
+				return err
+			}
+
+			if isStateFile(path) {
+				data, err = overrideNamespace(data, ns)
+				if err != nil {
+					return fmt.Errorf("rewriting namespace in %q: %w", path, err)
+				}
+			}
+
+			merged[fmt.Sprintf("%s/%s", ns, path)] = &fstest.MapFile{Data: data}
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("merging namespace %q: %w", ns, err)
+		}
+	}
+
+	return merged, nil
+}
+
+// isStateFile reports whether path looks like a Flipt flag state document,
+// as opposed to the well-known index file (which carries no namespace) or
+// any other file that happens to live in the tree.
+func isStateFile(path string) bool {
+	switch path {
+	case ".flipt.yml", ".flipt.yaml":
+		return false
+	}
+
+	ext := filepath.Ext(path)
+	return ext == ".yml" || ext == ".yaml"
+}
+
+// overrideNamespace decodes each YAML document in data, forces its
+// namespace field to ns and re-encodes the stream.
+func overrideNamespace(data []byte, ns string) ([]byte, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+
+	var any bool
+	for {
+		var doc ext.Document
+		if err := dec.Decode(&doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		doc.Namespace = ns
+		if err := enc.Encode(doc); err != nil {
+			return nil, err
+		}
+		any = true
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	if !any {
+		return data, nil
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,36 @@
+package git
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MergeRefFS(t *testing.T) {
+	merged, err := mergeRefFS(map[string]fs.FS{
+		"production": fstest.MapFS{
+			"features.yml": &fstest.MapFile{Data: []byte("namespace: main\nflags:\n  - key: foo\n")},
+			".flipt.yml":   &fstest.MapFile{Data: []byte("version: \"1.0\"\n")},
+		},
+		"staging": fstest.MapFS{
+			"features.yml": &fstest.MapFile{Data: []byte("flags:\n  - key: foo\n")},
+		},
+	})
+	require.NoError(t, err)
+
+	prod, err := fs.ReadFile(merged, "production/features.yml")
+	require.NoError(t, err)
+	assert.Contains(t, string(prod), "namespace: production\n")
+
+	stage, err := fs.ReadFile(merged, "staging/features.yml")
+	require.NoError(t, err)
+	assert.Contains(t, string(stage), "namespace: staging\n")
+
+	// the index file is left untouched, since it carries no namespace.
+	idx, err := fs.ReadFile(merged, "production/.flipt.yml")
+	require.NoError(t, err)
+	assert.Equal(t, "version: \"1.0\"\n", string(idx))
+}
@@ -7,13 +7,18 @@ import (
 	"io/fs"
 	"time"
 
+	"github.com/go-git/go-billy/v5/osfs"
 	"github.com/go-git/go-git/v5"
+	gocache "github.com/go-git/go-git/v5/plumbing/cache"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/go-git/go-git/v5/storage/filesystem"
 	"github.com/go-git/go-git/v5/storage/memory"
 	"go.flipt.io/flipt/internal/containers"
 	"go.flipt.io/flipt/internal/gitfs"
+	"go.flipt.io/flipt/internal/storage/fs/poll"
 	"go.uber.org/zap"
 )
 
@@ -30,6 +35,25 @@ type Source struct {
 	hash     plumbing.Hash
 	interval time.Duration
 	auth     transport.AuthMethod
+
+	// refs maps additional refs to the namespace they should be
+	// materialized under, enabling branch-per-environment configurations.
+	// When non-empty, it takes precedence over ref/hash.
+	refs map[string]string
+
+	depth        int
+	singleBranch bool
+	clonePath    string
+}
+
+// WithRefs configures a set of refs to be tracked simultaneously, each
+// materialized as its own namespace in the resulting snapshot (e.g.
+// {"main": "production", "develop": "staging"}). When set, it replaces
+// the single ref/hash tracking configured via WithRef.
+func WithRefs(refs map[string]string) containers.Option[Source] {
+	return func(s *Source) {
+		s.refs = refs
+	}
 }
 
 // WithRef configures the target reference to be used when fetching
@@ -63,6 +87,35 @@ func WithAuth(auth transport.AuthMethod) containers.Option[Source] {
 	}
 }
 
+// WithShallowClone configures the source to only fetch the last depth
+// commits of history for the tracked ref(s), rather than the whole history.
+// A depth of zero (the default) performs a full clone.
+func WithShallowClone(depth int) containers.Option[Source] {
+	return func(s *Source) {
+		s.depth = depth
+	}
+}
+
+// WithSingleBranch restricts clones and fetches to only the tracked ref(s),
+// instead of transferring every branch on the remote. This is ignored when
+// multiple refs are configured via WithRefs.
+func WithSingleBranch(singleBranch bool) containers.Option[Source] {
+	return func(s *Source) {
+		s.singleBranch = singleBranch
+	}
+}
+
+// WithClonePath configures an on-disk directory in which to keep the clone
+// of the git repository, instead of holding it entirely in memory. This
+// allows the clone (and any shallow history already fetched) to be reused
+// across restarts, avoiding the cost of a fresh clone every time Flipt
+// starts up against a large repository.
+func WithClonePath(path string) containers.Option[Source] {
+	return func(s *Source) {
+		s.clonePath = path
+	}
+}
+
 // NewSource constructs and configures a Source.
 // The source uses the connection and credential details provided to build
 // fs.FS implementations around a target git repository.
@@ -81,10 +134,29 @@ func NewSource(logger *zap.Logger, url string, opts ...containers.Option[Source]
 	}
 	source.logger = source.logger.With(field)
 
-	source.repo, err = git.Clone(memory.NewStorage(), nil, &git.CloneOptions{
-		Auth: source.auth,
-		URL:  source.url,
-	})
+	cloneOpts := &git.CloneOptions{
+		Auth:  source.auth,
+		URL:   source.url,
+		Depth: source.depth,
+	}
+
+	// single-branch clones don't make sense when tracking multiple refs or a
+	// fixed hash, as either every ref or the entire history containing the
+	// hash needs to be present in the resulting storage.
+	if source.singleBranch && len(source.refs) == 0 && source.hash == plumbing.ZeroHash {
+		cloneOpts.SingleBranch = true
+		cloneOpts.ReferenceName = plumbing.NewBranchReferenceName(source.ref)
+	}
+
+	st := storage.Storer(memory.NewStorage())
+	if source.clonePath != "" {
+		st = filesystem.NewStorage(osfs.New(source.clonePath), gocache.NewObjectLRUDefault())
+	}
+
+	source.repo, err = git.Open(st, nil)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		source.repo, err = git.Clone(st, nil, cloneOpts)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +166,10 @@ func NewSource(logger *zap.Logger, url string, opts ...containers.Option[Source]
 
 // Get builds a new fs.FS based on the configure Git remote and reference.
 func (s *Source) Get() (fs.FS, error) {
+	if len(s.refs) > 0 {
+		return s.getRefs()
+	}
+
 	if s.hash != plumbing.ZeroHash {
 		return gitfs.NewFromRepoHash(s.logger, s.repo, s.hash)
 	}
@@ -101,6 +177,43 @@ func (s *Source) Get() (fs.FS, error) {
 	return gitfs.NewFromRepo(s.logger, s.repo, gitfs.WithReference(plumbing.NewRemoteReferenceName("origin", s.ref)))
 }
 
+// getRefs builds a single fs.FS by resolving every configured ref and
+// merging their trees, each under the namespace it has been mapped to.
+func (s *Source) getRefs() (fs.FS, error) {
+	trees := make(map[string]fs.FS, len(s.refs))
+
+	for ref, ns := range s.refs {
+		tree, err := gitfs.NewFromRepo(s.logger, s.repo, gitfs.WithReference(plumbing.NewRemoteReferenceName("origin", ref)))
+		if err != nil {
+			return nil, fmt.Errorf("resolving ref %q: %w", ref, err)
+		}
+
+		trees[ns] = tree
+	}
+
+	return mergeRefFS(trees)
+}
+
+// refSpecs returns the set of RefSpecs which should be fetched on each poll,
+// tracking every configured ref (or just the single configured ref/hash).
+func (s *Source) refSpecs() []config.RefSpec {
+	refs := s.refs
+	if len(refs) == 0 {
+		refs = map[string]string{s.ref: ""}
+	}
+
+	specs := make([]config.RefSpec, 0, len(refs))
+	for ref := range refs {
+		specs = append(specs, config.RefSpec(fmt.Sprintf(
+			"+%s:%s",
+			plumbing.NewBranchReferenceName(ref),
+			plumbing.NewRemoteReferenceName("origin", ref),
+		)))
+	}
+
+	return specs
+}
+
 // Subscribe feeds gitfs implementations of fs.FS onto the provided channel.
 // It blocks until the provided context is cancelled (it will be called in a goroutine).
 // It closes the provided channel before it returns.
@@ -114,41 +227,46 @@ func (s *Source) Subscribe(ctx context.Context, ch chan<- fs.FS) {
 		return
 	}
 
-	ticker := time.NewTicker(s.interval)
+	backoff := poll.New(s.String(), s.interval)
+	timer := time.NewTimer(backoff.Next())
+	defer timer.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case <-timer.C:
 			s.logger.Debug("fetching from remote")
 			if err := s.repo.Fetch(&git.FetchOptions{
-				Auth: s.auth,
-				RefSpecs: []config.RefSpec{
-					config.RefSpec(fmt.Sprintf(
-						"+%s:%s",
-						plumbing.NewBranchReferenceName(s.ref),
-						plumbing.NewRemoteReferenceName("origin", s.ref),
-					)),
-				},
+				Auth:     s.auth,
+				RefSpecs: s.refSpecs(),
 			}); err != nil {
 				if errors.Is(err, git.NoErrAlreadyUpToDate) {
 					s.logger.Debug("store already up to date")
+					backoff.Success(ctx)
+					timer.Reset(backoff.Next())
 					continue
 				}
 
 				s.logger.Error("failed fetching remote", zap.Error(err))
+				backoff.Failure(ctx)
+				timer.Reset(backoff.Next())
 				continue
 			}
 
 			fs, err := s.Get()
 			if err != nil {
 				s.logger.Error("failed creating gitfs", zap.Error(err))
+				backoff.Failure(ctx)
+				timer.Reset(backoff.Next())
 				continue
 			}
 
+			backoff.Success(ctx)
 			ch <- fs
 
 			s.logger.Debug("finished fetching from remote")
+			timer.Reset(backoff.Next())
 		}
 	}
 }
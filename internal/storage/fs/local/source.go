@@ -4,8 +4,10 @@ import (
 	"context"
 	"io/fs"
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"go.flipt.io/flipt/internal/containers"
 	"go.uber.org/zap"
 )
@@ -17,6 +19,7 @@ type Source struct {
 
 	dir      string
 	interval time.Duration
+	watch    bool
 }
 
 // NewSource constructs a Source.
@@ -25,6 +28,7 @@ func NewSource(logger *zap.Logger, dir string, opts ...containers.Option[Source]
 		logger:   logger,
 		dir:      dir,
 		interval: 10 * time.Second,
+		watch:    true,
 	}
 
 	containers.ApplyAll(s, opts...)
@@ -40,32 +44,96 @@ func WithPollInterval(tick time.Duration) containers.Option[Source] {
 	}
 }
 
+// WithWatch enables or disables fsnotify-based watching of dir for changes,
+// complementing the interval-based polling with near-instant updates. It is
+// enabled by default.
+func WithWatch(watch bool) containers.Option[Source] {
+	return func(s *Source) {
+		s.watch = watch
+	}
+}
+
 // Get returns an fs.FS for the local filesystem.
 func (s *Source) Get() (fs.FS, error) {
 	return os.DirFS(s.dir), nil
 }
 
 // Subscribe feeds local fs.FS implementations onto the provided channel.
-// It blocks until the provided context is cancelled.
+// It blocks until the provided context is cancelled. When watching is
+// enabled, changes are additionally picked up via fsnotify, complementing
+// the interval-based polling with near-instant updates for local development.
 func (s *Source) Subscribe(ctx context.Context, ch chan<- fs.FS) {
 	defer close(ch)
 
+	var notify <-chan fsnotify.Event
+	if s.watch {
+		watcher, err := s.newWatcher()
+		if err != nil {
+			s.logger.Error("error starting file watcher, falling back to polling only", zap.Error(err))
+		} else {
+			defer watcher.Close()
+			notify = watcher.Events
+			go func() {
+				for err := range watcher.Errors {
+					s.logger.Error("file watcher error", zap.Error(err))
+				}
+			}()
+		}
+	}
+
 	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-notify:
+			s.logger.Debug("detected local file change, updating store snapshot")
+			s.publish(ch)
 		case <-ticker.C:
-			fs, err := s.Get()
-			if err != nil {
-				s.logger.Error("error getting file system from directory", zap.Error(err))
-				continue
-			}
-
-			s.logger.Debug("updating local store snapshot")
-			ch <- fs
+			s.publish(ch)
+		}
+	}
+}
+
+// publish pushes a fresh fs.FS built from the local directory onto ch.
+func (s *Source) publish(ch chan<- fs.FS) {
+	f, err := s.Get()
+	if err != nil {
+		s.logger.Error("error getting file system from directory", zap.Error(err))
+		return
+	}
+
+	s.logger.Debug("updating local store snapshot")
+	ch <- f
+}
+
+// newWatcher constructs an fsnotify.Watcher watching s.dir and all of its
+// subdirectories, so that changes to nested flag state files are observed.
+func (s *Source) newWatcher() (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	err = filepath.WalkDir(s.dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return watcher.Add(path)
 		}
+
+		return nil
+	})
+	if err != nil {
+		watcher.Close()
+		return nil, err
 	}
+
+	return watcher, nil
 }
 
 // String returns an identifier string for the store type.
@@ -0,0 +1,27 @@
+package fs
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.flipt.io/flipt/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	metricsNamespace = "flipt"
+	metricsSubsystem = "source"
+)
+
+// snapshotBuildErrors is a gauge-like counter reporting, per source,
+// whether the most recent attempt to build a snapshot failed (1) or
+// succeeded (0), so a bad declarative flag file is visible to operators
+// and CI without tailing logs.
+var snapshotBuildErrors = metrics.MustInt64().
+	UpDownCounter(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "snapshot_build_errors"),
+		metric.WithDescription("Whether the most recent declarative snapshot build failed (1) or succeeded (0)"),
+	)
+
+func sourceAttributeSet(source string) attribute.Set {
+	return attribute.NewSet(attribute.Key("source").String(source))
+}
@@ -0,0 +1,102 @@
+// Package poll provides a shared backoff helper used by remote FSSource
+// implementations (e.g. git, object storage) to space out polling of an
+// upstream origin.
+package poll
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.flipt.io/flipt/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	namespace = "flipt"
+	subsystem = "source"
+
+	// jitterFraction is how much a poll delay is allowed to vary, as a
+	// fraction of the configured interval, so that a fleet of replicas
+	// configured with the same interval don't all poll in lockstep.
+	jitterFraction = 0.2
+
+	// maxBackoffMultiplier caps how many multiples of the configured
+	// interval a run of consecutive failures can back off to.
+	maxBackoffMultiplier = 32
+)
+
+// ConsecutiveFailures is a gauge-like counter of how many polls in a row
+// have failed for a given source, reset to zero on the next successful
+// poll, so operators can alert on a struggling origin before it causes
+// stale snapshots.
+var ConsecutiveFailures = metrics.MustInt64().
+	UpDownCounter(
+		prometheus.BuildFQName(namespace, subsystem, "poll_consecutive_failures"),
+		metric.WithDescription("The number of consecutive failed polls against a remote source"),
+	)
+
+// Backoff computes the delay before the next poll attempt against a
+// remote source, jittering the configured interval and backing off
+// exponentially (up to a cap) across consecutive failures.
+type Backoff struct {
+	source   string
+	interval time.Duration
+	failures int
+}
+
+// New constructs a Backoff which jitters around interval, identifying
+// itself as source in the consecutive-failures metric.
+func New(source string, interval time.Duration) *Backoff {
+	return &Backoff{source: source, interval: interval}
+}
+
+// Next returns the delay to wait before the next poll attempt, taking
+// into account any run of consecutive failures recorded via Failure.
+func (b *Backoff) Next() time.Duration {
+	if b.failures == 0 {
+		return jitter(b.interval)
+	}
+
+	multiplier := int64(1) << uint(b.failures)
+	if multiplier > maxBackoffMultiplier {
+		multiplier = maxBackoffMultiplier
+	}
+
+	return jitter(b.interval * time.Duration(multiplier))
+}
+
+// Success records a successful poll, resetting the backoff so the next
+// delay returns to the configured interval.
+func (b *Backoff) Success(ctx context.Context) {
+	if b.failures == 0 {
+		return
+	}
+
+	ConsecutiveFailures.Add(ctx, -int64(b.failures), metric.WithAttributeSet(b.attributeSet()))
+	b.failures = 0
+}
+
+// Failure records a failed poll, growing the backoff applied by the next
+// call to Next.
+func (b *Backoff) Failure(ctx context.Context) {
+	b.failures++
+	ConsecutiveFailures.Add(ctx, 1, metric.WithAttributeSet(b.attributeSet()))
+}
+
+func (b *Backoff) attributeSet() attribute.Set {
+	return attribute.NewSet(attribute.Key("source").String(b.source))
+}
+
+// jitter returns d adjusted by up to +/- jitterFraction, picked uniformly
+// at random.
+func jitter(d time.Duration) time.Duration {
+	delta := time.Duration(float64(d) * jitterFraction)
+	if delta <= 0 {
+		return d
+	}
+
+	return d - delta + time.Duration(rand.Int63n(2*int64(delta)+1))
+}
@@ -0,0 +1,44 @@
+package poll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_NextWithinJitterBounds(t *testing.T) {
+	b := New("test", 10*time.Second)
+
+	for i := 0; i < 100; i++ {
+		next := b.Next()
+		assert.GreaterOrEqual(t, next, 8*time.Second)
+		assert.LessOrEqual(t, next, 12*time.Second)
+	}
+}
+
+func TestBackoff_FailureGrowsDelayUpToCap(t *testing.T) {
+	ctx := context.Background()
+	b := New("test", time.Second)
+
+	for i := 0; i < 10; i++ {
+		b.Failure(ctx)
+	}
+
+	next := b.Next()
+	assert.LessOrEqual(t, next, time.Duration(float64(maxBackoffMultiplier)*float64(time.Second)*(1+jitterFraction)))
+	assert.Greater(t, next, 10*time.Second)
+}
+
+func TestBackoff_SuccessResetsDelay(t *testing.T) {
+	ctx := context.Background()
+	b := New("test", time.Second)
+
+	b.Failure(ctx)
+	b.Failure(ctx)
+	b.Success(ctx)
+
+	next := b.Next()
+	assert.LessOrEqual(t, next, time.Second+time.Duration(float64(time.Second)*jitterFraction)+time.Millisecond)
+}
@@ -2,16 +2,26 @@ package s3
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"fmt"
 	"io/fs"
+	"net/http"
+	"os"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	"go.flipt.io/flipt/internal/containers"
 	"go.flipt.io/flipt/internal/s3fs"
+	"go.flipt.io/flipt/internal/storage/fs/poll"
 	"go.uber.org/zap"
 )
 
@@ -26,6 +36,19 @@ type Source struct {
 	bucket   string
 	prefix   string
 	interval time.Duration
+	lastETag string
+
+	caPath    string
+	pathStyle bool
+
+	roleARN              string
+	roleSessionName      string
+	roleExternalID       string
+	webIdentityTokenFile string
+
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
 }
 
 // NewSource constructs a Source.
@@ -56,16 +79,85 @@ func NewSource(logger *zap.Logger, bucket string, opts ...containers.Option[Sour
 		})
 		s3opts = append(s3opts, config.WithEndpointResolverWithOptions(customResolver))
 	}
+
+	if s.accessKeyID != "" {
+		s3opts = append(s3opts, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(s.accessKeyID, s.secretAccessKey, s.sessionToken),
+		))
+	}
+
+	if s.caPath != "" {
+		httpClient, err := newHTTPClient(s.caPath)
+		if err != nil {
+			return nil, err
+		}
+		s3opts = append(s3opts, config.WithHTTPClient(httpClient))
+	}
+
 	cfg, err := config.LoadDefaultConfig(context.Background(),
 		s3opts...)
 	if err != nil {
 		return nil, err
 	}
-	s.s3 = s3.NewFromConfig(cfg)
+
+	if s.roleARN != "" {
+		stsClient := sts.NewFromConfig(cfg)
+
+		var provider aws.CredentialsProvider
+		if s.webIdentityTokenFile != "" {
+			provider = stscreds.NewWebIdentityRoleProvider(stsClient, s.roleARN,
+				stscreds.IdentityTokenFile(s.webIdentityTokenFile),
+				func(o *stscreds.WebIdentityRoleOptions) {
+					if s.roleSessionName != "" {
+						o.RoleSessionName = s.roleSessionName
+					}
+				},
+			)
+		} else {
+			provider = stscreds.NewAssumeRoleProvider(stsClient, s.roleARN, func(o *stscreds.AssumeRoleOptions) {
+				if s.roleSessionName != "" {
+					o.RoleSessionName = s.roleSessionName
+				}
+				if s.roleExternalID != "" {
+					o.ExternalID = aws.String(s.roleExternalID)
+				}
+			})
+		}
+
+		cfg.Credentials = aws.NewCredentialsCache(provider)
+	}
+
+	s.s3 = s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = s.pathStyle
+	})
 
 	return s, nil
 }
 
+// newHTTPClient builds an HTTP client trusting both the system roots and
+// the PEM encoded certificate authority bundle at caPath, for use with
+// S3-compatible endpoints presenting a certificate signed by a private CA.
+func newHTTPClient(caPath string) (*http.Client, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	pem, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading s3 ca_path: %w", err)
+	}
+
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in s3 ca_path %q", caPath)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	return &http.Client{Transport: transport}, nil
+}
+
 // WithPrefix configures the prefix for s3
 func WithPrefix(prefix string) containers.Option[Source] {
 	return func(s *Source) {
@@ -95,6 +187,67 @@ func WithPollInterval(tick time.Duration) containers.Option[Source] {
 	}
 }
 
+// WithRoleARN configures an IAM role for the source to assume via STS
+// before accessing the bucket.
+func WithRoleARN(arn string) containers.Option[Source] {
+	return func(s *Source) {
+		s.roleARN = arn
+	}
+}
+
+// WithRoleSessionName configures the session name used when assuming
+// RoleARN.
+func WithRoleSessionName(name string) containers.Option[Source] {
+	return func(s *Source) {
+		s.roleSessionName = name
+	}
+}
+
+// WithRoleExternalID configures the external ID used when assuming
+// RoleARN.
+func WithRoleExternalID(id string) containers.Option[Source] {
+	return func(s *Source) {
+		s.roleExternalID = id
+	}
+}
+
+// WithWebIdentityTokenFile configures the source to assume RoleARN using
+// the OIDC web identity token found at the given file path, instead of
+// the default AssumeRole credential provider.
+func WithWebIdentityTokenFile(file string) containers.Option[Source] {
+	return func(s *Source) {
+		s.webIdentityTokenFile = file
+	}
+}
+
+// WithCAPath configures a PEM encoded certificate authority bundle to
+// trust in addition to the system roots.
+func WithCAPath(path string) containers.Option[Source] {
+	return func(s *Source) {
+		s.caPath = path
+	}
+}
+
+// WithPathStyle forces path-style bucket addressing instead of the
+// default virtual-hosted style, as required by most S3-compatible
+// object stores.
+func WithPathStyle(pathStyle bool) containers.Option[Source] {
+	return func(s *Source) {
+		s.pathStyle = pathStyle
+	}
+}
+
+// WithStaticCredentials configures the source to authenticate using a
+// fixed access key ID, secret access key, and optional session token,
+// instead of the default credential chain.
+func WithStaticCredentials(accessKeyID, secretAccessKey, sessionToken string) containers.Option[Source] {
+	return func(s *Source) {
+		s.accessKeyID = accessKeyID
+		s.secretAccessKey = secretAccessKey
+		s.sessionToken = sessionToken
+	}
+}
+
 // Get returns an fs.FS for the local filesystem.
 func (s *Source) Get() (fs.FS, error) {
 	return s3fs.New(s.logger, s.s3, s.bucket, s.prefix)
@@ -105,24 +258,87 @@ func (s *Source) Get() (fs.FS, error) {
 func (s *Source) Subscribe(ctx context.Context, ch chan<- fs.FS) {
 	defer close(ch)
 
-	ticker := time.NewTicker(s.interval)
+	backoff := poll.New(s.String(), s.interval)
+	timer := time.NewTimer(backoff.Next())
+	defer timer.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case <-timer.C:
+			tag, err := s.etag(ctx)
+			if err != nil {
+				s.logger.Error("error checking bucket for changes", zap.Error(err))
+				backoff.Failure(ctx)
+				timer.Reset(backoff.Next())
+				continue
+			}
+
+			backoff.Success(ctx)
+
+			if tag == s.lastETag {
+				s.logger.Debug("s3 bucket unchanged, skipping snapshot rebuild")
+				timer.Reset(backoff.Next())
+				continue
+			}
+
 			fs, err := s.Get()
 			if err != nil {
 				s.logger.Error("error getting file system from directory", zap.Error(err))
+				timer.Reset(backoff.Next())
 				continue
 			}
 
+			s.lastETag = tag
 			s.logger.Debug("updating local store snapshot")
 			ch <- fs
+			timer.Reset(backoff.Next())
 		}
 	}
 }
 
+// etag computes a fingerprint of the bucket's current state by combining
+// the key and ETag of every object under prefix. Comparing this against
+// the value observed on the previous poll lets Subscribe skip rebuilding
+// the snapshot when nothing in the bucket has changed.
+func (s *Source) etag(ctx context.Context) (string, error) {
+	var prefix *string
+	if s.prefix != "" {
+		prefix = &s.prefix
+	}
+
+	h := sha256.New()
+
+	var continuationToken *string
+	for {
+		output, err := s.s3.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket:            &s.bucket,
+			Prefix:            prefix,
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		for _, obj := range output.Contents {
+			if obj.Key != nil {
+				h.Write([]byte(*obj.Key))
+			}
+			if obj.ETag != nil {
+				h.Write([]byte(*obj.ETag))
+			}
+		}
+
+		if !output.IsTruncated {
+			break
+		}
+		continuationToken = output.NextContinuationToken
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // String returns an identifier string for the store type.
 func (s *Source) String() string {
 	return "s3"
@@ -24,8 +24,9 @@ import (
 )
 
 const (
-	indexFile = ".flipt.yml"
-	defaultNs = "default"
+	indexFile     = ".flipt.yml"
+	indexFileJSON = ".flipt.json"
+	defaultNs     = "default"
 )
 
 var (
@@ -164,11 +165,17 @@ func listStateFiles(logger *zap.Logger, source fs.FS) ([]string, error) {
 		Version: "1.0",
 		Include: []string{
 			"**features.yml", "**features.yaml", "**.features.yml", "**.features.yaml",
+			"**features.json", "**.features.json",
 		},
 	}
 
-	// Read index file
+	// Read index file, falling back to the JSON variant if the YAML one
+	// does not exist.
 	inFile, err := source.Open(indexFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		inFile, err = source.Open(indexFileJSON)
+	}
+
 	if err == nil {
 		if derr := yaml.NewDecoder(inFile).Decode(&idx); derr != nil {
 			return nil, fmt.Errorf("yaml: %w", derr)
@@ -220,7 +227,7 @@ func listStateFiles(logger *zap.Logger, source fs.FS) ([]string, error) {
 		for _, g := range idx.Exclude {
 			glob, err := glob.Compile(g)
 			if err != nil {
-				return nil, fmt.Errorf("compiling include glob: %w", err)
+				return nil, fmt.Errorf("compiling exclude glob: %w", err)
 			}
 
 			excludes = append(excludes, glob)
@@ -240,6 +247,23 @@ func listStateFiles(logger *zap.Logger, source fs.FS) ([]string, error) {
 	return filenames, nil
 }
 
+// resolveSegment looks up key in ns, falling back to storage.GlobalNamespace
+// so a flag's rules can reference a shared segment without ns having its own
+// copy. Note: because documents are loaded in a single pass, the global
+// namespace's document must be listed (and therefore loaded) before any
+// namespace that references one of its segments.
+func (ss *StoreSnapshot) resolveSegment(ns *namespace, key string) *flipt.Segment {
+	if segment := ns.segments[key]; segment != nil {
+		return segment
+	}
+
+	if global := ss.ns[storage.GlobalNamespace]; global != nil {
+		return global.segments[key]
+	}
+
+	return nil
+}
+
 func (ss *StoreSnapshot) addDoc(doc *ext.Document) error {
 	ns := ss.ns[doc.Namespace]
 	if ns == nil {
@@ -252,6 +276,10 @@ func (ss *StoreSnapshot) addDoc(doc *ext.Document) error {
 	}
 
 	for _, s := range doc.Segments {
+		if _, exists := ns.segments[s.Key]; exists {
+			return errs.ErrInvalidf("duplicate segment %q in namespace %q", s.Key, doc.Namespace)
+		}
+
 		matchType := flipt.MatchType_value[s.MatchType]
 		segment := &flipt.Segment{
 			NamespaceKey: doc.Namespace,
@@ -283,6 +311,10 @@ func (ss *StoreSnapshot) addDoc(doc *ext.Document) error {
 	}
 
 	for _, f := range doc.Flags {
+		if _, exists := ns.flags[f.Key]; exists {
+			return errs.ErrInvalidf("duplicate flag %q in namespace %q", f.Key, doc.Namespace)
+		}
+
 		flagType := flipt.FlagType_value[f.Type]
 		flag := &flipt.Flag{
 			NamespaceKey: doc.Namespace,
@@ -295,7 +327,13 @@ func (ss *StoreSnapshot) addDoc(doc *ext.Document) error {
 			UpdatedAt:    ss.now,
 		}
 
+		seenVariants := make(map[string]struct{}, len(f.Variants))
 		for _, v := range f.Variants {
+			if _, exists := seenVariants[v.Key]; exists {
+				return errs.ErrInvalidf("duplicate variant %q for flag %q in namespace %q", v.Key, f.Key, doc.Namespace)
+			}
+			seenVariants[v.Key] = struct{}{}
+
 			attachment, err := json.Marshal(v.Attachment)
 			if err != nil {
 				return err
@@ -356,7 +394,7 @@ func (ss *StoreSnapshot) addDoc(doc *ext.Document) error {
 			}
 
 			for _, segmentKey := range segmentKeys {
-				segment := ns.segments[segmentKey]
+				segment := ss.resolveSegment(ns, segmentKey)
 				if segment == nil {
 					return errs.ErrInvalidf("flag %s/%s rule %d references unknown segment %q", doc.Namespace, flag.Key, rank, segmentKey)
 				}
@@ -386,12 +424,18 @@ func (ss *StoreSnapshot) addDoc(doc *ext.Document) error {
 
 			evalRules = append(evalRules, evalRule)
 
+			var totalRollout float32
 			for _, d := range r.Distributions {
 				variant, found := findByKey(d.VariantKey, flag.Variants...)
 				if !found {
 					return errs.ErrInvalidf("flag %s/%s rule %d references unknown variant %q", doc.Namespace, flag.Key, rank, d.VariantKey)
 				}
 
+				totalRollout += d.Rollout
+				if totalRollout > 100 {
+					return errs.ErrInvalidf("flag %s/%s rule %d distribution rollout percentages sum to more than 100", doc.Namespace, flag.Key, rank)
+				}
+
 				id := uuid.Must(uuid.NewV4()).String()
 				rule.Distributions = append(rule.Distributions, &flipt.Distribution{
 					Id:        id,
@@ -577,6 +621,20 @@ func (ss *StoreSnapshot) CountRules(ctx context.Context, namespaceKey, flagKey s
 	return count, nil
 }
 
+func (ss *StoreSnapshot) CountRulesByFlag(ctx context.Context, namespaceKey string) (map[string]uint64, error) {
+	ns, err := ss.getNamespace(namespaceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]uint64)
+	for _, rule := range ns.rules {
+		counts[rule.FlagKey]++
+	}
+
+	return counts, nil
+}
+
 func (ss *StoreSnapshot) CreateRule(ctx context.Context, r *flipt.CreateRuleRequest) (*flipt.Rule, error) {
 	return nil, ErrNotImplemented
 }
@@ -593,6 +651,10 @@ func (ss *StoreSnapshot) OrderRules(ctx context.Context, r *flipt.OrderRulesRequ
 	return ErrNotImplemented
 }
 
+func (ss *StoreSnapshot) ReplaceRules(ctx context.Context, namespaceKey, flagKey string, rules []storage.DraftRule) ([]*flipt.Rule, error) {
+	return nil, ErrNotImplemented
+}
+
 func (ss *StoreSnapshot) CreateDistribution(ctx context.Context, r *flipt.CreateDistributionRequest) (*flipt.Distribution, error) {
 	return nil, ErrNotImplemented
 }
@@ -605,6 +667,10 @@ func (ss *StoreSnapshot) DeleteDistribution(ctx context.Context, r *flipt.Delete
 	return ErrNotImplemented
 }
 
+func (ss *StoreSnapshot) NormalizeDistributions(ctx context.Context, r *storage.NormalizeDistributionsRequest) ([]*flipt.Distribution, error) {
+	return nil, ErrNotImplemented
+}
+
 func (ss *StoreSnapshot) GetSegment(ctx context.Context, namespaceKey string, key string) (*flipt.Segment, error) {
 	ns, err := ss.getNamespace(namespaceKey)
 	if err != nil {
@@ -668,6 +734,22 @@ func (ss *StoreSnapshot) DeleteConstraint(ctx context.Context, r *flipt.DeleteCo
 	return ErrNotImplemented
 }
 
+func (ss *StoreSnapshot) CreateConstraints(ctx context.Context, reqs []*flipt.CreateConstraintRequest) ([]*flipt.Constraint, error) {
+	return nil, ErrNotImplemented
+}
+
+func (ss *StoreSnapshot) UpdateConstraints(ctx context.Context, reqs []*flipt.UpdateConstraintRequest) ([]*flipt.Constraint, error) {
+	return nil, ErrNotImplemented
+}
+
+func (ss *StoreSnapshot) DeleteConstraints(ctx context.Context, reqs []*flipt.DeleteConstraintRequest) error {
+	return ErrNotImplemented
+}
+
+func (ss *StoreSnapshot) OrderConstraints(ctx context.Context, r *storage.OrderConstraintsRequest) error {
+	return ErrNotImplemented
+}
+
 func (ss *StoreSnapshot) GetNamespace(ctx context.Context, key string) (*flipt.Namespace, error) {
 	ns, err := ss.getNamespace(key)
 	if err != nil {
@@ -767,6 +849,14 @@ func (ss *StoreSnapshot) DeleteVariant(ctx context.Context, r *flipt.DeleteVaria
 	return ErrNotImplemented
 }
 
+func (ss *StoreSnapshot) CreateVariants(ctx context.Context, reqs []*flipt.CreateVariantRequest) ([]*flipt.Variant, error) {
+	return nil, ErrNotImplemented
+}
+
+func (ss *StoreSnapshot) UpdateVariants(ctx context.Context, reqs []*flipt.UpdateVariantRequest) ([]*flipt.Variant, error) {
+	return nil, ErrNotImplemented
+}
+
 func (ss *StoreSnapshot) GetEvaluationRules(ctx context.Context, namespaceKey string, flagKey string) ([]*storage.EvaluationRule, error) {
 	ns, ok := ss.ns[namespaceKey]
 	if !ok {
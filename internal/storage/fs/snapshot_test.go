@@ -1644,6 +1644,36 @@ func (fis *FSWithoutIndexSuite) TestListAndGetRules() {
 	}
 }
 
+func TestFS_Invalid_ExcludeGlob(t *testing.T) {
+	fs, _ := fs.Sub(testdata, "fixtures/invalid_exclude_glob")
+	_, err := listStateFiles(zap.NewNop(), fs)
+	require.ErrorContains(t, err, "compiling exclude glob")
+}
+
+func TestFS_Invalid_DuplicateFlag(t *testing.T) {
+	fs, _ := fs.Sub(testdata, "fixtures/duplicate_flag")
+	_, err := SnapshotFromFS(zaptest.NewLogger(t), fs)
+	require.EqualError(t, err, `duplicate flag "flag1" in namespace "default"`)
+}
+
+func TestFS_Invalid_DuplicateSegment(t *testing.T) {
+	fs, _ := fs.Sub(testdata, "fixtures/duplicate_segment")
+	_, err := SnapshotFromFS(zaptest.NewLogger(t), fs)
+	require.EqualError(t, err, `duplicate segment "segment1" in namespace "default"`)
+}
+
+func TestFS_Invalid_DuplicateVariant(t *testing.T) {
+	fs, _ := fs.Sub(testdata, "fixtures/duplicate_variant")
+	_, err := SnapshotFromFS(zaptest.NewLogger(t), fs)
+	require.EqualError(t, err, `duplicate variant "variant1" for flag "flag1" in namespace "default"`)
+}
+
+func TestFS_Invalid_RolloutSumExceeds100(t *testing.T) {
+	fs, _ := fs.Sub(testdata, "fixtures/rollout_sum_exceeds")
+	_, err := SnapshotFromFS(zaptest.NewLogger(t), fs)
+	require.EqualError(t, err, "flag fruit/apple rule 1 distribution rollout percentages sum to more than 100")
+}
+
 func TestFS_Invalid_VariantFlag_Segment(t *testing.T) {
 	fs, _ := fs.Sub(testdata, "fixtures/invalid_variant_flag_segment")
 	_, err := SnapshotFromFS(zaptest.NewLogger(t), fs)
@@ -1706,3 +1736,20 @@ func TestFS_YAML_Stream(t *testing.T) {
 	assert.Len(t, frsegments.Results, 1)
 	assert.Equal(t, "internal", frsegments.Results[0].Key)
 }
+
+func TestFS_JSON_Features(t *testing.T) {
+	fs, _ := fs.Sub(testdata, "fixtures/json_features")
+
+	filenames, err := listStateFiles(zap.NewNop(), fs)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, filenames, []string{"prod/features.json"})
+
+	ss, err := SnapshotFromFS(zaptest.NewLogger(t), fs)
+	require.NoError(t, err)
+
+	flags, err := ss.ListFlags(context.TODO(), "production")
+	require.NoError(t, err)
+
+	assert.Len(t, flags.Results, 1)
+	assert.Equal(t, "json-flag-1", flags.Results[0].Key)
+}
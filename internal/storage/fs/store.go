@@ -2,13 +2,26 @@ package fs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/fs"
 	"path"
+	"sync"
+	"time"
 
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/containers"
+	"go.flipt.io/flipt/internal/cue"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 )
 
+// ErrSnapshotUnavailable is returned by read operations once the
+// declarative snapshot build has been failing for longer than the
+// configured SnapshotErrorPolicyConfig allows it to keep serving the last
+// known-good snapshot.
+var ErrSnapshotUnavailable = errors.New("flipt: declarative snapshot is unavailable due to repeated build failures")
+
 // FSSource produces implementations of fs.FS.
 // A single FS can be produced via Get or a channel
 // may be provided to Subscribe in order to received
@@ -41,10 +54,62 @@ type Store struct {
 
 	cancel context.CancelFunc
 	done   chan struct{}
+
+	errorPolicy config.SnapshotErrorPolicyConfig
+
+	statusMu sync.RWMutex
+	status   SnapshotStatus
+	// failingSince is when the current run of failed builds began, or the
+	// zero Time if the most recent build succeeded. It's used to measure
+	// staleness independent of how often builds are retried.
+	failingSince time.Time
+}
+
+// WithSnapshotErrorPolicy configures how the store behaves once its
+// declarative snapshot build starts failing, per cfg. The default policy
+// (the zero value's fail_open mode) preserves Flipt's historical
+// behavior of serving the last known-good snapshot indefinitely.
+func WithSnapshotErrorPolicy(cfg config.SnapshotErrorPolicyConfig) containers.Option[Store] {
+	return func(s *Store) {
+		s.errorPolicy = cfg
+	}
+}
+
+// Refresher is implemented by stores which support being forced to
+// immediately re-fetch their source and rebuild their snapshot, rather
+// than waiting on whatever polling interval they are configured with.
+type Refresher interface {
+	// Update forces the store to fetch a new snapshot from its source.
+	Update(context.Context) error
+}
+
+// SnapshotStatusReporter is implemented by stores which can report the
+// outcome of their most recent attempt to build a snapshot, so it can be
+// surfaced to operators independent of logs.
+type SnapshotStatusReporter interface {
+	// SnapshotStatus returns the outcome of the most recent snapshot build.
+	SnapshotStatus() SnapshotStatus
+}
+
+// SnapshotStatus reports the outcome of the most recent attempt to build a
+// snapshot from a store's source, so that a declarative flag file error
+// (e.g. a bad git/object/local update) can be surfaced through the meta
+// status endpoint and metrics, instead of only being logged.
+type SnapshotStatus struct {
+	// Success reports whether the most recent snapshot build succeeded.
+	Success bool `json:"success"`
+	// At is when the most recent snapshot build attempt completed.
+	At time.Time `json:"at"`
+	// Message is the error produced by the most recent failed build, if any.
+	Message string `json:"message,omitempty"`
+	// Errors contains the individual, file/line-located validation errors
+	// which made up Message, when the failure came from cue validation.
+	Errors []cue.Error `json:"errors,omitempty"`
 }
 
 func (l *Store) updateSnapshot(fs fs.FS) error {
 	storeSnapshot, err := SnapshotFromFS(l.logger, fs)
+	l.recordStatus(err)
 	if err != nil {
 		return err
 	}
@@ -64,9 +129,83 @@ func (l *Store) updateSnapshot(fs fs.FS) error {
 	return nil
 }
 
+// SnapshotStatus returns the outcome of the most recent attempt to build a
+// snapshot from the store's source.
+func (l *Store) SnapshotStatus() SnapshotStatus {
+	l.statusMu.RLock()
+	defer l.statusMu.RUnlock()
+
+	return l.status
+}
+
+// recordStatus updates the store's SnapshotStatus to reflect the outcome of
+// the build attempt which produced err (nil on success), and adjusts the
+// snapshotBuildErrors gauge when the failing/healthy state changes.
+func (l *Store) recordStatus(err error) {
+	status := SnapshotStatus{At: time.Now(), Success: err == nil}
+
+	if err != nil {
+		status.Message = err.Error()
+
+		unwrapped, ok := cue.Unwrap(err)
+		if !ok {
+			unwrapped = []error{err}
+		}
+
+		for _, e := range unwrapped {
+			var cerr cue.Error
+			if errors.As(e, &cerr) {
+				status.Errors = append(status.Errors, cerr)
+			}
+		}
+	}
+
+	l.statusMu.Lock()
+	wasFailing := !l.status.Success && !l.status.At.IsZero()
+	l.status = status
+	switch {
+	case err != nil && !wasFailing:
+		l.failingSince = status.At
+	case err == nil:
+		l.failingSince = time.Time{}
+	}
+	l.statusMu.Unlock()
+
+	attrs := metric.WithAttributeSet(sourceAttributeSet(l.source.String()))
+	switch {
+	case err != nil && !wasFailing:
+		snapshotBuildErrors.Add(context.Background(), 1, attrs)
+	case err == nil && wasFailing:
+		snapshotBuildErrors.Add(context.Background(), -1, attrs)
+	}
+}
+
+// checkStale enforces the store's SnapshotErrorPolicyConfig against reads,
+// returning ErrSnapshotUnavailable once the policy no longer permits
+// serving the last known-good snapshot.
+func (l *Store) checkStale() error {
+	l.statusMu.RLock()
+	failingSince := l.failingSince
+	l.statusMu.RUnlock()
+
+	if failingSince.IsZero() {
+		return nil
+	}
+
+	if l.errorPolicy.Mode == config.SnapshotErrorModeFailClosed {
+		return ErrSnapshotUnavailable
+	}
+
+	if l.errorPolicy.MaxStaleness > 0 && time.Since(failingSince) > l.errorPolicy.MaxStaleness {
+		return ErrSnapshotUnavailable
+	}
+
+	return nil
+}
+
 // NewStore constructs and configure a Store.
 // The store creates a background goroutine which feeds a channel of fs.FS.
-func NewStore(logger *zap.Logger, source FSSource) (*Store, error) {
+func NewStore(logger *zap.Logger, source FSSource, opts ...containers.Option[Store]) (*Store, error) {
 	store := &Store{
 		syncedStore: &syncedStore{},
 		logger:      logger,
@@ -74,6 +213,9 @@ func NewStore(logger *zap.Logger, source FSSource) (*Store, error) {
 		done:        make(chan struct{}),
 	}
 
+	containers.ApplyAll(store, opts...)
+	store.syncedStore.unavailable = store.checkStale
+
 	// get an initial FS from source.
 	f, err := source.Get()
 	if err != nil {
@@ -109,6 +251,19 @@ func NewStore(logger *zap.Logger, source FSSource) (*Store, error) {
 	return store, nil
 }
 
+// Update forces the store to immediately fetch a new fs.FS from its source
+// and rebuild its snapshot, independent of the source's regular polling
+// (or watch) interval. It is used to support on-demand refresh, e.g. in
+// response to an upstream webhook notification.
+func (l *Store) Update(context.Context) error {
+	f, err := l.source.Get()
+	if err != nil {
+		return fmt.Errorf("refreshing store: %w", err)
+	}
+
+	return l.updateSnapshot(f)
+}
+
 // Close cancels the polling routine and waits for the routine to return.
 func (l *Store) Close() error {
 	l.cancel()
@@ -4,10 +4,12 @@ import (
 	"context"
 	"io/fs"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.flipt.io/flipt/internal/config"
 	"go.uber.org/zap/zaptest"
 )
 
@@ -32,6 +34,10 @@ func Test_Store(t *testing.T) {
 
 	assert.Equal(t, "filesystem/test", store.String())
 
+	status := store.SnapshotStatus()
+	assert.True(t, status.Success)
+	assert.Empty(t, status.Message)
+
 	// run FS with index suite against current store
 	suite.Run(t, &FSIndexSuite{store: store})
 
@@ -44,10 +50,75 @@ func Test_Store(t *testing.T) {
 	// run FS without index suite against current store
 	suite.Run(t, &FSWithoutIndexSuite{store: store})
 
+	// update snapshot with an fs containing an invalid flag file; the
+	// store should record the failure without losing the last good
+	// snapshot or notifying.
+	require.Error(t, store.updateSnapshot(mustSub(t, testdata, "fixtures/duplicate_flag")))
+
+	status = store.SnapshotStatus()
+	assert.False(t, status.Success)
+	assert.Contains(t, status.Message, `duplicate flag "flag1"`)
+
+	// run FS without index suite again, confirming it's still served
+	suite.Run(t, &FSWithoutIndexSuite{store: store})
+
 	// shutdown store
 	require.NoError(t, store.Close())
 }
 
+func Test_Store_SnapshotErrorPolicy_FailClosed(t *testing.T) {
+	var (
+		logger = zaptest.NewLogger(t)
+		source = source{
+			getFS: mustSub(t, testdata, "fixtures/fswithindex"),
+			ch:    make(chan fs.FS),
+		}
+	)
+
+	store, err := NewStore(logger, source, WithSnapshotErrorPolicy(config.SnapshotErrorPolicyConfig{
+		Mode: config.SnapshotErrorModeFailClosed,
+	}))
+	require.NoError(t, err)
+	defer store.Close()
+
+	_, err = store.GetNamespace(context.Background(), "default")
+	require.NoError(t, err)
+
+	require.Error(t, store.updateSnapshot(mustSub(t, testdata, "fixtures/duplicate_flag")))
+
+	_, err = store.GetNamespace(context.Background(), "default")
+	assert.ErrorIs(t, err, ErrSnapshotUnavailable)
+}
+
+func Test_Store_SnapshotErrorPolicy_MaxStaleness(t *testing.T) {
+	var (
+		logger = zaptest.NewLogger(t)
+		source = source{
+			getFS: mustSub(t, testdata, "fixtures/fswithindex"),
+			ch:    make(chan fs.FS),
+		}
+	)
+
+	store, err := NewStore(logger, source, WithSnapshotErrorPolicy(config.SnapshotErrorPolicyConfig{
+		Mode:         config.SnapshotErrorModeFailOpen,
+		MaxStaleness: 10 * time.Millisecond,
+	}))
+	require.NoError(t, err)
+	defer store.Close()
+
+	require.Error(t, store.updateSnapshot(mustSub(t, testdata, "fixtures/duplicate_flag")))
+
+	// still within the staleness window, so the last good snapshot keeps
+	// being served
+	_, err = store.GetNamespace(context.Background(), "default")
+	require.NoError(t, err)
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, err = store.GetNamespace(context.Background(), "default")
+	assert.ErrorIs(t, err, ErrSnapshotUnavailable)
+}
+
 type source struct {
 	getFS fs.FS
 	ch    chan fs.FS
@@ -16,12 +16,30 @@ type syncedStore struct {
 	*StoreSnapshot
 
 	mu sync.RWMutex
+
+	// unavailable, when set, is consulted by every read method before it
+	// falls through to StoreSnapshot. It lets Store enforce a fail-closed
+	// snapshot error policy without threading that policy's state through
+	// each method individually.
+	unavailable func() error
+}
+
+func (s *syncedStore) checkUnavailable() error {
+	if s.unavailable == nil {
+		return nil
+	}
+
+	return s.unavailable()
 }
 
 func (s *syncedStore) GetFlag(ctx context.Context, namespaceKey string, key string) (*flipt.Flag, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return nil, err
+	}
+
 	return s.StoreSnapshot.GetFlag(ctx, namespaceKey, key)
 }
 
@@ -29,6 +47,10 @@ func (s *syncedStore) ListFlags(ctx context.Context, namespaceKey string, opts .
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return storage.ResultSet[*flipt.Flag]{}, err
+	}
+
 	return s.StoreSnapshot.ListFlags(ctx, namespaceKey, opts...)
 }
 
@@ -36,6 +58,10 @@ func (s *syncedStore) CountFlags(ctx context.Context, namespaceKey string) (uint
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return 0, err
+	}
+
 	return s.StoreSnapshot.CountFlags(ctx, namespaceKey)
 }
 
@@ -43,6 +69,10 @@ func (s *syncedStore) GetRule(ctx context.Context, namespaceKey string, id strin
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return nil, err
+	}
+
 	return s.StoreSnapshot.GetRule(ctx, namespaceKey, id)
 }
 
@@ -50,6 +80,10 @@ func (s *syncedStore) ListRules(ctx context.Context, namespaceKey string, flagKe
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return storage.ResultSet[*flipt.Rule]{}, err
+	}
+
 	return s.StoreSnapshot.ListRules(ctx, namespaceKey, flagKey, opts...)
 }
 
@@ -57,6 +91,10 @@ func (s *syncedStore) CountRules(ctx context.Context, namespaceKey, flagKey stri
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return 0, err
+	}
+
 	return s.StoreSnapshot.CountRules(ctx, namespaceKey, flagKey)
 }
 
@@ -64,6 +102,10 @@ func (s *syncedStore) GetSegment(ctx context.Context, namespaceKey string, key s
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return nil, err
+	}
+
 	return s.StoreSnapshot.GetSegment(ctx, namespaceKey, key)
 }
 
@@ -71,6 +113,10 @@ func (s *syncedStore) ListSegments(ctx context.Context, namespaceKey string, opt
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return storage.ResultSet[*flipt.Segment]{}, err
+	}
+
 	return s.StoreSnapshot.ListSegments(ctx, namespaceKey, opts...)
 }
 
@@ -78,6 +124,10 @@ func (s *syncedStore) CountSegments(ctx context.Context, namespaceKey string) (u
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return 0, err
+	}
+
 	return s.StoreSnapshot.CountSegments(ctx, namespaceKey)
 }
 
@@ -85,6 +135,10 @@ func (s *syncedStore) GetEvaluationRules(ctx context.Context, namespaceKey strin
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return nil, err
+	}
+
 	return s.StoreSnapshot.GetEvaluationRules(ctx, namespaceKey, flagKey)
 }
 
@@ -92,6 +146,10 @@ func (s *syncedStore) GetEvaluationDistributions(ctx context.Context, ruleID str
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return nil, err
+	}
+
 	return s.StoreSnapshot.GetEvaluationDistributions(ctx, ruleID)
 }
 
@@ -99,6 +157,10 @@ func (s *syncedStore) GetNamespace(ctx context.Context, key string) (*flipt.Name
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return nil, err
+	}
+
 	return s.StoreSnapshot.GetNamespace(ctx, key)
 }
 
@@ -106,6 +168,10 @@ func (s *syncedStore) ListNamespaces(ctx context.Context, opts ...storage.QueryO
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return storage.ResultSet[*flipt.Namespace]{}, err
+	}
+
 	return s.StoreSnapshot.ListNamespaces(ctx, opts...)
 }
 
@@ -113,6 +179,10 @@ func (s *syncedStore) CountNamespaces(ctx context.Context) (uint64, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return 0, err
+	}
+
 	return s.StoreSnapshot.CountNamespaces(ctx)
 }
 
@@ -120,6 +190,10 @@ func (s *syncedStore) GetRollout(ctx context.Context, namespaceKey, id string) (
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return nil, err
+	}
+
 	return s.StoreSnapshot.GetRollout(ctx, namespaceKey, id)
 }
 
@@ -127,6 +201,10 @@ func (s *syncedStore) ListRollouts(ctx context.Context, namespaceKey, flagKey st
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return storage.ResultSet[*flipt.Rollout]{}, err
+	}
+
 	return s.StoreSnapshot.ListRollouts(ctx, namespaceKey, flagKey, opts...)
 }
 
@@ -134,6 +212,10 @@ func (s *syncedStore) CountRollouts(ctx context.Context, namespaceKey, flagKey s
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if err := s.checkUnavailable(); err != nil {
+		return 0, err
+	}
+
 	return s.StoreSnapshot.CountRollouts(ctx, namespaceKey, flagKey)
 }
 
@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"go.flipt.io/flipt/internal/storage/oplock"
+)
+
+// Service is a Redis backed implementation of oplock.Service. It uses a
+// SET NX lease per operation, so that the lease itself expires naturally
+// if the holder crashes without needing any explicit heartbeat/renewal.
+// It is suitable for coordinating singleton jobs across a set of
+// horizontally scaled Flipt instances that share a Redis instance but not
+// necessarily a SQL database (e.g. git or object declarative storage).
+type Service struct {
+	rdb    *goredis.Client
+	prefix string
+}
+
+// New constructs and configures a new service instance. prefix namespaces
+// the lease keys this service writes, so multiple unrelated deployments can
+// safely share a single Redis instance/database.
+func New(rdb *goredis.Client, prefix string) *Service {
+	return &Service{rdb: rdb, prefix: prefix}
+}
+
+func (s *Service) key(operation oplock.Operation) string {
+	return fmt.Sprintf("%s:oplock:%s", s.prefix, operation)
+}
+
+// TryAcquire will attempt to obtain a lease for the supplied operation name
+// for the specified duration, via a Redis SET NX. If it succeeds then the
+// returned boolean (acquired) will be true, else false, along with the
+// entry currently held by whichever instance (possibly this one) holds the
+// lease.
+func (s *Service) TryAcquire(ctx context.Context, operation oplock.Operation, duration time.Duration) (acquired bool, entry oplock.LockEntry, err error) {
+	now := time.Now().UTC()
+	entry = oplock.LockEntry{
+		Operation:     operation,
+		Version:       1,
+		LastAcquired:  now,
+		AcquiredUntil: now.Add(duration),
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return false, oplock.LockEntry{}, fmt.Errorf("marshalling lock entry: %w", err)
+	}
+
+	ok, err := s.rdb.SetNX(ctx, s.key(operation), data, duration).Result()
+	if err != nil {
+		return false, oplock.LockEntry{}, fmt.Errorf("acquiring lock: %w", err)
+	}
+
+	if ok {
+		return true, entry, nil
+	}
+
+	existing, err := s.rdb.Get(ctx, s.key(operation)).Bytes()
+	if err != nil {
+		return false, oplock.LockEntry{}, fmt.Errorf("reading existing lock entry: %w", err)
+	}
+
+	if err := json.Unmarshal(existing, &entry); err != nil {
+		return false, oplock.LockEntry{}, fmt.Errorf("unmarshalling existing lock entry: %w", err)
+	}
+
+	return false, entry, nil
+}
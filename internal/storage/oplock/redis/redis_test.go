@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	oplocktesting "go.flipt.io/flipt/internal/storage/oplock/testing"
+)
+
+type redisContainer struct {
+	testcontainers.Container
+	host string
+	port string
+}
+
+func setupRedis(ctx context.Context) (*redisContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "redis:7",
+		ExposedPorts: []string{"6379/tcp"},
+		WaitingFor:   wait.ForLog("Ready to accept connections"),
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mappedPort, err := container.MappedPort(ctx, "6379")
+	if err != nil {
+		return nil, err
+	}
+
+	hostIP, err := container.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisContainer{Container: container, host: hostIP, port: mappedPort.Port()}, nil
+}
+
+func Test_Harness(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+
+	var (
+		ctx         = context.Background()
+		redisAddr   = os.Getenv("REDIS_HOST")
+		redisCancel = func(context.Context) error { return nil }
+	)
+
+	if redisAddr == "" {
+		t.Log("Starting redis container.")
+
+		container, err := setupRedis(ctx)
+		require.NoError(t, err, "Failed to start redis container.")
+
+		redisCancel = container.Terminate
+		redisAddr = fmt.Sprintf("%s:%s", container.host, container.port)
+	}
+
+	defer func() { _ = redisCancel(ctx) }()
+
+	rdb := goredis.NewClient(&goredis.Options{Addr: redisAddr})
+
+	oplocktesting.Harness(t, New(rdb, "flipt"))
+}
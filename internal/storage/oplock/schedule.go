@@ -0,0 +1,55 @@
+package oplock
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// minRetryInterval bounds how often RunOnSchedule will retry acquiring the
+// lock when the Service itself is returning errors, so a failing backend
+// (e.g. a database outage) doesn't cause a hot loop.
+const minRetryInterval = 5 * time.Minute
+
+// RunOnSchedule repeatedly attempts to acquire the lock for operation using
+// lock, and invokes fn only while held by this instance, at most once per
+// interval. It blocks until ctx is cancelled, and is intended to be run in
+// its own goroutine (e.g. via an errgroup) by callers that want a background
+// job to run exactly once across a set of horizontally scaled instances,
+// rather than on every replica.
+func RunOnSchedule(ctx context.Context, logger *zap.Logger, lock Service, operation Operation, interval time.Duration, fn func(ctx context.Context)) {
+	// on the first attempt we try to obtain the lock immediately.
+	acquiredUntil := time.Now().UTC()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(acquiredUntil)):
+		}
+
+		acquired, entry, err := lock.TryAcquire(ctx, operation, interval)
+		if err != nil {
+			// ensure we dont go into a hot loop when the operation lock
+			// service enters an error state by ensuring we sleep for
+			// at-least the minimum retry interval.
+			now := time.Now().UTC()
+			if acquiredUntil.Before(now) {
+				acquiredUntil = now.Add(minRetryInterval)
+			}
+
+			logger.Warn("attempting to acquire lock", zap.String("operation", string(operation)), zap.Error(err))
+			continue
+		}
+
+		// update the next sleep target to the current entries acquired until
+		acquiredUntil = entry.AcquiredUntil
+
+		if !acquired {
+			logger.Debug("operation not acquired", zap.String("operation", string(operation)), zap.Time("next_attempt", entry.AcquiredUntil))
+			continue
+		}
+
+		fn(ctx)
+	}
+}
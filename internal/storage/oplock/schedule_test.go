@@ -0,0 +1,54 @@
+package oplock_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.flipt.io/flipt/internal/storage/oplock"
+	"go.flipt.io/flipt/internal/storage/oplock/memory"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRunOnSchedule(t *testing.T) {
+	var (
+		lock   = memory.New()
+		logger = zaptest.NewLogger(t)
+		calls  int32
+
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	)
+	defer cancel()
+
+	oplock.RunOnSchedule(ctx, logger, lock, oplock.Operation("test"), 500*time.Millisecond, func(_ context.Context) {
+		atomic.AddInt32(&calls, 1)
+	})
+
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&calls), int32(5))
+}
+
+func TestRunOnSchedule_SingleLock(t *testing.T) {
+	var (
+		lock   = memory.New()
+		logger = zaptest.NewLogger(t)
+		calls  int32
+
+		ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+	)
+	defer cancel()
+
+	// two competing runners sharing a single lock should only ever have one
+	// of them invoke fn for any given acquisition window.
+	for i := 0; i < 2; i++ {
+		go oplock.RunOnSchedule(ctx, logger, lock, oplock.Operation("shared"), time.Second, func(_ context.Context) {
+			atomic.AddInt32(&calls, 1)
+		})
+	}
+
+	<-ctx.Done()
+
+	// across ~2 acquisition windows only one of the two runners should have won each time.
+	assert.LessOrEqual(t, atomic.LoadInt32(&calls), int32(3))
+}
@@ -0,0 +1,104 @@
+package proposal
+
+import (
+	"context"
+	"time"
+
+	"go.flipt.io/flipt/internal/storage"
+)
+
+// Status is the review state of a Proposal.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+)
+
+// Action identifies the kind of change a Proposal's Payload describes.
+// Only flag updates are supported in this first cut of the proposal
+// subsystem.
+type Action string
+
+const (
+	ActionUpdateFlag Action = "update_flag"
+)
+
+// Proposal is a pending mutation awaiting approval before it is applied.
+type Proposal struct {
+	ID           string
+	NamespaceKey string
+	FlagKey      string
+	Action       Action
+	Payload      interface{}
+	Status       Status
+	ProposedBy   string
+	ReviewedBy   string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// UpdateFlagPayload is the Payload shape for an ActionUpdateFlag proposal.
+// Fields mirror flipt.UpdateFlagRequest, minus the identifying key/namespace
+// which are already recorded on the Proposal itself.
+type UpdateFlagPayload struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// Store persists proposals and allows them to be queried, approved, or
+// rejected.
+type Store interface {
+	// CreateProposal persists a new, pending Proposal.
+	CreateProposal(context.Context, *CreateProposalRequest) (*Proposal, error)
+	// GetProposal retrieves a single Proposal by ID.
+	GetProposal(ctx context.Context, id string) (*Proposal, error)
+	// ListProposals retrieves a set of proposals based on the provided predicate.
+	ListProposals(context.Context, *storage.ListRequest[ListProposalsPredicate]) (storage.ResultSet[*Proposal], error)
+	// ReviewProposal transitions a pending Proposal to StatusApproved or
+	// StatusRejected, recording who reviewed it. It returns the updated
+	// Proposal.
+	ReviewProposal(context.Context, *ReviewProposalRequest) (*Proposal, error)
+}
+
+// CreateProposalRequest is the argument passed when persisting a new Proposal.
+type CreateProposalRequest struct {
+	NamespaceKey string
+	FlagKey      string
+	Action       Action
+	Payload      interface{}
+	ProposedBy   string
+}
+
+// ReviewProposalRequest is the argument passed when approving or rejecting a
+// pending Proposal.
+type ReviewProposalRequest struct {
+	ID         string
+	Status     Status
+	ReviewedBy string
+}
+
+// ListProposalsPredicate contains the fields necessary to predicate a list
+// operation on a proposal storage backend.
+type ListProposalsPredicate struct {
+	NamespaceKey *string
+	Status       *Status
+}
+
+// ListWithNamespaceKey can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListProposals by namespace.
+func ListWithNamespaceKey(namespaceKey string) storage.ListOption[ListProposalsPredicate] {
+	return func(r *storage.ListRequest[ListProposalsPredicate]) {
+		r.Predicate.NamespaceKey = &namespaceKey
+	}
+}
+
+// ListWithStatus can be passed to storage.NewListRequest.
+// The request can then be used to predicate ListProposals by status.
+func ListWithStatus(status Status) storage.ListOption[ListProposalsPredicate] {
+	return func(r *storage.ListRequest[ListProposalsPredicate]) {
+		r.Predicate.Status = &status
+	}
+}
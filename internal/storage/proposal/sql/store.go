@@ -0,0 +1,240 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+	"go.flipt.io/flipt/internal/storage"
+	storageproposal "go.flipt.io/flipt/internal/storage/proposal"
+	storagesql "go.flipt.io/flipt/internal/storage/sql"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Store is the persistent storage layer for proposals backed by SQL based
+// relational database systems.
+type Store struct {
+	driver  storagesql.Driver
+	builder sq.StatementBuilderType
+
+	generateID func() string
+}
+
+// NewStore constructs and configures a new instance of *Store.
+// Queries are issued to the database via the provided statement builder.
+func NewStore(driver storagesql.Driver, builder sq.StatementBuilderType) *Store {
+	return &Store{
+		driver:  driver,
+		builder: builder,
+		generateID: func() string {
+			return uuid.Must(uuid.NewV4()).String()
+		},
+	}
+}
+
+// CreateProposal persists a new, pending Proposal.
+func (s *Store) CreateProposal(ctx context.Context, r *storageproposal.CreateProposalRequest) (*storageproposal.Proposal, error) {
+	now := time.Now().UTC()
+
+	p := &storageproposal.Proposal{
+		ID:           s.generateID(),
+		NamespaceKey: r.NamespaceKey,
+		FlagKey:      r.FlagKey,
+		Action:       r.Action,
+		Payload:      r.Payload,
+		Status:       storageproposal.StatusPending,
+		ProposedBy:   r.ProposedBy,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if _, err := s.builder.Insert("proposals").
+		Columns(
+			"id",
+			"namespace_key",
+			"flag_key",
+			"action",
+			"payload",
+			"status",
+			"proposed_by",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			p.ID,
+			p.NamespaceKey,
+			p.FlagKey,
+			string(p.Action),
+			&storagesql.JSONField[interface{}]{T: p.Payload},
+			string(p.Status),
+			p.ProposedBy,
+			&storagesql.Timestamp{Timestamp: timestamppb.New(now)},
+			&storagesql.Timestamp{Timestamp: timestamppb.New(now)},
+		).
+		ExecContext(ctx); err != nil {
+		return nil, fmt.Errorf("creating proposal: %w", s.driver.AdaptError(err))
+	}
+
+	return p, nil
+}
+
+// GetProposal retrieves a single Proposal by ID.
+func (s *Store) GetProposal(ctx context.Context, id string) (*storageproposal.Proposal, error) {
+	row := s.builder.Select(
+		"id",
+		"namespace_key",
+		"flag_key",
+		"action",
+		"payload",
+		"status",
+		"proposed_by",
+		"reviewed_by",
+		"created_at",
+		"updated_at",
+	).
+		From("proposals").
+		Where(sq.Eq{"id": id}).
+		QueryRowContext(ctx)
+
+	p, err := s.scanProposal(row)
+	if err != nil {
+		return nil, fmt.Errorf("getting proposal %q: %w", id, s.driver.AdaptError(err))
+	}
+
+	return p, nil
+}
+
+// ListProposals retrieves a set of proposals based on the provided predicate.
+func (s *Store) ListProposals(ctx context.Context, req *storage.ListRequest[storageproposal.ListProposalsPredicate]) (set storage.ResultSet[*storageproposal.Proposal], err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("listing proposals: %w", s.driver.AdaptError(err))
+		}
+	}()
+
+	req.QueryParams.Normalize()
+
+	query := s.builder.Select(
+		"id",
+		"namespace_key",
+		"flag_key",
+		"action",
+		"payload",
+		"status",
+		"proposed_by",
+		"reviewed_by",
+		"created_at",
+		"updated_at",
+	).
+		From("proposals").
+		Limit(req.QueryParams.Limit + 1).
+		OrderBy(fmt.Sprintf("created_at %s", req.QueryParams.Order))
+
+	if req.Predicate.NamespaceKey != nil {
+		query = query.Where(sq.Eq{"namespace_key": *req.Predicate.NamespaceKey})
+	}
+
+	if req.Predicate.Status != nil {
+		query = query.Where(sq.Eq{"status": string(*req.Predicate.Status)})
+	}
+
+	var offset int
+	if v, err := strconv.ParseInt(req.QueryParams.PageToken, 10, 64); err == nil {
+		offset = int(v)
+		query = query.Offset(uint64(v))
+	}
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return set, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		p, err := s.scanProposal(rows)
+		if err != nil {
+			return set, err
+		}
+
+		if len(set.Results) >= int(req.QueryParams.Limit) {
+			set.NextPageToken = fmt.Sprintf("%d", offset+int(req.QueryParams.Limit))
+			break
+		}
+
+		set.Results = append(set.Results, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return set, err
+	}
+
+	return set, nil
+}
+
+// ReviewProposal transitions a pending Proposal to StatusApproved or
+// StatusRejected, recording who reviewed it.
+func (s *Store) ReviewProposal(ctx context.Context, r *storageproposal.ReviewProposalRequest) (*storageproposal.Proposal, error) {
+	res, err := s.builder.Update("proposals").
+		Set("status", string(r.Status)).
+		Set("reviewed_by", r.ReviewedBy).
+		Set("updated_at", &storagesql.Timestamp{Timestamp: timestamppb.New(time.Now().UTC())}).
+		Where(sq.Eq{"id": r.ID, "status": string(storageproposal.StatusPending)}).
+		ExecContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reviewing proposal %q: %w", r.ID, s.driver.AdaptError(err))
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("reviewing proposal %q: %w", r.ID, err)
+	}
+
+	if count < 1 {
+		return nil, fmt.Errorf("reviewing proposal %q: no pending proposal found", r.ID)
+	}
+
+	return s.GetProposal(ctx, r.ID)
+}
+
+func (s *Store) scanProposal(scanner sq.RowScanner) (*storageproposal.Proposal, error) {
+	var (
+		p          storageproposal.Proposal
+		action     string
+		status     string
+		payload    interface{}
+		reviewedBy *string
+		createdAt  storagesql.Timestamp
+		updatedAt  storagesql.Timestamp
+	)
+
+	if err := scanner.Scan(
+		&p.ID,
+		&p.NamespaceKey,
+		&p.FlagKey,
+		&action,
+		&storagesql.JSONField[*interface{}]{T: &payload},
+		&status,
+		&p.ProposedBy,
+		&reviewedBy,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	p.Action = storageproposal.Action(action)
+	p.Status = storageproposal.Status(status)
+	p.Payload = payload
+	p.CreatedAt = createdAt.AsTime()
+	p.UpdatedAt = updatedAt.AsTime()
+
+	if reviewedBy != nil {
+		p.ReviewedBy = *reviewedBy
+	}
+
+	return &p, nil
+}
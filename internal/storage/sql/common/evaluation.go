@@ -10,68 +10,40 @@ import (
 	flipt "go.flipt.io/flipt/rpc/flipt"
 )
 
+// GetEvaluationRules hydrates every rule applicable to flagKey -- along with
+// its segments, constraints, and variant distributions -- from a single
+// joined query, so that evaluating a flag never needs a follow-up
+// GetEvaluationDistributions round trip per matching rule.
 func (s *Store) GetEvaluationRules(ctx context.Context, namespaceKey, flagKey string) (_ []*storage.EvaluationRule, err error) {
 	if namespaceKey == "" {
 		namespaceKey = storage.DefaultNamespace
 	}
 
-	ruleMetaRows, err := s.builder.
-		Select("id, \"rank\", segment_operator").
-		From("rules").
-		Where(sq.And{sq.Eq{"flag_key": flagKey}, sq.Eq{"namespace_key": namespaceKey}}).
-		QueryContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		if cerr := ruleMetaRows.Close(); cerr != nil && err == nil {
-			err = cerr
-		}
-	}()
-
-	type RuleMeta struct {
-		ID              string
-		Rank            int32
-		SegmentOperator flipt.SegmentOperator
-	}
-
-	var rmMap = make(map[string]*RuleMeta)
-
-	ruleIDs := make([]string, 0)
-	for ruleMetaRows.Next() {
-		var rm RuleMeta
-
-		if err := ruleMetaRows.Scan(&rm.ID, &rm.Rank, &rm.SegmentOperator); err != nil {
-			return nil, err
-		}
-
-		rmMap[rm.ID] = &rm
-		ruleIDs = append(ruleIDs, rm.ID)
-	}
-
-	if err := ruleMetaRows.Err(); err != nil {
-		return nil, err
-	}
-
-	if err := ruleMetaRows.Close(); err != nil {
-		return nil, err
-	}
-
 	rows, err := s.builder.Select(`
-		rs.rule_id,
+		r.id,
+		r."rank",
+		r.segment_operator,
 		rs.segment_key,
 		s.match_type AS segment_match_type,
 		c.id AS constraint_id,
 		c."type" AS constraint_type,
 		c.property AS constraint_property,
 		c.operator AS constraint_operator,
-		c.value AS constraint_value
+		c.value AS constraint_value,
+		d.id AS distribution_id,
+		d.variant_id AS distribution_variant_id,
+		d.rollout AS distribution_rollout,
+		v."key" AS distribution_variant_key,
+		v.attachment AS distribution_variant_attachment
 	`).
-		From("rule_segments AS rs").
-		Join(`segments AS s ON rs.segment_key = s."key"`).
+		From("rules AS r").
+		LeftJoin("rule_segments AS rs ON rs.rule_id = r.id").
+		LeftJoin(`segments AS s ON (rs.segment_key = s."key" AND s.namespace_key = rs.namespace_key)`).
 		LeftJoin(`constraints AS c ON (s."key" = c.segment_key AND s.namespace_key = c.namespace_key)`).
-		Where(sq.Eq{"rs.rule_id": ruleIDs}).
+		LeftJoin("distributions AS d ON d.rule_id = r.id").
+		LeftJoin(`variants AS v ON v.id = d.variant_id`).
+		Where(sq.And{sq.Eq{"r.flag_key": flagKey}, sq.Eq{"r.namespace_key": namespaceKey}}).
+		OrderBy(`r."rank" ASC`).
 		QueryContext(ctx)
 	if err != nil {
 		return nil, err
@@ -84,44 +56,62 @@ func (s *Store) GetEvaluationRules(ctx context.Context, namespaceKey, flagKey st
 	}()
 
 	var (
-		uniqueRules = make(map[string]*storage.EvaluationRule)
-		rules       = []*storage.EvaluationRule{}
+		uniqueRules        = make(map[string]*storage.EvaluationRule)
+		uniqueDistribution = make(map[string]map[string]*storage.EvaluationDistribution)
+		rules              = []*storage.EvaluationRule{}
 	)
 
 	for rows.Next() {
 		var (
-			intermediateStorageRule struct {
-				ID               string
-				NamespaceKey     string
-				FlagKey          string
-				SegmentKey       string
-				SegmentMatchType flipt.MatchType
-				SegmentOperator  flipt.SegmentOperator
-				Rank             int32
-			}
+			ruleID             string
+			rank               int32
+			segmentOperator    flipt.SegmentOperator
+			segmentKey         sql.NullString
+			segmentMatchType   sql.NullInt32
 			optionalConstraint optionalConstraint
+			distID             sql.NullString
+			distVariantID      sql.NullString
+			distRollout        sql.NullFloat64
+			distVariantKey     sql.NullString
+			distAttachment     sql.NullString
 		)
 
 		if err := rows.Scan(
-			&intermediateStorageRule.ID,
-			&intermediateStorageRule.SegmentKey,
-			&intermediateStorageRule.SegmentMatchType,
+			&ruleID,
+			&rank,
+			&segmentOperator,
+			&segmentKey,
+			&segmentMatchType,
 			&optionalConstraint.Id,
 			&optionalConstraint.Type,
 			&optionalConstraint.Property,
 			&optionalConstraint.Operator,
-			&optionalConstraint.Value); err != nil {
+			&optionalConstraint.Value,
+			&distID,
+			&distVariantID,
+			&distRollout,
+			&distVariantKey,
+			&distAttachment); err != nil {
 			return rules, err
 		}
 
-		rm := rmMap[intermediateStorageRule.ID]
+		rule, ok := uniqueRules[ruleID]
+		if !ok {
+			rule = &storage.EvaluationRule{
+				ID:              ruleID,
+				NamespaceKey:    namespaceKey,
+				FlagKey:         flagKey,
+				Rank:            rank,
+				SegmentOperator: segmentOperator,
+				Segments:        make(map[string]*storage.EvaluationSegment),
+			}
 
-		intermediateStorageRule.FlagKey = flagKey
-		intermediateStorageRule.NamespaceKey = namespaceKey
-		intermediateStorageRule.Rank = rm.Rank
-		intermediateStorageRule.SegmentOperator = rm.SegmentOperator
+			uniqueRules[ruleID] = rule
+			uniqueDistribution[ruleID] = make(map[string]*storage.EvaluationDistribution)
+			rules = append(rules, rule)
+		}
 
-		if existingRule, ok := uniqueRules[intermediateStorageRule.ID]; ok {
+		if segmentKey.Valid {
 			var constraint *storage.EvaluationConstraint
 			if optionalConstraint.Id.Valid {
 				constraint = &storage.EvaluationConstraint{
@@ -133,56 +123,59 @@ func (s *Store) GetEvaluationRules(ctx context.Context, namespaceKey, flagKey st
 				}
 			}
 
-			segment, ok := existingRule.Segments[intermediateStorageRule.SegmentKey]
+			segment, ok := rule.Segments[segmentKey.String]
 			if !ok {
-				ses := &storage.EvaluationSegment{
-					SegmentKey: intermediateStorageRule.SegmentKey,
-					MatchType:  intermediateStorageRule.SegmentMatchType,
+				segment = &storage.EvaluationSegment{
+					SegmentKey: segmentKey.String,
+					MatchType:  flipt.MatchType(segmentMatchType.Int32),
 				}
 
 				if constraint != nil {
-					ses.Constraints = []storage.EvaluationConstraint{*constraint}
+					segment.Constraints = []storage.EvaluationConstraint{*constraint}
 				}
 
-				existingRule.Segments[intermediateStorageRule.SegmentKey] = ses
+				rule.Segments[segmentKey.String] = segment
 			} else if constraint != nil {
-				segment.Constraints = append(segment.Constraints, *constraint)
-			}
-		} else {
-			// haven't seen this rule before
-			newRule := &storage.EvaluationRule{
-				ID:              intermediateStorageRule.ID,
-				NamespaceKey:    intermediateStorageRule.NamespaceKey,
-				FlagKey:         intermediateStorageRule.FlagKey,
-				Rank:            intermediateStorageRule.Rank,
-				SegmentOperator: intermediateStorageRule.SegmentOperator,
-				Segments:        make(map[string]*storage.EvaluationSegment),
-			}
+				found := false
+				for _, existing := range segment.Constraints {
+					if existing.ID == constraint.ID {
+						found = true
+						break
+					}
+				}
 
-			var constraint *storage.EvaluationConstraint
-			if optionalConstraint.Id.Valid {
-				constraint = &storage.EvaluationConstraint{
-					ID:       optionalConstraint.Id.String,
-					Type:     flipt.ComparisonType(optionalConstraint.Type.Int32),
-					Property: optionalConstraint.Property.String,
-					Operator: optionalConstraint.Operator.String,
-					Value:    optionalConstraint.Value.String,
+				if !found {
+					segment.Constraints = append(segment.Constraints, *constraint)
 				}
 			}
+		}
 
-			ses := &storage.EvaluationSegment{
-				SegmentKey: intermediateStorageRule.SegmentKey,
-				MatchType:  intermediateStorageRule.SegmentMatchType,
-			}
+		if distID.Valid {
+			if _, ok := uniqueDistribution[ruleID][distID.String]; !ok {
+				d := &storage.EvaluationDistribution{
+					ID:         distID.String,
+					RuleID:     ruleID,
+					VariantID:  distVariantID.String,
+					Rollout:    float32(distRollout.Float64),
+					VariantKey: distVariantKey.String,
+				}
 
-			if constraint != nil {
-				ses.Constraints = []storage.EvaluationConstraint{*constraint}
-			}
+				if distAttachment.Valid {
+					decoded, err := decodeAttachment(distAttachment.String)
+					if err != nil {
+						return rules, err
+					}
 
-			newRule.Segments[intermediateStorageRule.SegmentKey] = ses
+					attachmentString, err := compactJSONString(decoded)
+					if err != nil {
+						return rules, err
+					}
+					d.VariantAttachment = attachmentString
+				}
 
-			uniqueRules[newRule.ID] = newRule
-			rules = append(rules, newRule)
+				uniqueDistribution[ruleID][distID.String] = d
+				rule.Distributions = append(rule.Distributions, d)
+			}
 		}
 	}
 
@@ -233,7 +226,12 @@ func (s *Store) GetEvaluationDistributions(ctx context.Context, ruleID string) (
 		}
 
 		if attachment.Valid {
-			attachmentString, err := compactJSONString(attachment.String)
+			decoded, err := decodeAttachment(attachment.String)
+			if err != nil {
+				return distributions, err
+			}
+
+			attachmentString, err := compactJSONString(decoded)
 			if err != nil {
 				return distributions, err
 			}
@@ -0,0 +1,113 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.flipt.io/flipt/internal/storage"
+)
+
+var _ storage.EvaluationViewRefresher = &Store{}
+
+// evaluationViewBatchSize bounds how many namespaces/flags are held in
+// memory at once while rebuilding the view, mirroring the batching used
+// elsewhere when walking every flag in every namespace (see ext.exporter).
+const evaluationViewBatchSize = 100
+
+// RefreshEvaluationRulesView rebuilds the evaluation_rules_view row for
+// every flag in every namespace, one flag at a time, so that the hot
+// evaluation read path can eventually be served from a single indexed
+// lookup instead of re-joining rules/segments/constraints/distributions
+// on every call.
+func (s *Store) RefreshEvaluationRulesView(ctx context.Context) (err error) {
+	var namespacePageToken string
+
+	for {
+		namespaces, err := s.ListNamespaces(ctx, storage.WithPageToken(namespacePageToken), storage.WithLimit(evaluationViewBatchSize))
+		if err != nil {
+			return fmt.Errorf("listing namespaces: %w", err)
+		}
+
+		for _, namespace := range namespaces.Results {
+			if err := s.refreshEvaluationRulesViewForNamespace(ctx, namespace.Key); err != nil {
+				return fmt.Errorf("refreshing namespace %q: %w", namespace.Key, err)
+			}
+		}
+
+		namespacePageToken = namespaces.NextPageToken
+		if namespacePageToken == "" {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) refreshEvaluationRulesViewForNamespace(ctx context.Context, namespaceKey string) error {
+	var flagPageToken string
+
+	for {
+		flags, err := s.ListFlags(ctx, namespaceKey, storage.WithPageToken(flagPageToken), storage.WithLimit(evaluationViewBatchSize))
+		if err != nil {
+			return fmt.Errorf("listing flags: %w", err)
+		}
+
+		for _, flag := range flags.Results {
+			rules, err := s.GetEvaluationRules(ctx, namespaceKey, flag.Key)
+			if err != nil {
+				return fmt.Errorf("getting evaluation rules for flag %q: %w", flag.Key, err)
+			}
+
+			payload, err := json.Marshal(rules)
+			if err != nil {
+				return fmt.Errorf("marshaling evaluation rules for flag %q: %w", flag.Key, err)
+			}
+
+			if err := s.upsertEvaluationRulesView(ctx, namespaceKey, flag.Key, payload); err != nil {
+				return fmt.Errorf("upserting evaluation rules view for flag %q: %w", flag.Key, err)
+			}
+		}
+
+		flagPageToken = flags.NextPageToken
+		if flagPageToken == "" {
+			break
+		}
+	}
+
+	return nil
+}
+
+// upsertEvaluationRulesView replaces the view row for the given flag within
+// a transaction, deleting then re-inserting rather than relying on
+// dialect-specific upsert syntax (ON CONFLICT vs ON DUPLICATE KEY).
+func (s *Store) upsertEvaluationRulesView(ctx context.Context, namespaceKey, flagKey string, payload []byte) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = s.builder.Delete("evaluation_rules_view").
+		Where(sq.And{sq.Eq{"namespace_key": namespaceKey}, sq.Eq{"flag_key": flagKey}}).
+		RunWith(tx).
+		ExecContext(ctx); err != nil {
+		return err
+	}
+
+	if _, err = s.builder.Insert("evaluation_rules_view").
+		Columns("namespace_key", "flag_key", "payload").
+		Values(namespaceKey, flagKey, string(payload)).
+		RunWith(tx).
+		ExecContext(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -2,12 +2,15 @@ package common
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"database/sql"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/gofrs/uuid"
@@ -34,6 +37,72 @@ func emptyAsNil(str string) *string {
 	return &str
 }
 
+// attachmentCompressionThreshold is the size, in bytes, above which a
+// variant's attachment is gzip-compressed at rest. Remote-config style use
+// cases can legitimately need attachments well beyond this, and storing them
+// compressed keeps the database row size reasonable.
+const attachmentCompressionThreshold = 2048
+
+// attachmentCompressionMarker prefixes a compressed attachment so it can be
+// told apart from one stored as plain JSON. It is not valid JSON itself, so
+// it can never collide with an uncompressed attachment.
+const attachmentCompressionMarker = "\x00flipt:gzip:"
+
+// encodeAttachment returns the representation of attachment to persist in
+// storage, transparently gzip-compressing it if it's larger than
+// attachmentCompressionThreshold.
+func encodeAttachment(attachment string) (*string, error) {
+	if attachment == "" {
+		return nil, nil
+	}
+
+	if len(attachment) <= attachmentCompressionThreshold {
+		return &attachment, nil
+	}
+
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write([]byte(attachment)); err != nil {
+		return nil, err
+	}
+
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	encoded := attachmentCompressionMarker + base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	return &encoded, nil
+}
+
+// decodeAttachment reverses encodeAttachment, transparently decompressing a
+// stored attachment if it was compressed. Attachments stored before
+// compression support was added are returned unchanged.
+func decodeAttachment(stored string) (string, error) {
+	if !strings.HasPrefix(stored, attachmentCompressionMarker) {
+		return stored, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, attachmentCompressionMarker))
+	if err != nil {
+		return "", err
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer gr.Close()
+
+	raw, err := io.ReadAll(gr)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
 // GetFlag gets a flag with variants by key
 func (s *Store) GetFlag(ctx context.Context, namespaceKey, key string) (*flipt.Flag, error) {
 	if namespaceKey == "" {
@@ -111,7 +180,12 @@ func (s *Store) GetFlag(ctx context.Context, namespaceKey, key string) (*flipt.F
 		variant.CreatedAt = createdAt.Timestamp
 		variant.UpdatedAt = updatedAt.Timestamp
 		if attachment.Valid {
-			compactedAttachment, err := compactJSONString(attachment.String)
+			decodedAttachment, err := decodeAttachment(attachment.String)
+			if err != nil {
+				return flag, err
+			}
+
+			compactedAttachment, err := compactJSONString(decodedAttachment)
 			if err != nil {
 				return flag, err
 			}
@@ -292,6 +366,11 @@ func (s *Store) setVariants(ctx context.Context, namespaceKey string, flagsByKey
 		}
 
 		if flag, ok := flagsByKey[variant.FlagKey.String]; ok {
+			attachment, err := decodeAttachment(variant.Attachment.String)
+			if err != nil {
+				return err
+			}
+
 			flag.Variants = append(flag.Variants, &flipt.Variant{
 				Id:           variant.Id.String,
 				NamespaceKey: variant.NamespaceKey.String,
@@ -299,7 +378,7 @@ func (s *Store) setVariants(ctx context.Context, namespaceKey string, flagsByKey
 				FlagKey:      variant.FlagKey.String,
 				Name:         variant.Name.String,
 				Description:  variant.Description.String,
-				Attachment:   variant.Attachment.String,
+				Attachment:   attachment,
 				CreatedAt:    vCreatedAt.Timestamp,
 				UpdatedAt:    vUpdatedAt.Timestamp,
 			})
@@ -436,6 +515,12 @@ func (s *Store) CreateVariant(ctx context.Context, r *flipt.CreateVariantRequest
 	)
 
 	attachment := emptyAsNil(r.Attachment)
+
+	stored, err := encodeAttachment(r.Attachment)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, err := s.builder.Insert("variants").
 		Columns("id", "namespace_key", "flag_key", "\"key\"", "name", "description", "attachment", "created_at", "updated_at").
 		Values(
@@ -445,7 +530,7 @@ func (s *Store) CreateVariant(ctx context.Context, r *flipt.CreateVariantRequest
 			v.Key,
 			v.Name,
 			v.Description,
-			attachment,
+			stored,
 			&fliptsql.Timestamp{Timestamp: v.CreatedAt},
 			&fliptsql.Timestamp{Timestamp: v.UpdatedAt},
 		).
@@ -472,11 +557,16 @@ func (s *Store) UpdateVariant(ctx context.Context, r *flipt.UpdateVariantRequest
 
 	whereClause := sq.And{sq.Eq{"id": r.Id}, sq.Eq{"flag_key": r.FlagKey}, sq.Eq{"namespace_key": r.NamespaceKey}}
 
+	stored, err := encodeAttachment(r.Attachment)
+	if err != nil {
+		return nil, err
+	}
+
 	query := s.builder.Update("variants").
 		Set("\"key\"", r.Key).
 		Set("name", r.Name).
 		Set("description", r.Description).
-		Set("attachment", emptyAsNil(r.Attachment)).
+		Set("attachment", stored).
 		Set("updated_at", &fliptsql.Timestamp{Timestamp: timestamppb.Now()}).
 		Where(whereClause)
 
@@ -513,7 +603,12 @@ func (s *Store) UpdateVariant(ctx context.Context, r *flipt.UpdateVariantRequest
 	v.CreatedAt = createdAt.Timestamp
 	v.UpdatedAt = updatedAt.Timestamp
 	if attachment.Valid {
-		compactedAttachment, err := compactJSONString(attachment.String)
+		decodedAttachment, err := decodeAttachment(attachment.String)
+		if err != nil {
+			return nil, err
+		}
+
+		compactedAttachment, err := compactJSONString(decodedAttachment)
 		if err != nil {
 			return nil, err
 		}
@@ -535,3 +630,178 @@ func (s *Store) DeleteVariant(ctx context.Context, r *flipt.DeleteVariantRequest
 
 	return err
 }
+
+// CreateVariants creates a batch of variants in a single transaction, so that
+// creating a flag with many variants does not require a sequential round
+// trip per variant.
+func (s *Store) CreateVariants(ctx context.Context, reqs []*flipt.CreateVariantRequest) (_ []*flipt.Variant, err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	variants := make([]*flipt.Variant, 0, len(reqs))
+
+	for _, r := range reqs {
+		namespaceKey := r.NamespaceKey
+		if namespaceKey == "" {
+			namespaceKey = storage.DefaultNamespace
+		}
+
+		now := timestamppb.Now()
+		v := &flipt.Variant{
+			Id:           uuid.Must(uuid.NewV4()).String(),
+			NamespaceKey: namespaceKey,
+			FlagKey:      r.FlagKey,
+			Key:          r.Key,
+			Name:         r.Name,
+			Description:  r.Description,
+			Attachment:   r.Attachment,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+
+		attachment := emptyAsNil(r.Attachment)
+
+		var stored *string
+		if stored, err = encodeAttachment(r.Attachment); err != nil {
+			return nil, err
+		}
+
+		if _, err = s.builder.Insert("variants").
+			RunWith(tx).
+			Columns("id", "namespace_key", "flag_key", "\"key\"", "name", "description", "attachment", "created_at", "updated_at").
+			Values(
+				v.Id,
+				v.NamespaceKey,
+				v.FlagKey,
+				v.Key,
+				v.Name,
+				v.Description,
+				stored,
+				&fliptsql.Timestamp{Timestamp: v.CreatedAt},
+				&fliptsql.Timestamp{Timestamp: v.UpdatedAt},
+			).
+			ExecContext(ctx); err != nil {
+			return nil, err
+		}
+
+		if attachment != nil {
+			var compactedAttachment string
+			if compactedAttachment, err = compactJSONString(*attachment); err != nil {
+				return nil, err
+			}
+			v.Attachment = compactedAttachment
+		}
+
+		variants = append(variants, v)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
+
+// UpdateVariants updates a batch of variants in a single transaction.
+func (s *Store) UpdateVariants(ctx context.Context, reqs []*flipt.UpdateVariantRequest) (_ []*flipt.Variant, err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	variants := make([]*flipt.Variant, 0, len(reqs))
+
+	for _, r := range reqs {
+		namespaceKey := r.NamespaceKey
+		if namespaceKey == "" {
+			namespaceKey = storage.DefaultNamespace
+		}
+
+		whereClause := sq.And{sq.Eq{"id": r.Id}, sq.Eq{"flag_key": r.FlagKey}, sq.Eq{"namespace_key": namespaceKey}}
+
+		stored, serr := encodeAttachment(r.Attachment)
+		if serr != nil {
+			err = serr
+			return nil, err
+		}
+
+		res, qerr := s.builder.Update("variants").
+			RunWith(tx).
+			Set("\"key\"", r.Key).
+			Set("name", r.Name).
+			Set("description", r.Description).
+			Set("attachment", stored).
+			Set("updated_at", &fliptsql.Timestamp{Timestamp: timestamppb.Now()}).
+			Where(whereClause).
+			ExecContext(ctx)
+		if qerr != nil {
+			err = qerr
+			return nil, err
+		}
+
+		var count int64
+		if count, err = res.RowsAffected(); err != nil {
+			return nil, err
+		}
+
+		if count != 1 {
+			err = errs.ErrNotFoundf("variant %q", r.Key)
+			return nil, err
+		}
+
+		var (
+			attachment sql.NullString
+			createdAt  fliptsql.Timestamp
+			updatedAt  fliptsql.Timestamp
+
+			v = &flipt.Variant{}
+		)
+
+		if err = s.builder.Select("id, namespace_key, \"key\", flag_key, name, description, attachment, created_at, updated_at").
+			RunWith(tx).
+			From("variants").
+			Where(whereClause).
+			QueryRowContext(ctx).
+			Scan(&v.Id, &v.NamespaceKey, &v.Key, &v.FlagKey, &v.Name, &v.Description, &attachment, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+
+		v.CreatedAt = createdAt.Timestamp
+		v.UpdatedAt = updatedAt.Timestamp
+		if attachment.Valid {
+			decoded, derr := decodeAttachment(attachment.String)
+			if derr != nil {
+				err = derr
+				return nil, err
+			}
+
+			var compactedAttachment string
+			if compactedAttachment, err = compactJSONString(decoded); err != nil {
+				return nil, err
+			}
+			v.Attachment = compactedAttachment
+		}
+
+		variants = append(variants, v)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
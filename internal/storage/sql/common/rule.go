@@ -363,6 +363,91 @@ func (s *Store) CountRules(ctx context.Context, namespaceKey, flagKey string) (u
 	return count, nil
 }
 
+// CountRulesByFlag returns the number of rules for every flag in a namespace
+// in a single query, keyed by flag key, so that callers listing many flags
+// can surface rule counts without a follow-up query per flag.
+func (s *Store) CountRulesByFlag(ctx context.Context, namespaceKey string) (map[string]uint64, error) {
+	if namespaceKey == "" {
+		namespaceKey = storage.DefaultNamespace
+	}
+
+	rows, err := s.builder.Select("flag_key, COUNT(*)").
+		From("rules").
+		Where(sq.Eq{"namespace_key": namespaceKey}).
+		GroupBy("flag_key").
+		QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	counts := make(map[string]uint64)
+
+	for rows.Next() {
+		var (
+			flagKey string
+			count   uint64
+		)
+
+		if err := rows.Scan(&flagKey, &count); err != nil {
+			return nil, err
+		}
+
+		counts[flagKey] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, rows.Close()
+}
+
+// resolveSegmentNamespace returns the namespace a segment referenced by a
+// rule in namespaceKey should be looked up in: namespaceKey itself if a
+// segment with key exists there, otherwise storage.GlobalNamespace if one
+// exists there instead, so rules can reference shared segments (e.g.
+// "internal employees") without namespaceKey needing its own copy. If the
+// segment exists in neither, namespaceKey is returned unchanged so the
+// caller's insert fails against the existing foreign key constraint, the
+// same as referencing any other nonexistent segment.
+//
+// The lookup runs against runner (the caller's transaction) rather than
+// s.GetSegment, since querying through s.builder's own connection while a
+// transaction is held on the same *sql.DB can deadlock a single-connection
+// pool.
+func (s *Store) resolveSegmentNamespace(ctx context.Context, runner sq.BaseRunner, namespaceKey, key string) (string, error) {
+	if namespaceKey == storage.GlobalNamespace {
+		return namespaceKey, nil
+	}
+
+	if s.segmentExists(ctx, runner, namespaceKey, key) {
+		return namespaceKey, nil
+	}
+
+	if s.segmentExists(ctx, runner, storage.GlobalNamespace, key) {
+		return storage.GlobalNamespace, nil
+	}
+
+	return namespaceKey, nil
+}
+
+func (s *Store) segmentExists(ctx context.Context, runner sq.BaseRunner, namespaceKey, key string) bool {
+	var found string
+	err := s.builder.Select("\"key\"").
+		RunWith(runner).
+		From("segments").
+		Where(sq.And{sq.Eq{"namespace_key": namespaceKey}, sq.Eq{"\"key\"": key}}).
+		QueryRowContext(ctx).
+		Scan(&found)
+	return err == nil
+}
+
 // CreateRule creates a rule
 func (s *Store) CreateRule(ctx context.Context, r *flipt.CreateRuleRequest) (_ *flipt.Rule, err error) {
 	segmentKeys := sanitizeSegmentKeys(r.GetSegmentKey(), r.GetSegmentKeys())
@@ -418,13 +503,18 @@ func (s *Store) CreateRule(ctx context.Context, r *flipt.CreateRuleRequest) (_ *
 	}
 
 	for _, segmentKey := range segmentKeys {
+		segmentNamespace, err := s.resolveSegmentNamespace(ctx, tx, rule.NamespaceKey, segmentKey)
+		if err != nil {
+			return nil, err
+		}
+
 		if _, err := s.builder.
 			Insert("rule_segments").
 			RunWith(tx).
 			Columns("rule_id", "namespace_key", "segment_key").
 			Values(
 				rule.Id,
-				rule.NamespaceKey,
+				segmentNamespace,
 				segmentKey,
 			).
 			ExecContext(ctx); err != nil {
@@ -476,22 +566,30 @@ func (s *Store) UpdateRule(ctx context.Context, r *flipt.UpdateRuleRequest) (_ *
 		return nil, err
 	}
 
-	// Delete and reinsert segmentKeys.
+	// Delete and reinsert segmentKeys. Note: a referenced segment's
+	// namespace_key may differ from the rule's own namespace (e.g. when it
+	// resolves to storage.GlobalNamespace), so every rule_segments row for
+	// this rule is cleared, not just the ones matching r.NamespaceKey.
 	if _, err = s.builder.Delete("rule_segments").
 		RunWith(tx).
-		Where(sq.And{sq.Eq{"rule_id": r.Id}, sq.Eq{"namespace_key": r.NamespaceKey}}).
+		Where(sq.Eq{"rule_id": r.Id}).
 		ExecContext(ctx); err != nil {
 		return nil, err
 	}
 
 	for _, segmentKey := range segmentKeys {
+		segmentNamespace, err := s.resolveSegmentNamespace(ctx, tx, r.NamespaceKey, segmentKey)
+		if err != nil {
+			return nil, err
+		}
+
 		if _, err := s.builder.
 			Insert("rule_segments").
 			RunWith(tx).
 			Columns("rule_id", "namespace_key", "segment_key").
 			Values(
 				r.Id,
-				r.NamespaceKey,
+				segmentNamespace,
 				segmentKey,
 			).
 			ExecContext(ctx); err != nil {
@@ -744,3 +842,238 @@ func (s *Store) DeleteDistribution(ctx context.Context, r *flipt.DeleteDistribut
 
 	return err
 }
+
+// NormalizeDistributions atomically rebalances a rule's distributions to the
+// given set of variant/rollout weights. The existing distributions are
+// replaced wholesale within a single transaction so that evaluation never
+// observes an intermediate state where the rollouts sum past 100%.
+func (s *Store) NormalizeDistributions(ctx context.Context, r *storage.NormalizeDistributionsRequest) (_ []*flipt.Distribution, err error) {
+	if r.NamespaceKey == "" {
+		r.NamespaceKey = storage.DefaultNamespace
+	}
+
+	var total float32
+	for _, w := range r.Weights {
+		total += w.Rollout
+	}
+
+	if total > 100 {
+		return nil, errs.ErrInvalidf("distribution weights sum to %v, must be less than or equal to 100", total)
+	}
+
+	var count int
+	if err := s.builder.Select("COUNT(*)").
+		From("rules").
+		Where(sq.And{sq.Eq{"id": r.RuleId}, sq.Eq{"namespace_key": r.NamespaceKey}}).
+		QueryRowContext(ctx).
+		Scan(&count); err != nil {
+		return nil, err
+	}
+
+	if count < 1 {
+		return nil, errs.ErrNotFoundf("rule %q in namespace %q", r.RuleId, r.NamespaceKey)
+	}
+
+	for _, w := range r.Weights {
+		var variantCount int
+		if err := s.builder.Select("COUNT(*)").
+			From("variants").
+			Join("rules ON variants.namespace_key = rules.namespace_key AND variants.flag_key = rules.flag_key").
+			Where(sq.And{
+				sq.Eq{"rules.id": r.RuleId},
+				sq.Eq{"rules.namespace_key": r.NamespaceKey},
+				sq.Eq{"variants.id": w.VariantId},
+			}).
+			QueryRowContext(ctx).
+			Scan(&variantCount); err != nil {
+			return nil, err
+		}
+
+		if variantCount < 1 {
+			return nil, errs.ErrNotFoundf("variant %q for rule %q in namespace %q", w.VariantId, r.RuleId, r.NamespaceKey)
+		}
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = s.builder.Delete("distributions").
+		RunWith(tx).
+		Where(sq.Eq{"rule_id": r.RuleId}).
+		ExecContext(ctx); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+
+	distributions := make([]*flipt.Distribution, 0, len(r.Weights))
+
+	for _, w := range r.Weights {
+		d := &flipt.Distribution{
+			Id:        uuid.Must(uuid.NewV4()).String(),
+			RuleId:    r.RuleId,
+			VariantId: w.VariantId,
+			Rollout:   w.Rollout,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+
+		if _, err = s.builder.Insert("distributions").
+			RunWith(tx).
+			Columns("id", "rule_id", "variant_id", "rollout", "created_at", "updated_at").
+			Values(
+				d.Id,
+				d.RuleId,
+				d.VariantId,
+				d.Rollout,
+				&fliptsql.Timestamp{Timestamp: d.CreatedAt},
+				&fliptsql.Timestamp{Timestamp: d.UpdatedAt}).
+			ExecContext(ctx); err != nil {
+			return nil, err
+		}
+
+		distributions = append(distributions, d)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return distributions, nil
+}
+
+// ReplaceRules atomically replaces every rule (and its distributions)
+// attached to a flag with the supplied drafts, ranked in the given order.
+// Existing rules are deleted first; their distributions and segment links
+// are removed via ON DELETE CASCADE. The whole operation runs in a single
+// transaction, so a flag being evaluated mid-publish never observes a
+// partially replaced rule set.
+func (s *Store) ReplaceRules(ctx context.Context, namespaceKey, flagKey string, drafts []storage.DraftRule) (_ []*flipt.Rule, err error) {
+	if namespaceKey == "" {
+		namespaceKey = storage.DefaultNamespace
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = s.builder.Delete("rules").
+		RunWith(tx).
+		Where(sq.And{sq.Eq{"namespace_key": namespaceKey}, sq.Eq{"flag_key": flagKey}}).
+		ExecContext(ctx); err != nil {
+		return nil, err
+	}
+
+	rules := make([]*flipt.Rule, 0, len(drafts))
+
+	for i, draft := range drafts {
+		segmentKeys := sanitizeSegmentKeys(draft.SegmentKey, draft.SegmentKeys)
+
+		segmentOperator := draft.SegmentOperator
+		if len(segmentKeys) == 1 {
+			segmentOperator = flipt.SegmentOperator_OR_SEGMENT_OPERATOR
+		}
+
+		now := timestamppb.Now()
+		rule := &flipt.Rule{
+			Id:              uuid.Must(uuid.NewV4()).String(),
+			NamespaceKey:    namespaceKey,
+			FlagKey:         flagKey,
+			Rank:            int32(i + 1),
+			SegmentOperator: segmentOperator,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+
+		if _, err = s.builder.Insert("rules").
+			RunWith(tx).
+			Columns("id", "namespace_key", "flag_key", "\"rank\"", "segment_operator", "created_at", "updated_at").
+			Values(
+				rule.Id,
+				rule.NamespaceKey,
+				rule.FlagKey,
+				rule.Rank,
+				rule.SegmentOperator,
+				&fliptsql.Timestamp{Timestamp: rule.CreatedAt},
+				&fliptsql.Timestamp{Timestamp: rule.UpdatedAt},
+			).
+			ExecContext(ctx); err != nil {
+			return nil, err
+		}
+
+		for _, segmentKey := range segmentKeys {
+			segmentNamespace, err := s.resolveSegmentNamespace(ctx, tx, namespaceKey, segmentKey)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err = s.builder.Insert("rule_segments").
+				RunWith(tx).
+				Columns("rule_id", "namespace_key", "segment_key").
+				Values(rule.Id, segmentNamespace, segmentKey).
+				ExecContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(segmentKeys) == 1 {
+			rule.SegmentKey = segmentKeys[0]
+		} else {
+			rule.SegmentKeys = segmentKeys
+		}
+
+		for _, dist := range draft.Distributions {
+			var variantID string
+			if err = s.builder.Select("id").
+				RunWith(tx).
+				From("variants").
+				Where(sq.And{sq.Eq{"namespace_key": namespaceKey}, sq.Eq{"flag_key": flagKey}, sq.Eq{"\"key\"": dist.VariantKey}}).
+				QueryRowContext(ctx).
+				Scan(&variantID); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, errs.ErrNotFoundf("variant %q for flag %q in namespace %q", dist.VariantKey, flagKey, namespaceKey)
+				}
+				return nil, err
+			}
+
+			distNow := timestamppb.Now()
+			if _, err = s.builder.Insert("distributions").
+				RunWith(tx).
+				Columns("id", "rule_id", "variant_id", "rollout", "created_at", "updated_at").
+				Values(
+					uuid.Must(uuid.NewV4()).String(),
+					rule.Id,
+					variantID,
+					dist.Rollout,
+					&fliptsql.Timestamp{Timestamp: distNow},
+					&fliptsql.Timestamp{Timestamp: distNow},
+				).
+				ExecContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
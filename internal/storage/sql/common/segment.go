@@ -59,7 +59,7 @@ func (s *Store) GetSegment(ctx context.Context, namespaceKey, key string) (*flip
 	query := s.builder.Select("id, namespace_key, segment_key, type, property, operator, value, description, created_at, updated_at").
 		From("constraints").
 		Where(sq.And{sq.Eq{"namespace_key": segment.NamespaceKey}, sq.Eq{"segment_key": segment.Key}}).
-		OrderBy("created_at ASC")
+		OrderBy("rank", "created_at")
 
 	rows, err := query.QueryContext(ctx)
 	if err != nil {
@@ -236,7 +236,7 @@ func (s *Store) setConstraints(ctx context.Context, namespaceKey string, segment
 	query := s.builder.Select("id, namespace_key, segment_key, type, property, operator, value, description, created_at, updated_at").
 		From("constraints").
 		Where(sq.Eq{"namespace_key": namespaceKey, "segment_key": allSegmentKeys}).
-		OrderBy("created_at")
+		OrderBy("rank", "created_at")
 
 	rows, err := query.QueryContext(ctx)
 	if err != nil {
@@ -391,12 +391,34 @@ func (s *Store) DeleteSegment(ctx context.Context, r *flipt.DeleteSegmentRequest
 	return err
 }
 
+// nextConstraintRank returns the rank to assign to the next constraint
+// appended to a segment, placing it after every existing constraint.
+func (s *Store) nextConstraintRank(ctx context.Context, runner sq.BaseRunner, namespaceKey, segmentKey string) (int32, error) {
+	var count int32
+
+	if err := s.builder.Select("COUNT(*)").
+		RunWith(runner).
+		From("constraints").
+		Where(sq.And{sq.Eq{"namespace_key": namespaceKey}, sq.Eq{"segment_key": segmentKey}}).
+		QueryRowContext(ctx).
+		Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count + 1, nil
+}
+
 // CreateConstraint creates a constraint
 func (s *Store) CreateConstraint(ctx context.Context, r *flipt.CreateConstraintRequest) (*flipt.Constraint, error) {
 	if r.NamespaceKey == "" {
 		r.NamespaceKey = storage.DefaultNamespace
 	}
 
+	rank, err := s.nextConstraintRank(ctx, s.db, r.NamespaceKey, r.SegmentKey)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		operator = strings.ToLower(r.Operator)
 		now      = timestamppb.Now()
@@ -420,7 +442,7 @@ func (s *Store) CreateConstraint(ctx context.Context, r *flipt.CreateConstraintR
 	}
 
 	if _, err := s.builder.Insert("constraints").
-		Columns("id", "namespace_key", "segment_key", "type", "property", "operator", "value", "description", "created_at", "updated_at").
+		Columns("id", "namespace_key", "segment_key", "type", "property", "operator", "value", "description", "\"rank\"", "created_at", "updated_at").
 		Values(
 			c.Id,
 			c.NamespaceKey,
@@ -430,6 +452,7 @@ func (s *Store) CreateConstraint(ctx context.Context, r *flipt.CreateConstraintR
 			c.Operator,
 			c.Value,
 			c.Description,
+			rank,
 			&fliptsql.Timestamp{Timestamp: c.CreatedAt},
 			&fliptsql.Timestamp{Timestamp: c.UpdatedAt}).
 		ExecContext(ctx); err != nil {
@@ -510,3 +533,228 @@ func (s *Store) DeleteConstraint(ctx context.Context, r *flipt.DeleteConstraintR
 
 	return err
 }
+
+// CreateConstraints creates a batch of constraints in a single transaction.
+func (s *Store) CreateConstraints(ctx context.Context, reqs []*flipt.CreateConstraintRequest) (_ []*flipt.Constraint, err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	constraints := make([]*flipt.Constraint, 0, len(reqs))
+
+	for _, r := range reqs {
+		namespaceKey := r.NamespaceKey
+		if namespaceKey == "" {
+			namespaceKey = storage.DefaultNamespace
+		}
+
+		rank, rerr := s.nextConstraintRank(ctx, tx, namespaceKey, r.SegmentKey)
+		if rerr != nil {
+			err = rerr
+			return nil, err
+		}
+
+		operator := strings.ToLower(r.Operator)
+		now := timestamppb.Now()
+		c := &flipt.Constraint{
+			Id:           uuid.Must(uuid.NewV4()).String(),
+			NamespaceKey: namespaceKey,
+			SegmentKey:   r.SegmentKey,
+			Type:         r.Type,
+			Property:     r.Property,
+			Operator:     operator,
+			Value:        r.Value,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+			Description:  r.Description,
+		}
+
+		// unset value if operator does not require it
+		if _, ok := flipt.NoValueOperators[c.Operator]; ok {
+			c.Value = ""
+		}
+
+		if _, err = s.builder.Insert("constraints").
+			RunWith(tx).
+			Columns("id", "namespace_key", "segment_key", "type", "property", "operator", "value", "description", "\"rank\"", "created_at", "updated_at").
+			Values(
+				c.Id,
+				c.NamespaceKey,
+				c.SegmentKey,
+				c.Type,
+				c.Property,
+				c.Operator,
+				c.Value,
+				c.Description,
+				rank,
+				&fliptsql.Timestamp{Timestamp: c.CreatedAt},
+				&fliptsql.Timestamp{Timestamp: c.UpdatedAt},
+			).
+			ExecContext(ctx); err != nil {
+			return nil, err
+		}
+
+		constraints = append(constraints, c)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return constraints, nil
+}
+
+// UpdateConstraints updates a batch of constraints in a single transaction.
+func (s *Store) UpdateConstraints(ctx context.Context, reqs []*flipt.UpdateConstraintRequest) (_ []*flipt.Constraint, err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	constraints := make([]*flipt.Constraint, 0, len(reqs))
+
+	for _, r := range reqs {
+		namespaceKey := r.NamespaceKey
+		if namespaceKey == "" {
+			namespaceKey = storage.DefaultNamespace
+		}
+
+		whereClause := sq.And{sq.Eq{"id": r.Id}, sq.Eq{"segment_key": r.SegmentKey}, sq.Eq{"namespace_key": namespaceKey}}
+		operator := strings.ToLower(r.Operator)
+
+		// unset value if operator does not require it
+		if _, ok := flipt.NoValueOperators[operator]; ok {
+			r.Value = ""
+		}
+
+		res, qerr := s.builder.Update("constraints").
+			RunWith(tx).
+			Set("type", r.Type).
+			Set("property", r.Property).
+			Set("operator", operator).
+			Set("value", r.Value).
+			Set("description", r.Description).
+			Set("updated_at", &fliptsql.Timestamp{Timestamp: timestamppb.Now()}).
+			Where(whereClause).
+			ExecContext(ctx)
+		if qerr != nil {
+			err = qerr
+			return nil, err
+		}
+
+		var count int64
+		if count, err = res.RowsAffected(); err != nil {
+			return nil, err
+		}
+
+		if count != 1 {
+			err = errs.ErrNotFoundf("constraint %q", r.Id)
+			return nil, err
+		}
+
+		var (
+			createdAt fliptsql.Timestamp
+			updatedAt fliptsql.Timestamp
+
+			c = &flipt.Constraint{}
+		)
+
+		if err = s.builder.Select("id, namespace_key, segment_key, type, property, operator, value, description, created_at, updated_at").
+			RunWith(tx).
+			From("constraints").
+			Where(whereClause).
+			QueryRowContext(ctx).
+			Scan(&c.Id, &c.NamespaceKey, &c.SegmentKey, &c.Type, &c.Property, &c.Operator, &c.Value, &c.Description, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+
+		c.CreatedAt = createdAt.Timestamp
+		c.UpdatedAt = updatedAt.Timestamp
+
+		constraints = append(constraints, c)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return constraints, nil
+}
+
+// DeleteConstraints deletes a batch of constraints in a single transaction.
+func (s *Store) DeleteConstraints(ctx context.Context, reqs []*flipt.DeleteConstraintRequest) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, r := range reqs {
+		namespaceKey := r.NamespaceKey
+		if namespaceKey == "" {
+			namespaceKey = storage.DefaultNamespace
+		}
+
+		if _, err = s.builder.Delete("constraints").
+			RunWith(tx).
+			Where(sq.And{sq.Eq{"id": r.Id}, sq.Eq{"segment_key": r.SegmentKey}, sq.Eq{"namespace_key": namespaceKey}}).
+			ExecContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// OrderConstraints explicitly reorders every constraint on a segment to
+// match the given constraint ID order.
+func (s *Store) OrderConstraints(ctx context.Context, r *storage.OrderConstraintsRequest) (err error) {
+	namespaceKey := r.NamespaceKey
+	if namespaceKey == "" {
+		namespaceKey = storage.DefaultNamespace
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	updatedAt := timestamppb.Now()
+
+	for i, id := range r.ConstraintIds {
+		if _, err = s.builder.Update("constraints").
+			RunWith(tx).
+			Set("\"rank\"", i+1).
+			Set("updated_at", &fliptsql.Timestamp{Timestamp: updatedAt}).
+			Where(sq.And{sq.Eq{"id": id}, sq.Eq{"namespace_key": namespaceKey}, sq.Eq{"segment_key": r.SegmentKey}}).
+			ExecContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,43 @@
+package sql
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.flipt.io/flipt/internal/config"
+	"go.flipt.io/flipt/internal/storage"
+	"go.uber.org/zap"
+)
+
+// EnsureDefaultNamespace renames the namespace seeded by migrations under
+// the built-in key to the key and name configured via
+// storage.default_namespace, if one has been configured.
+//
+// The rename only succeeds on a namespace that is still exactly as
+// migrations left it: any flag, segment, or rollout already created under
+// the built-in key will cause it to fail on a foreign key violation, which
+// is treated as a no-op rather than an error, since the rename is only
+// meant to apply at bootstrap, before the namespace has been used.
+func EnsureDefaultNamespace(ctx context.Context, driver Driver, builder sq.StatementBuilderType, logger *zap.Logger, cfg config.DefaultNamespaceConfig) error {
+	if cfg.Key == "" || cfg.Key == storage.DefaultNamespace {
+		return nil
+	}
+
+	_, err := builder.Update("namespaces").
+		Set("\"key\"", cfg.Key).
+		Set("name", cfg.Name).
+		Where(sq.Eq{"\"key\"": storage.DefaultNamespace}).
+		ExecContext(ctx)
+	if err != nil {
+		logger.Debug("skipping default namespace rename",
+			zap.String("configured_key", cfg.Key),
+			zap.Error(driver.AdaptError(err)),
+		)
+
+		return nil
+	}
+
+	logger.Info("renamed default namespace", zap.String("key", cfg.Key), zap.String("name", cfg.Name))
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package sql_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/storage"
+	flipt "go.flipt.io/flipt/rpc/flipt"
+)
+
+func (s *DBTestSuite) TestRefreshEvaluationRulesView() {
+	t := s.T()
+
+	refresher, ok := s.store.(storage.EvaluationViewRefresher)
+	require.True(t, ok, "store does not implement storage.EvaluationViewRefresher")
+
+	flag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		MatchType:   flipt.MatchType_ANY_MATCH_TYPE,
+	})
+
+	require.NoError(t, err)
+
+	rule, err := s.store.CreateRule(context.TODO(), &flipt.CreateRuleRequest{
+		FlagKey:     flag.Key,
+		SegmentKeys: []string{segment.Key},
+		Rank:        1,
+	})
+
+	require.NoError(t, err)
+
+	require.NoError(t, refresher.RefreshEvaluationRulesView(context.TODO()))
+
+	var payload string
+	err = s.db.DB.QueryRow(
+		`SELECT payload FROM evaluation_rules_view WHERE namespace_key = ? AND flag_key = ?`,
+		storage.DefaultNamespace, flag.Key,
+	).Scan(&payload)
+	require.NoError(t, err)
+
+	var rules []*storage.EvaluationRule
+	require.NoError(t, json.Unmarshal([]byte(payload), &rules))
+
+	assert.Len(t, rules, 1)
+	assert.Equal(t, rule.Id, rules[0].ID)
+
+	// refreshing again should replace, not duplicate, the row
+	require.NoError(t, refresher.RefreshEvaluationRulesView(context.TODO()))
+
+	var count int
+	err = s.db.DB.QueryRow(
+		`SELECT COUNT(*) FROM evaluation_rules_view WHERE namespace_key = ? AND flag_key = ?`,
+		storage.DefaultNamespace, flag.Key,
+	).Scan(&count)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
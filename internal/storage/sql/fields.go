@@ -2,10 +2,12 @@ package sql
 
 import (
 	"database/sql/driver"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"time"
 
+	"go.flipt.io/flipt/internal/crypto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -78,3 +80,88 @@ func (f *JSONField[T]) Scan(v any) error {
 func (f *JSONField[T]) Value() (driver.Value, error) {
 	return json.Marshal(f.T)
 }
+
+// EncryptedJSONField behaves like JSONField, except the marshalled JSON is
+// additionally sealed with Encryptor before being written to the column,
+// and opened again when scanned back out. A nil Encryptor leaves the value
+// stored as plain JSON, matching JSONField, so it is safe to use
+// unconditionally regardless of whether encryption is configured.
+//
+// Scan tolerates rows written before encryption was enabled: it only
+// attempts to base64-decode and decrypt a column that doesn't already look
+// like plain JSON, so existing plaintext rows keep reading correctly the
+// first time encryption.enabled is turned on. New rows are always written
+// encrypted from that point on, so this fallback is only ever needed once
+// per row, on its first read after the migration.
+type EncryptedJSONField[T any] struct {
+	T         T
+	Encryptor crypto.Encryptor
+}
+
+func (f *EncryptedJSONField[T]) Scan(v any) error {
+	var bytes []byte
+	switch b := v.(type) {
+	case []byte:
+		bytes = b
+	case string:
+		bytes = []byte(b)
+	default:
+		return fmt.Errorf("unexpected type for data: %T", v)
+	}
+
+	if f.Encryptor != nil && !looksLikeJSON(bytes) {
+		ciphertext, err := base64.StdEncoding.DecodeString(string(bytes))
+		if err != nil {
+			return fmt.Errorf("decoding encrypted column: %w", err)
+		}
+
+		plaintext, err := f.Encryptor.Decrypt(ciphertext)
+		if err != nil {
+			return fmt.Errorf("decrypting column: %w", err)
+		}
+
+		bytes = plaintext
+	}
+
+	return json.Unmarshal(bytes, &f.T)
+}
+
+// looksLikeJSON reports whether b appears to already be a plain JSON value
+// rather than base64-encoded ciphertext, so Scan can recognize rows written
+// before encryption was enabled. Base64-encoded ciphertext never begins
+// with '{' or '[', so this is sufficient to distinguish the two without
+// needing a dedicated format marker.
+func looksLikeJSON(b []byte) bool {
+	for _, c := range b {
+		switch c {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '{', '[':
+			return true
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+func (f *EncryptedJSONField[T]) Value() (driver.Value, error) {
+	data, err := json.Marshal(f.T)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Encryptor == nil {
+		return data, nil
+	}
+
+	ciphertext, err := f.Encryptor.Encrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting column: %w", err)
+	}
+
+	// base64 encode so the ciphertext remains valid text for TEXT columns
+	// regardless of the target database's encoding.
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
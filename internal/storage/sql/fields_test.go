@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.flipt.io/flipt/internal/crypto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -92,3 +94,48 @@ func TestJSONField_Value(t *testing.T) {
 		assert.Equal(t, string(b), `{"hello":"world"}`)
 	}
 }
+
+func TestEncryptedJSONField_NoEncryptor(t *testing.T) {
+	ef := EncryptedJSONField[map[string]string]{
+		T: map[string]string{"hello": "world"},
+	}
+
+	v, err := ef.Value()
+	require.NoError(t, err)
+
+	got := EncryptedJSONField[map[string]string]{}
+	require.NoError(t, got.Scan(v))
+	assert.Equal(t, ef.T, got.T)
+}
+
+func TestEncryptedJSONField_WithEncryptor(t *testing.T) {
+	enc, err := crypto.NewAESGCM(make([]byte, 32))
+	require.NoError(t, err)
+
+	ef := EncryptedJSONField[map[string]string]{
+		T:         map[string]string{"hello": "world"},
+		Encryptor: enc,
+	}
+
+	v, err := ef.Value()
+	require.NoError(t, err)
+
+	s, ok := v.(string)
+	require.True(t, ok)
+	assert.NotContains(t, s, "hello")
+
+	got := EncryptedJSONField[map[string]string]{Encryptor: enc}
+	require.NoError(t, got.Scan(s))
+	assert.Equal(t, ef.T, got.T)
+}
+
+func TestEncryptedJSONField_ScanPlaintextAfterEncryptorEnabled(t *testing.T) {
+	enc, err := crypto.NewAESGCM(make([]byte, 32))
+	require.NoError(t, err)
+
+	// rows written before encryption.enabled was turned on remain plain
+	// JSON; scanning them with an encryptor configured must still work.
+	got := EncryptedJSONField[map[string]string]{Encryptor: enc}
+	require.NoError(t, got.Scan(`{"hello":"world"}`))
+	assert.Equal(t, map[string]string{"hello": "world"}, got.T)
+}
@@ -958,6 +958,125 @@ func (s *DBTestSuite) TestCreateVariantNamespace_DuplicateFlag_DuplicateKey() {
 	assert.Equal(t, "foo", variant2.Key)
 }
 
+func (s *DBTestSuite) TestCreateVariants() {
+	t := s.T()
+
+	flag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, flag)
+
+	variants, err := s.store.CreateVariants(context.TODO(), []*flipt.CreateVariantRequest{
+		{FlagKey: flag.Key, Key: "v1", Name: "v1"},
+		{FlagKey: flag.Key, Key: "v2", Name: "v2"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, variants, 2)
+
+	assert.Equal(t, "v1", variants[0].Key)
+	assert.Equal(t, "v2", variants[1].Key)
+
+	for _, v := range variants {
+		assert.NotZero(t, v.Id)
+		assert.Equal(t, storage.DefaultNamespace, v.NamespaceKey)
+		assert.Equal(t, flag.Key, v.FlagKey)
+	}
+
+	// get the flag again
+	flag, err = s.store.GetFlag(context.TODO(), storage.DefaultNamespace, flag.Key)
+
+	require.NoError(t, err)
+	assert.NotNil(t, flag)
+
+	assert.Len(t, flag.Variants, 2)
+}
+
+func (s *DBTestSuite) TestCreateVariants_DuplicateKey() {
+	t := s.T()
+
+	flag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, flag)
+
+	_, err = s.store.CreateVariants(context.TODO(), []*flipt.CreateVariantRequest{
+		{FlagKey: flag.Key, Key: "v1", Name: "v1"},
+		{FlagKey: flag.Key, Key: "v1", Name: "v1 again"},
+	})
+
+	require.Error(t, err)
+
+	// the batch must not have partially committed
+	flag, err = s.store.GetFlag(context.TODO(), storage.DefaultNamespace, flag.Key)
+
+	require.NoError(t, err)
+	assert.Empty(t, flag.Variants)
+}
+
+func (s *DBTestSuite) TestUpdateVariants() {
+	t := s.T()
+
+	flag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, flag)
+
+	variants, err := s.store.CreateVariants(context.TODO(), []*flipt.CreateVariantRequest{
+		{FlagKey: flag.Key, Key: "v1", Name: "v1"},
+		{FlagKey: flag.Key, Key: "v2", Name: "v2"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, variants, 2)
+
+	updated, err := s.store.UpdateVariants(context.TODO(), []*flipt.UpdateVariantRequest{
+		{Id: variants[0].Id, FlagKey: flag.Key, Key: "v1", Name: "v1-updated"},
+		{Id: variants[1].Id, FlagKey: flag.Key, Key: "v2", Name: "v2-updated"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, updated, 2)
+
+	assert.Equal(t, "v1-updated", updated[0].Name)
+	assert.Equal(t, "v2-updated", updated[1].Name)
+}
+
+func (s *DBTestSuite) TestUpdateVariants_NotFound() {
+	t := s.T()
+
+	flag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, flag)
+
+	_, err = s.store.UpdateVariants(context.TODO(), []*flipt.UpdateVariantRequest{
+		{Id: uuid.Must(uuid.NewV4()).String(), FlagKey: flag.Key, Key: "nope", Name: "nope"},
+	})
+
+	require.Error(t, err)
+}
+
 func (s *DBTestSuite) TestGetFlagWithVariantsMultiNamespace() {
 	t := s.T()
 
@@ -18,10 +18,10 @@ import (
 )
 
 var expectedVersions = map[Driver]uint{
-	SQLite:      11,
-	Postgres:    12,
-	MySQL:       10,
-	CockroachDB: 9,
+	SQLite:      16,
+	Postgres:    17,
+	MySQL:       15,
+	CockroachDB: 14,
 }
 
 // Migrator is responsible for migrating the database schema
@@ -83,6 +83,24 @@ func (m *Migrator) Close() (source, db error) {
 	return m.migrator.Close()
 }
 
+// PendingMigrations reports whether the database has migrations pending,
+// along with the current and expected schema versions, without running
+// or altering anything.
+func (m *Migrator) PendingMigrations() (pending bool, current, expected uint, err error) {
+	expected = expectedVersions[m.driver]
+
+	current, _, err = m.migrator.Version()
+	if err != nil {
+		if errors.Is(err, migrate.ErrNilVersion) {
+			return true, 0, expected, nil
+		}
+
+		return false, 0, expected, fmt.Errorf("getting current migrations version: %w", err)
+	}
+
+	return current < expected, current, expected, nil
+}
+
 // Up runs any pending migrations
 func (m *Migrator) Up(force bool) error {
 	canAutoMigrate := force
@@ -81,6 +81,41 @@ func TestMigratorRun_NoChange(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMigratorPendingMigrations(t *testing.T) {
+	s := &stubDB.Stub{}
+	d, err := s.Open("")
+	require.NoError(t, err)
+
+	src := &stubSource.Stub{}
+	srcDrv, err := src.Open("")
+	require.NoError(t, err)
+
+	m, err := migrate.NewWithInstance("stub", srcDrv, "", d)
+	require.NoError(t, err)
+
+	migrator := Migrator{
+		migrator: m,
+		logger:   zaptest.NewLogger(t),
+		driver:   SQLite,
+	}
+
+	defer migrator.Close()
+
+	pending, current, expected, err := migrator.PendingMigrations()
+	require.NoError(t, err)
+	assert.True(t, pending)
+	assert.Equal(t, uint(0), current)
+	assert.Equal(t, expectedVersions[SQLite], expected)
+
+	require.NoError(t, d.SetVersion(int(expectedVersions[SQLite]), false))
+
+	pending, current, expected, err = migrator.PendingMigrations()
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.Equal(t, expectedVersions[SQLite], current)
+	assert.Equal(t, expectedVersions[SQLite], expected)
+}
+
 func TestMigratorExpectedVersions(t *testing.T) {
 	for db, driver := range stringToDriver {
 		migrations, err := os.ReadDir(filepath.Join("../../../config/migrations", db))
@@ -829,6 +829,202 @@ func (s *DBTestSuite) TestCreateDistribution_NoRule() {
 	assert.EqualError(t, err, fmt.Sprintf("variant %q, rule %q, flag %q in namespace %q not found", variant.Id, "foo", flag.Key, "default"))
 }
 
+func (s *DBTestSuite) TestNormalizeDistributions() {
+	t := s.T()
+
+	flag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, flag)
+
+	variant1, err := s.store.CreateVariant(context.TODO(), &flipt.CreateVariantRequest{
+		FlagKey:     flag.Key,
+		Key:         "variant1",
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, variant1)
+
+	variant2, err := s.store.CreateVariant(context.TODO(), &flipt.CreateVariantRequest{
+		FlagKey:     flag.Key,
+		Key:         "variant2",
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, variant2)
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	rule, err := s.store.CreateRule(context.TODO(), &flipt.CreateRuleRequest{
+		FlagKey:    flag.Key,
+		SegmentKey: segment.Key,
+		Rank:       1,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, rule)
+
+	_, err = s.store.CreateDistribution(context.TODO(), &flipt.CreateDistributionRequest{
+		FlagKey:   flag.Key,
+		RuleId:    rule.Id,
+		VariantId: variant1.Id,
+		Rollout:   100,
+	})
+
+	require.NoError(t, err)
+
+	distributions, err := s.store.NormalizeDistributions(context.TODO(), &storage.NormalizeDistributionsRequest{
+		RuleId: rule.Id,
+		Weights: []storage.DistributionWeight{
+			{VariantId: variant1.Id, Rollout: 40},
+			{VariantId: variant2.Id, Rollout: 60},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, distributions, 2)
+
+	byVariant := make(map[string]float32, len(distributions))
+	for _, d := range distributions {
+		assert.Equal(t, rule.Id, d.RuleId)
+		byVariant[d.VariantId] = d.Rollout
+	}
+
+	assert.Equal(t, float32(40), byVariant[variant1.Id])
+	assert.Equal(t, float32(60), byVariant[variant2.Id])
+}
+
+func (s *DBTestSuite) TestNormalizeDistributions_RuleNotFound() {
+	t := s.T()
+
+	_, err := s.store.NormalizeDistributions(context.TODO(), &storage.NormalizeDistributionsRequest{
+		RuleId: "foo",
+		Weights: []storage.DistributionWeight{
+			{VariantId: "bar", Rollout: 100},
+		},
+	})
+
+	assert.EqualError(t, err, fmt.Sprintf("rule %q in namespace %q not found", "foo", "default"))
+}
+
+func (s *DBTestSuite) TestNormalizeDistributions_VariantNotFound() {
+	t := s.T()
+
+	flag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, flag)
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	rule, err := s.store.CreateRule(context.TODO(), &flipt.CreateRuleRequest{
+		FlagKey:    flag.Key,
+		SegmentKey: segment.Key,
+		Rank:       1,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, rule)
+
+	_, err = s.store.NormalizeDistributions(context.TODO(), &storage.NormalizeDistributionsRequest{
+		RuleId: rule.Id,
+		Weights: []storage.DistributionWeight{
+			{VariantId: "nonexistent", Rollout: 100},
+		},
+	})
+
+	assert.EqualError(t, err, fmt.Sprintf("variant %q for rule %q in namespace %q not found", "nonexistent", rule.Id, "default"))
+}
+
+func (s *DBTestSuite) TestNormalizeDistributions_VariantFromOtherFlag() {
+	t := s.T()
+
+	flag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, flag)
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	rule, err := s.store.CreateRule(context.TODO(), &flipt.CreateRuleRequest{
+		FlagKey:    flag.Key,
+		SegmentKey: segment.Key,
+		Rank:       1,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, rule)
+
+	otherFlag, err := s.store.CreateFlag(context.TODO(), &flipt.CreateFlagRequest{
+		Key:         t.Name() + "_other",
+		Name:        "foo",
+		Description: "bar",
+		Enabled:     true,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, otherFlag)
+
+	otherVariant, err := s.store.CreateVariant(context.TODO(), &flipt.CreateVariantRequest{
+		FlagKey:     otherFlag.Key,
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, otherVariant)
+
+	_, err = s.store.NormalizeDistributions(context.TODO(), &storage.NormalizeDistributionsRequest{
+		RuleId: rule.Id,
+		Weights: []storage.DistributionWeight{
+			{VariantId: otherVariant.Id, Rollout: 100},
+		},
+	})
+
+	assert.EqualError(t, err, fmt.Sprintf("variant %q for rule %q in namespace %q not found", otherVariant.Id, rule.Id, "default"))
+}
+
 func (s *DBTestSuite) TestCreateRule_FlagNotFound() {
 	t := s.T()
 
@@ -1233,6 +1233,164 @@ func (s *DBTestSuite) TestDeleteConstraintNamespace_NotFound() {
 	require.NoError(t, err)
 }
 
+func (s *DBTestSuite) TestCreateConstraints() {
+	t := s.T()
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	constraints, err := s.store.CreateConstraints(context.TODO(), []*flipt.CreateConstraintRequest{
+		{SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "foo", Operator: "EQ", Value: "bar"},
+		{SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "baz", Operator: "EQ", Value: "qux"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, constraints, 2)
+
+	assert.Equal(t, "foo", constraints[0].Property)
+	assert.Equal(t, "baz", constraints[1].Property)
+
+	for _, c := range constraints {
+		assert.NotZero(t, c.Id)
+		assert.Equal(t, storage.DefaultNamespace, c.NamespaceKey)
+		assert.Equal(t, segment.Key, c.SegmentKey)
+	}
+
+	// get the segment again
+	segment, err = s.store.GetSegment(context.TODO(), storage.DefaultNamespace, segment.Key)
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	assert.Len(t, segment.Constraints, 2)
+}
+
+func (s *DBTestSuite) TestUpdateConstraints() {
+	t := s.T()
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	constraints, err := s.store.CreateConstraints(context.TODO(), []*flipt.CreateConstraintRequest{
+		{SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "foo", Operator: "EQ", Value: "bar"},
+		{SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "baz", Operator: "EQ", Value: "qux"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, constraints, 2)
+
+	updated, err := s.store.UpdateConstraints(context.TODO(), []*flipt.UpdateConstraintRequest{
+		{Id: constraints[0].Id, SegmentKey: segment.Key, Type: constraints[0].Type, Property: "foo", Operator: "EQ", Value: "bar2"},
+		{Id: constraints[1].Id, SegmentKey: segment.Key, Type: constraints[1].Type, Property: "baz", Operator: "EQ", Value: "qux2"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, updated, 2)
+
+	assert.Equal(t, "bar2", updated[0].Value)
+	assert.Equal(t, "qux2", updated[1].Value)
+}
+
+func (s *DBTestSuite) TestUpdateConstraints_NotFound() {
+	t := s.T()
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	_, err = s.store.UpdateConstraints(context.TODO(), []*flipt.UpdateConstraintRequest{
+		{Id: "nonexistent", SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "foo", Operator: "EQ", Value: "bar"},
+	})
+
+	require.Error(t, err)
+}
+
+func (s *DBTestSuite) TestDeleteConstraints() {
+	t := s.T()
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	constraints, err := s.store.CreateConstraints(context.TODO(), []*flipt.CreateConstraintRequest{
+		{SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "foo", Operator: "EQ", Value: "bar"},
+		{SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "baz", Operator: "EQ", Value: "qux"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, constraints, 2)
+
+	err = s.store.DeleteConstraints(context.TODO(), []*flipt.DeleteConstraintRequest{
+		{Id: constraints[0].Id, SegmentKey: segment.Key},
+		{Id: constraints[1].Id, SegmentKey: segment.Key},
+	})
+
+	require.NoError(t, err)
+
+	segment, err = s.store.GetSegment(context.TODO(), storage.DefaultNamespace, segment.Key)
+
+	require.NoError(t, err)
+	assert.Empty(t, segment.Constraints)
+}
+
+func (s *DBTestSuite) TestOrderConstraints() {
+	t := s.T()
+
+	segment, err := s.store.CreateSegment(context.TODO(), &flipt.CreateSegmentRequest{
+		Key:         t.Name(),
+		Name:        "foo",
+		Description: "bar",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, segment)
+
+	constraints, err := s.store.CreateConstraints(context.TODO(), []*flipt.CreateConstraintRequest{
+		{SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "foo", Operator: "EQ", Value: "bar"},
+		{SegmentKey: segment.Key, Type: flipt.ComparisonType_STRING_COMPARISON_TYPE, Property: "baz", Operator: "EQ", Value: "qux"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, constraints, 2)
+
+	err = s.store.OrderConstraints(context.TODO(), &storage.OrderConstraintsRequest{
+		SegmentKey:    segment.Key,
+		ConstraintIds: []string{constraints[1].Id, constraints[0].Id},
+	})
+
+	require.NoError(t, err)
+
+	segment, err = s.store.GetSegment(context.TODO(), storage.DefaultNamespace, segment.Key)
+
+	require.NoError(t, err)
+	require.Len(t, segment.Constraints, 2)
+
+	assert.Equal(t, constraints[1].Id, segment.Constraints[0].Id)
+	assert.Equal(t, constraints[0].Id, segment.Constraints[1].Id)
+}
+
 func BenchmarkListSegments(b *testing.B) {
 	s := new(DBTestSuite)
 	t := &testing.T{}
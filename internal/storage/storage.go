@@ -24,6 +24,10 @@ type EvaluationRule struct {
 	Segments        map[string]*EvaluationSegment `json:"segments,omitempty"`
 	Rank            int32                         `json:"rank,omitempty"`
 	SegmentOperator flipt.SegmentOperator         `json:"segmentOperator,omitempty"`
+	// Distributions are the rule's variant distributions, hydrated alongside
+	// the rule itself so evaluation doesn't need a separate round trip per
+	// matching rule via GetEvaluationDistributions.
+	Distributions []*EvaluationDistribution `json:"distributions,omitempty"`
 }
 
 type EvaluationSegment struct {
@@ -168,6 +172,13 @@ type ResultSet[T any] struct {
 
 const DefaultNamespace = "default"
 
+// GlobalNamespace is the reserved namespace key for segments that should be
+// referenceable by rules in any namespace, so common audiences (e.g.
+// "internal employees") don't need to be copied into every namespace. It is
+// an ordinary namespace in all other respects -- it must still be created
+// like any other before segments can be added to it.
+const GlobalNamespace = "global"
+
 // EvaluationStore returns data necessary for evaluation
 type EvaluationStore interface {
 	// GetEvaluationRules returns rules applicable to flagKey provided
@@ -177,6 +188,18 @@ type EvaluationStore interface {
 	GetEvaluationRollouts(ctx context.Context, namespaceKey, flagKey string) ([]*EvaluationRollout, error)
 }
 
+// EvaluationViewRefresher is implemented by storage backends that maintain
+// a denormalized evaluation_rules_view table, allowing it to be refreshed
+// from the authoritative rules/segments/constraints/distributions tables.
+// It is deliberately not part of Store, since only SQL-backed storage
+// supports it; callers should type-assert a Store to check.
+type EvaluationViewRefresher interface {
+	// RefreshEvaluationRulesView rebuilds the evaluation_rules_view row for
+	// every flag in every namespace from the current rules/segments/
+	// constraints/distributions tables.
+	RefreshEvaluationRulesView(ctx context.Context) error
+}
+
 // NamespaceStore stores and retrieves namespaces
 type NamespaceStore interface {
 	GetNamespace(ctx context.Context, key string) (*flipt.Namespace, error)
@@ -198,6 +221,12 @@ type FlagStore interface {
 	CreateVariant(ctx context.Context, r *flipt.CreateVariantRequest) (*flipt.Variant, error)
 	UpdateVariant(ctx context.Context, r *flipt.UpdateVariantRequest) (*flipt.Variant, error)
 	DeleteVariant(ctx context.Context, r *flipt.DeleteVariantRequest) error
+	// CreateVariants creates a batch of variants in a single transaction, so
+	// that creating a flag with many variants does not require a sequential
+	// round trip per variant.
+	CreateVariants(ctx context.Context, reqs []*flipt.CreateVariantRequest) ([]*flipt.Variant, error)
+	// UpdateVariants updates a batch of variants in a single transaction.
+	UpdateVariants(ctx context.Context, reqs []*flipt.UpdateVariantRequest) ([]*flipt.Variant, error)
 }
 
 // SegmentStore stores and retrieves segments and constraints
@@ -211,6 +240,26 @@ type SegmentStore interface {
 	CreateConstraint(ctx context.Context, r *flipt.CreateConstraintRequest) (*flipt.Constraint, error)
 	UpdateConstraint(ctx context.Context, r *flipt.UpdateConstraintRequest) (*flipt.Constraint, error)
 	DeleteConstraint(ctx context.Context, r *flipt.DeleteConstraintRequest) error
+	// CreateConstraints creates a batch of constraints in a single
+	// transaction, so that building out a constraint-heavy segment doesn't
+	// expose partially-applied intermediate states to evaluation.
+	CreateConstraints(ctx context.Context, reqs []*flipt.CreateConstraintRequest) ([]*flipt.Constraint, error)
+	// UpdateConstraints updates a batch of constraints in a single
+	// transaction.
+	UpdateConstraints(ctx context.Context, reqs []*flipt.UpdateConstraintRequest) ([]*flipt.Constraint, error)
+	// DeleteConstraints deletes a batch of constraints in a single
+	// transaction.
+	DeleteConstraints(ctx context.Context, reqs []*flipt.DeleteConstraintRequest) error
+	// OrderConstraints explicitly reorders every constraint on a segment to
+	// match the given constraint ID order.
+	OrderConstraints(ctx context.Context, r *OrderConstraintsRequest) error
+}
+
+// OrderConstraintsRequest explicitly reorders the constraints on a segment.
+type OrderConstraintsRequest struct {
+	NamespaceKey  string
+	SegmentKey    string
+	ConstraintIds []string
 }
 
 // RuleStore stores and retrieves rules and distributions
@@ -218,6 +267,9 @@ type RuleStore interface {
 	GetRule(ctx context.Context, namespaceKey, id string) (*flipt.Rule, error)
 	ListRules(ctx context.Context, namespaceKey, flagKey string, opts ...QueryOption) (ResultSet[*flipt.Rule], error)
 	CountRules(ctx context.Context, namespaceKey, flagKey string) (uint64, error)
+	// CountRulesByFlag returns rule counts for every flag in a namespace,
+	// keyed by flag key, in a single query.
+	CountRulesByFlag(ctx context.Context, namespaceKey string) (map[string]uint64, error)
 	CreateRule(ctx context.Context, r *flipt.CreateRuleRequest) (*flipt.Rule, error)
 	UpdateRule(ctx context.Context, r *flipt.UpdateRuleRequest) (*flipt.Rule, error)
 	DeleteRule(ctx context.Context, r *flipt.DeleteRuleRequest) error
@@ -225,6 +277,46 @@ type RuleStore interface {
 	CreateDistribution(ctx context.Context, r *flipt.CreateDistributionRequest) (*flipt.Distribution, error)
 	UpdateDistribution(ctx context.Context, r *flipt.UpdateDistributionRequest) (*flipt.Distribution, error)
 	DeleteDistribution(ctx context.Context, r *flipt.DeleteDistributionRequest) error
+	// NormalizeDistributions atomically rebalances a rule's distributions to
+	// the given set of variant/rollout weights, rejecting the request if the
+	// weights sum to over 100, so that readers never observe a rule whose
+	// distributions transiently sum past 100% mid-update.
+	NormalizeDistributions(ctx context.Context, r *NormalizeDistributionsRequest) ([]*flipt.Distribution, error)
+	// ReplaceRules atomically replaces every rule (and its distributions)
+	// attached to a flag with the given set, so that staged multi-step
+	// targeting edits "publish" as a single all-or-nothing change rather
+	// than appearing to evaluation mid-edit.
+	ReplaceRules(ctx context.Context, namespaceKey, flagKey string, rules []DraftRule) ([]*flipt.Rule, error)
+}
+
+// NormalizeDistributionsRequest describes the target weights to rebalance a
+// rule's distributions to.
+type NormalizeDistributionsRequest struct {
+	NamespaceKey string
+	RuleId       string
+	Weights      []DistributionWeight
+}
+
+// DistributionWeight pairs a variant with the rollout percentage it should
+// be rebalanced to.
+type DistributionWeight struct {
+	VariantId string
+	Rollout   float32
+}
+
+// DraftRule describes a rule to be created as part of a ReplaceRules publish,
+// in the order it should be ranked.
+type DraftRule struct {
+	SegmentKey      string
+	SegmentKeys     []string
+	SegmentOperator flipt.SegmentOperator
+	Distributions   []DraftDistribution
+}
+
+// DraftDistribution describes a distribution to attach to a DraftRule.
+type DraftDistribution struct {
+	VariantKey string
+	Rollout    float32
 }
 
 type RolloutStore interface {
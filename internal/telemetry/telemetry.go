@@ -21,8 +21,14 @@ import (
 
 const (
 	filename = "telemetry.json"
-	version  = "1.3"
-	event    = "flipt.ping"
+	// bufferFilename holds pings that could not be sent, so they aren't lost
+	// across restarts of an instance that is only intermittently connected.
+	bufferFilename = "telemetry_buffer.json"
+	// bufferLimit bounds the number of unsent pings retained on disk; once
+	// exceeded, the oldest buffered pings are dropped in favor of newer ones.
+	bufferLimit = 50
+	version     = "1.3"
+	event       = "flipt.ping"
 )
 
 type ping struct {
@@ -77,10 +83,16 @@ func NewReporter(cfg config.Config, logger *zap.Logger, analyticsKey string, inf
 		return analytics.StdLogger(stdLogger)
 	}
 
-	client, err := analytics.NewWithConfig(analyticsKey, analytics.Config{
+	analyticsCfg := analytics.Config{
 		BatchSize: 1,
 		Logger:    analyticsLogger(),
-	})
+	}
+
+	if endpoint := cfg.Meta.TelemetryEndpoint; endpoint != "" {
+		analyticsCfg.Endpoint = endpoint
+	}
+
+	client, err := analytics.NewWithConfig(analyticsKey, analyticsCfg)
 	if err != nil {
 		return nil, fmt.Errorf("initializing telemetry client %w", err)
 	}
@@ -179,15 +191,12 @@ func (r *Reporter) ping(_ context.Context, f file) error {
 		r.logger.Debug("last report", zap.Time("when", t), zap.Duration("elapsed", time.Since(t)))
 	}
 
-	var (
-		props = analytics.NewProperties()
-		flipt = flipt{
-			OS:           info.OS,
-			Arch:         info.Arch,
-			Version:      info.Version,
-			Experimental: r.cfg.Experimental,
-		}
-	)
+	flipt := flipt{
+		OS:           info.OS,
+		Arch:         info.Arch,
+		Version:      info.Version,
+		Experimental: r.cfg.Experimental,
+	}
 
 	var dbProtocol = r.cfg.Database.Protocol.String()
 
@@ -249,21 +258,15 @@ func (r *Reporter) ping(_ context.Context, f file) error {
 		Flipt:   flipt,
 	}
 
-	// marshal as json first so we can get the correct case field names in the analytics service
-	out, err := json.Marshal(p)
-	if err != nil {
-		return fmt.Errorf("marshaling ping: %w", err)
-	}
+	// retry any pings left over from a previous report that couldn't reach
+	// the analytics service before sending the current one
+	r.flushBuffer(s.UUID)
 
-	if err := json.Unmarshal(out, &props); err != nil {
-		return fmt.Errorf("unmarshaling ping: %w", err)
-	}
+	if err := r.send(s.UUID, p); err != nil {
+		if bufErr := r.bufferPing(p); bufErr != nil {
+			r.logger.Debug("buffering ping", zap.Error(bufErr))
+		}
 
-	if err := r.client.Enqueue(analytics.Track{
-		AnonymousId: s.UUID,
-		Event:       event,
-		Properties:  props,
-	}); err != nil {
 		return fmt.Errorf("tracking ping: %w", err)
 	}
 
@@ -285,6 +288,111 @@ func (r *Reporter) ping(_ context.Context, f file) error {
 	return nil
 }
 
+// send marshals p and enqueues it with the analytics client, under the
+// provided anonymous id.
+func (r *Reporter) send(uuid string, p ping) error {
+	// marshal as json first so we can get the correct case field names in the analytics service
+	out, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("marshaling ping: %w", err)
+	}
+
+	props := analytics.NewProperties()
+	if err := json.Unmarshal(out, &props); err != nil {
+		return fmt.Errorf("unmarshaling ping: %w", err)
+	}
+
+	return r.client.Enqueue(analytics.Track{
+		AnonymousId: uuid,
+		Event:       event,
+		Properties:  props,
+	})
+}
+
+// bufferPath returns the path to the file used to persist pings that
+// couldn't be sent, so they survive a restart of the instance.
+func (r *Reporter) bufferPath() string {
+	return filepath.Join(r.cfg.Meta.StateDirectory, bufferFilename)
+}
+
+// bufferPing persists p to disk so it can be retried on a later report,
+// in case the instance is only intermittently connected to the network.
+func (r *Reporter) bufferPing(p ping) error {
+	pings, err := r.loadBuffer()
+	if err != nil {
+		return err
+	}
+
+	pings = append(pings, p)
+	if len(pings) > bufferLimit {
+		pings = pings[len(pings)-bufferLimit:]
+	}
+
+	return r.saveBuffer(pings)
+}
+
+// flushBuffer attempts to resend any pings buffered from previous failed
+// reports, under the current anonymous id. Pings that still fail to send
+// remain buffered for the next attempt.
+func (r *Reporter) flushBuffer(uuid string) {
+	pings, err := r.loadBuffer()
+	if err != nil {
+		r.logger.Debug("reading telemetry buffer", zap.Error(err))
+		return
+	}
+
+	if len(pings) == 0 {
+		return
+	}
+
+	remaining := make([]ping, 0, len(pings))
+	for _, p := range pings {
+		if err := r.send(uuid, p); err != nil {
+			remaining = append(remaining, p)
+		}
+	}
+
+	if err := r.saveBuffer(remaining); err != nil {
+		r.logger.Debug("saving telemetry buffer", zap.Error(err))
+	}
+}
+
+func (r *Reporter) loadBuffer() ([]ping, error) {
+	b, err := os.ReadFile(r.bufferPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("reading telemetry buffer: %w", err)
+	}
+
+	var pings []ping
+	if err := json.Unmarshal(b, &pings); err != nil {
+		// a corrupt buffer shouldn't block reporting, so start fresh
+		return nil, nil
+	}
+
+	return pings, nil
+}
+
+func (r *Reporter) saveBuffer(pings []ping) error {
+	if len(pings) == 0 {
+		if err := os.Remove(r.bufferPath()); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing telemetry buffer: %w", err)
+		}
+
+		return nil
+	}
+
+	out, err := json.Marshal(pings)
+	if err != nil {
+		return fmt.Errorf("marshaling telemetry buffer: %w", err)
+	}
+
+	return os.WriteFile(r.bufferPath(), out, 0644)
+}
+
 func newState() state {
 	var uid string
 
@@ -3,6 +3,7 @@ package telemetry
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
@@ -497,6 +498,46 @@ func TestPing_Disabled(t *testing.T) {
 	assert.Nil(t, mockAnalytics.msg)
 }
 
+func TestPing_BuffersOnFailure(t *testing.T) {
+	var (
+		logger        = zaptest.NewLogger(t)
+		tmpDir        = t.TempDir()
+		mockAnalytics = &mockAnalytics{enqueueErr: errors.New("offline")}
+
+		reporter = &Reporter{
+			cfg: config.Config{
+				Meta: config.MetaConfig{
+					TelemetryEnabled: true,
+					StateDirectory:   tmpDir,
+				},
+			},
+			logger: logger,
+			client: mockAnalytics,
+			info: info.Flipt{
+				Version: "1.0.0",
+				OS:      "linux",
+				Arch:    "amd64",
+			},
+		}
+	)
+
+	err := reporter.report(context.Background())
+	assert.Error(t, err)
+
+	buf, err := os.ReadFile(filepath.Join(tmpDir, bufferFilename))
+	require.NoError(t, err)
+	assert.NotEmpty(t, buf)
+
+	// a subsequent, successful report should flush the buffered ping first
+	mockAnalytics.enqueueErr = nil
+
+	err = reporter.report(context.Background())
+	assert.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(tmpDir, bufferFilename))
+	assert.True(t, os.IsNotExist(err))
+}
+
 func TestPing_SpecifyStateDir(t *testing.T) {
 	var (
 		logger = zaptest.NewLogger(t)
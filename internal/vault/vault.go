@@ -0,0 +1,125 @@
+// Package vault provides a thin client over HashiCorp Vault used to
+// resolve configuration values referenced as "vault:<path>#<key>" and to
+// watch the resulting secrets for lease expiry.
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// Client resolves secrets from Vault and watches their leases.
+type Client struct {
+	api *api.Client
+}
+
+// New constructs a Client from the standard Vault environment variables
+// (VAULT_ADDR, VAULT_TOKEN, VAULT_CACERT, etc.), the same convention
+// used by the official Vault CLI and libraries.
+func New() (*Client, error) {
+	cfg := api.DefaultConfig()
+	if err := cfg.ReadEnvironment(); err != nil {
+		return nil, fmt.Errorf("configuring vault client: %w", err)
+	}
+
+	c, err := api.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("constructing vault client: %w", err)
+	}
+
+	return &Client{api: c}, nil
+}
+
+// Reference is a parsed "vault:<path>#<key>" configuration value,
+// identifying a key within the secret stored at Path.
+type Reference struct {
+	Path string
+	Key  string
+}
+
+// ParseReference parses a "vault:secret/data/flipt#git_token" style
+// value into its path and key components. It returns false if raw does
+// not use the vault: scheme.
+func ParseReference(raw string) (Reference, bool) {
+	rest, ok := strings.CutPrefix(raw, "vault:")
+	if !ok {
+		return Reference{}, false
+	}
+
+	path, key, ok := strings.Cut(rest, "#")
+	if !ok {
+		return Reference{}, false
+	}
+
+	return Reference{Path: path, Key: key}, true
+}
+
+// Resolve reads the secret at ref.Path and returns the string stored
+// under ref.Key, along with the underlying secret so the caller can
+// watch it for renewal/expiry via Watch.
+func (c *Client) Resolve(ctx context.Context, ref Reference) (string, *api.Secret, error) {
+	secret, err := c.api.Logical().ReadWithContext(ctx, ref.Path)
+	if err != nil {
+		return "", nil, fmt.Errorf("reading vault secret %q: %w", ref.Path, err)
+	}
+
+	if secret == nil {
+		return "", nil, fmt.Errorf("vault secret %q not found", ref.Path)
+	}
+
+	data := secret.Data
+	// KV v2 engines nest the actual values under a "data" key.
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		data = nested
+	}
+
+	value, ok := data[ref.Key]
+	if !ok {
+		return "", nil, fmt.Errorf("vault secret %q has no key %q", ref.Path, ref.Key)
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return "", nil, fmt.Errorf("vault secret %q key %q is not a string", ref.Path, ref.Key)
+	}
+
+	return str, secret, nil
+}
+
+// Watch renews secret's lease in the background for as long as Vault
+// allows, calling onExpire once it can no longer be renewed so the
+// caller can re-resolve it. It is a no-op for secrets without a
+// renewable lease. Watching stops when ctx is cancelled.
+func (c *Client) Watch(ctx context.Context, secret *api.Secret, onExpire func()) error {
+	if secret == nil || !secret.Renewable {
+		return nil
+	}
+
+	watcher, err := c.api.NewLifetimeWatcher(&api.LifetimeWatcherInput{Secret: secret})
+	if err != nil {
+		return fmt.Errorf("watching vault secret lease: %w", err)
+	}
+
+	go watcher.Start()
+
+	go func() {
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-watcher.RenewCh():
+				// lease renewed, keep watching
+			case <-watcher.DoneCh():
+				onExpire()
+				return
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package vault
+
+import "testing"
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want Reference
+		ok   bool
+	}{
+		{
+			name: "valid",
+			raw:  "vault:secret/data/flipt#git_token",
+			want: Reference{Path: "secret/data/flipt", Key: "git_token"},
+			ok:   true,
+		},
+		{
+			name: "missing key",
+			raw:  "vault:secret/data/flipt",
+		},
+		{
+			name: "not a vault reference",
+			raw:  "supersecret",
+		},
+		{
+			name: "empty",
+			raw:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseReference(tt.raw)
+			if ok != tt.ok {
+				t.Fatalf("ParseReference(%q) ok = %v, want %v", tt.raw, ok, tt.ok)
+			}
+
+			if got != tt.want {
+				t.Fatalf("ParseReference(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
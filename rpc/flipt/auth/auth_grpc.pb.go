@@ -350,6 +350,7 @@ var AuthenticationService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	AuthenticationMethodTokenService_CreateToken_FullMethodName = "/flipt.auth.AuthenticationMethodTokenService/CreateToken"
+	AuthenticationMethodTokenService_RotateToken_FullMethodName = "/flipt.auth.AuthenticationMethodTokenService/RotateToken"
 )
 
 // AuthenticationMethodTokenServiceClient is the client API for AuthenticationMethodTokenService service.
@@ -357,6 +358,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuthenticationMethodTokenServiceClient interface {
 	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
+	RotateToken(ctx context.Context, in *RotateTokenRequest, opts ...grpc.CallOption) (*RotateTokenResponse, error)
 }
 
 type authenticationMethodTokenServiceClient struct {
@@ -376,11 +378,21 @@ func (c *authenticationMethodTokenServiceClient) CreateToken(ctx context.Context
 	return out, nil
 }
 
+func (c *authenticationMethodTokenServiceClient) RotateToken(ctx context.Context, in *RotateTokenRequest, opts ...grpc.CallOption) (*RotateTokenResponse, error) {
+	out := new(RotateTokenResponse)
+	err := c.cc.Invoke(ctx, AuthenticationMethodTokenService_RotateToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationMethodTokenServiceServer is the server API for AuthenticationMethodTokenService service.
 // All implementations must embed UnimplementedAuthenticationMethodTokenServiceServer
 // for forward compatibility
 type AuthenticationMethodTokenServiceServer interface {
 	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
+	RotateToken(context.Context, *RotateTokenRequest) (*RotateTokenResponse, error)
 	mustEmbedUnimplementedAuthenticationMethodTokenServiceServer()
 }
 
@@ -391,6 +403,9 @@ type UnimplementedAuthenticationMethodTokenServiceServer struct {
 func (UnimplementedAuthenticationMethodTokenServiceServer) CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
 }
+func (UnimplementedAuthenticationMethodTokenServiceServer) RotateToken(context.Context, *RotateTokenRequest) (*RotateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateToken not implemented")
+}
 func (UnimplementedAuthenticationMethodTokenServiceServer) mustEmbedUnimplementedAuthenticationMethodTokenServiceServer() {
 }
 
@@ -423,6 +438,24 @@ func _AuthenticationMethodTokenService_CreateToken_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationMethodTokenService_RotateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationMethodTokenServiceServer).RotateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationMethodTokenService_RotateToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationMethodTokenServiceServer).RotateToken(ctx, req.(*RotateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthenticationMethodTokenService_ServiceDesc is the grpc.ServiceDesc for AuthenticationMethodTokenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -434,6 +467,10 @@ var AuthenticationMethodTokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateToken",
 			Handler:    _AuthenticationMethodTokenService_CreateToken_Handler,
 		},
+		{
+			MethodName: "RotateToken",
+			Handler:    _AuthenticationMethodTokenService_RotateToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth/auth.proto",
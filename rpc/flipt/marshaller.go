@@ -4,12 +4,18 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"strings"
 
 	grpc_gateway_v1 "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	grpc_gateway_v2 "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
 )
 
+// StructuredAttachmentMIME is the Accept/Content-Type header value clients
+// can request to have variant attachment payloads returned as nested JSON
+// objects instead of serialized strings. See StructuredAttachmentMarshaler.
+const StructuredAttachmentMIME = "application/vnd.flipt.attachment+json"
+
 var _ grpc_gateway_v2.Marshaler = &V1toV2MarshallerAdapter{}
 
 // V1toV2MarshallerAdapter is a V1 to V2 marshaller adapter to be able to use the v1 marshaller
@@ -67,3 +73,68 @@ func (m *V1toV2MarshallerAdapter) NewDecoder(r io.Reader) grpc_gateway_v2.Decode
 func (m *V1toV2MarshallerAdapter) NewEncoder(w io.Writer) grpc_gateway_v2.Encoder {
 	return m.JSONPb.NewEncoder(w)
 }
+
+var _ grpc_gateway_v2.Marshaler = &StructuredAttachmentMarshaler{}
+
+// StructuredAttachmentMarshaler wraps another marshaler and rewrites any
+// variant attachment fields in the marshaled JSON from their serialized
+// string form into nested JSON objects, so that API consumers requesting
+// StructuredAttachmentMIME don't need to unmarshal attachment payloads
+// themselves.
+type StructuredAttachmentMarshaler struct {
+	grpc_gateway_v2.Marshaler
+}
+
+func NewStructuredAttachmentMarshaler(m grpc_gateway_v2.Marshaler) *StructuredAttachmentMarshaler {
+	return &StructuredAttachmentMarshaler{m}
+}
+
+func (m *StructuredAttachmentMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.Marshaler.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		// not a JSON object/array (e.g. an empty body), leave as-is
+		return data, nil
+	}
+
+	return json.Marshal(inflateAttachments(decoded))
+}
+
+// inflateAttachments walks a decoded JSON value, replacing any attachment
+// field's serialized JSON string with the parsed object it represents.
+func inflateAttachments(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			if isAttachmentKey(k) {
+				if s, ok := child.(string); ok && s != "" {
+					var obj interface{}
+					if err := json.Unmarshal([]byte(s), &obj); err == nil {
+						val[k] = obj
+						continue
+					}
+				}
+			}
+
+			val[k] = inflateAttachments(child)
+		}
+
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = inflateAttachments(child)
+		}
+
+		return val
+	default:
+		return v
+	}
+}
+
+func isAttachmentKey(key string) bool {
+	return strings.HasSuffix(strings.ToLower(key), "attachment")
+}
@@ -10,7 +10,15 @@ import (
 	"go.flipt.io/flipt/errors"
 )
 
-const maxVariantAttachmentSize = 10000
+var maxVariantAttachmentSize = 10000
+
+// SetMaxVariantAttachmentSize configures the maximum size, in bytes, a
+// variant's attachment payload may be. It is called once during server
+// startup with the configured limit; until then, or if never called, the
+// default of 10000 bytes applies.
+func SetMaxVariantAttachmentSize(n int) {
+	maxVariantAttachmentSize = n
+}
 
 // Validator validates types
 type Validator interface {